@@ -2,6 +2,7 @@ package alerter
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/snyk/driftctl/enumeration/resource"
 )
@@ -14,6 +15,8 @@ type Alerter struct {
 	alerts   Alerts
 	alertsCh chan Alerts
 	doneCh   chan bool
+	lock     sync.RWMutex
+	closed   bool
 }
 
 func NewAlerter() *Alerter {
@@ -46,12 +49,23 @@ func (a *Alerter) SetAlerts(alerts Alerts) {
 }
 
 func (a *Alerter) Retrieve() Alerts {
-	close(a.alertsCh)
-	<-a.doneCh
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if !a.closed {
+		a.closed = true
+		close(a.alertsCh)
+		<-a.doneCh
+	}
 	return a.alerts
 }
 
+// SendAlert drops alerts sent after Retrieve, e.g. by an enumerator abandoned by an interrupted scan
 func (a *Alerter) SendAlert(key string, alert Alert) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if a.closed {
+		return
+	}
 	a.alertsCh <- Alerts{
 		key: []Alert{alert},
 	}
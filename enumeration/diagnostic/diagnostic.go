@@ -21,6 +21,12 @@ func (d *diagnosticImpl) Code() string {
 	if _, ok := d.alert.(*alerts.RemoteAccessDeniedAlert); ok {
 		return "ACCESS_DENIED"
 	}
+	if _, ok := d.alert.(*alerts.EnumerationTimeoutAlert); ok {
+		return "TIMEOUT"
+	}
+	if _, ok := d.alert.(*alerts.IncompleteEnumerationAlert); ok {
+		return "INCOMPLETE"
+	}
 	return "UNKNOWN_ERROR"
 }
 
@@ -1,6 +1,7 @@
 package enumeration
 
 import (
+	"context"
 	"time"
 
 	"github.com/snyk/driftctl/enumeration/diagnostic"
@@ -29,5 +30,5 @@ type EnumerateOutput struct {
 }
 
 type Enumerator interface {
-	Enumerate(*EnumerateInput) (*EnumerateOutput, error)
+	Enumerate(context.Context, *EnumerateInput) (*EnumerateOutput, error)
 }
@@ -0,0 +1,212 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/logger"
+)
+
+// Client is a running plugin binary
+type Client struct {
+	name     string
+	client   *goplugin.Client
+	plugin   Plugin
+	manifest *Manifest
+}
+
+func (c *Client) Name() string {
+	return c.name
+}
+
+func (c *Client) Manifest() *Manifest {
+	return c.manifest
+}
+
+func (c *Client) Plugin() Plugin {
+	return c.plugin
+}
+
+// Close stops the plugin binary
+func (c *Client) Close() {
+	c.client.Kill()
+}
+
+type Clients []*Client
+
+func (c Clients) Close() {
+	for _, client := range c {
+		client.Close()
+	}
+}
+
+// CheckCollisions rejects plugins that bring a resource type or a middleware name that is already
+// built in or brought by another plugin, one of them would silently replace the other
+func (c Clients) CheckCollisions(resourceTypes, middlewares []string) error {
+	types := make(map[string]string, len(resourceTypes))
+	for _, ty := range resourceTypes {
+		types[ty] = "driftctl"
+	}
+	names := make(map[string]string, len(middlewares))
+	for _, name := range middlewares {
+		names[name] = "driftctl"
+	}
+
+	for _, client := range c {
+		for _, ty := range client.Manifest().ResourceTypes {
+			if owner, exist := types[ty]; exist {
+				return errors.Errorf("plugin %s enumerates %s which is already enumerated by %s", client.Name(), ty, owner)
+			}
+			types[ty] = "plugin " + client.Name()
+		}
+		for _, name := range client.Manifest().Middlewares {
+			if owner, exist := names[name]; exist {
+				return errors.Errorf("plugin %s middleware %s has the name of a middleware of %s", client.Name(), name, owner)
+			}
+			names[name] = "plugin " + client.Name()
+		}
+	}
+	return nil
+}
+
+// Discover returns the plugin binaries of dir in name order, a missing directory means there is no plugin
+func Discover(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list plugins")
+	}
+
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		if !isExecutable(info) {
+			logrus.WithField("file", entry.Name()).Debug("Ignoring plugins directory file that is not executable")
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func isExecutable(info os.FileInfo) bool {
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(info.Name()), ".exe")
+	}
+	return info.Mode().Perm()&0111 != 0
+}
+
+// Load starts the plugins of dir that are used with the given remote, the others are stopped right away
+func Load(ctx context.Context, dir, remote string) (Clients, error) {
+	paths, err := Discover(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(Clients, 0, len(paths))
+	for _, path := range paths {
+		client, err := Start(ctx, path)
+		if err != nil {
+			clients.Close()
+			return nil, err
+		}
+		if !client.Manifest().SupportsRemote(remote) {
+			logrus.WithFields(logrus.Fields{"plugin": client.Name(), "remote": remote}).Debug("Plugin is not used with this remote")
+			client.Close()
+			continue
+		}
+		logrus.WithFields(logrus.Fields{
+			"plugin":         client.Name(),
+			"resource_types": client.Manifest().ResourceTypes,
+			"middlewares":    client.Manifest().Middlewares,
+		}).Debug("Loaded plugin")
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+// Start runs a plugin binary and asks for its manifest
+func Start(ctx context.Context, path string) (*Client, error) {
+	name := filepath.Base(path)
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		Cmd:              exec.Command(path),
+		HandshakeConfig:  Handshake,
+		Plugins:          map[string]goplugin.Plugin{Name: &GRPCPlugin{}},
+		Managed:          true,
+		Logger:           logger.NewTerraformPluginLogger(),
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolGRPC},
+		AutoMTLS:         true,
+	})
+
+	c, err := newClient(ctx, name, client)
+	if err != nil {
+		client.Kill()
+		return nil, errors.Wrapf(err, "unable to start plugin %s", name)
+	}
+	return c, nil
+}
+
+func newClient(ctx context.Context, name string, client *goplugin.Client) (*Client, error) {
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := rpcClient.Dispense(Name)
+	if err != nil {
+		return nil, err
+	}
+	p := raw.(Plugin)
+	manifest, err := p.Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{name: name, client: client, plugin: p, manifest: manifest}, nil
+}
+
+type enumerator struct {
+	plugin  Plugin
+	ty      resource.ResourceType
+	factory resource.ResourceFactory
+}
+
+// NewEnumerator lists a resource type through a plugin, resources are built with the given factory
+// like the ones of built-in enumerators
+func NewEnumerator(plugin Plugin, ty string, factory resource.ResourceFactory) common.Enumerator {
+	return &enumerator{plugin: plugin, ty: resource.ResourceType(ty), factory: factory}
+}
+
+func (e *enumerator) SupportedType() resource.ResourceType {
+	return e.ty
+}
+
+func (e *enumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.plugin.Enumerate(ctx, string(e.ty))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		if res.Type != "" && res.Type != string(e.ty) {
+			return nil, errors.Errorf("plugin returned a %s resource while listing %s", res.Type, e.ty)
+		}
+		results = append(results, e.factory.CreateAbstractResource(string(e.ty), res.ID, res.Attributes))
+	}
+	return results, nil
+}
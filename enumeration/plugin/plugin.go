@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	"context"
+	"math"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+// Name is the name the plugin is dispensed under
+const Name = "driftctl"
+
+// Handshake must be used by plugin binaries, it makes sure that driftctl only runs plugins
+// written for it, and that both sides speak the same protocol version
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "DRIFTCTL_PLUGIN_MAGIC_COOKIE",
+	MagicCookieValue: "c0f1f6a8b5ab4ed6a2ed1d4f0d3c6cfb",
+}
+
+// Messages carry whole resource lists, they are not bounded by the gRPC 4MB default
+const maxMessageSize = math.MaxInt32
+
+// Manifest describes what a plugin brings
+type Manifest struct {
+	// Remotes the plugin is used with (e.g. aws+tf), the plugin is used with every remote when empty
+	Remotes []string `json:"remotes,omitempty"`
+	// ResourceTypes the plugin can enumerate
+	ResourceTypes []string `json:"resource_types,omitempty"`
+	// Middlewares the plugin implements, they run after the built-in ones in the given order
+	Middlewares []string `json:"middlewares,omitempty"`
+}
+
+// SupportsRemote tells whether the plugin should be used when scanning the given remote
+func (m *Manifest) SupportsRemote(remote string) bool {
+	if len(m.Remotes) == 0 {
+		return true
+	}
+	for _, r := range m.Remotes {
+		if r == remote {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource is a resource exchanged with a plugin, attributes are the ones of the terraform resource
+type Resource struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Plugin is implemented by plugin binaries and served with Serve
+type Plugin interface {
+	Describe(ctx context.Context) (*Manifest, error)
+	// Enumerate lists the resources of one of the manifest types
+	Enumerate(ctx context.Context, resourceType string) ([]Resource, error)
+	// Execute runs one of the manifest middlewares, it returns the resources to keep, which may
+	// have been altered or added
+	Execute(ctx context.Context, middleware string, remote, state []Resource) ([]Resource, []Resource, error)
+}
+
+// Serve is called by the main function of a plugin binary, it only returns when driftctl stops the plugin
+func Serve(impl Plugin) {
+	goplugin.Serve(&goplugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: map[string]goplugin.Plugin{
+			Name: &GRPCPlugin{Impl: impl},
+		},
+		GRPCServer: func(opts []grpc.ServerOption) *grpc.Server {
+			opts = append(opts, grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize))
+			return grpc.NewServer(opts...)
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func dispense(t *testing.T, impl Plugin) Plugin {
+	client, _ := goplugin.TestPluginGRPCConn(t, map[string]goplugin.Plugin{Name: &GRPCPlugin{Impl: impl}})
+	t.Cleanup(func() { client.Close() })
+	raw, err := client.Dispense(Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw.(Plugin)
+}
+
+func TestGRPCPlugin(t *testing.T) {
+	impl := &MockPlugin{}
+	impl.On("Describe", mock.Anything).Return(&Manifest{
+		Remotes:       []string{"aws+tf"},
+		ResourceTypes: []string{"acme_widget"},
+		Middlewares:   []string{"acme_defaults"},
+	}, nil)
+	impl.On("Enumerate", mock.Anything, "acme_widget").Return([]Resource{
+		{ID: "widget-1", Type: "acme_widget", Attributes: map[string]interface{}{"name": "widget"}},
+	}, nil)
+	impl.On("Enumerate", mock.Anything, "acme_gadget").Return(nil, errors.New("unknown type acme_gadget"))
+	impl.On("Execute", mock.Anything, "acme_defaults",
+		[]Resource{{ID: "widget-1", Type: "acme_widget"}},
+		[]Resource{{ID: "widget-2", Type: "acme_widget"}},
+	).Return([]Resource{}, []Resource{{ID: "widget-2", Type: "acme_widget"}}, nil)
+
+	p := dispense(t, impl)
+
+	manifest, err := p.Describe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &Manifest{
+		Remotes:       []string{"aws+tf"},
+		ResourceTypes: []string{"acme_widget"},
+		Middlewares:   []string{"acme_defaults"},
+	}, manifest)
+
+	resources, err := p.Enumerate(context.Background(), "acme_widget")
+	assert.NoError(t, err)
+	assert.Equal(t, []Resource{
+		{ID: "widget-1", Type: "acme_widget", Attributes: map[string]interface{}{"name": "widget"}},
+	}, resources)
+
+	_, err = p.Enumerate(context.Background(), "acme_gadget")
+	assert.EqualError(t, err, "unknown type acme_gadget")
+
+	remote, state, err := p.Execute(context.Background(), "acme_defaults",
+		[]Resource{{ID: "widget-1", Type: "acme_widget"}},
+		[]Resource{{ID: "widget-2", Type: "acme_widget"}},
+	)
+	assert.NoError(t, err)
+	assert.Empty(t, remote)
+	assert.Equal(t, []Resource{{ID: "widget-2", Type: "acme_widget"}}, state)
+
+	impl.AssertExpectations(t)
+}
+
+func TestManifest_SupportsRemote(t *testing.T) {
+	assert.True(t, (&Manifest{}).SupportsRemote("aws+tf"))
+	assert.True(t, (&Manifest{Remotes: []string{"github+tf", "aws+tf"}}).SupportsRemote("aws+tf"))
+	assert.False(t, (&Manifest{Remotes: []string{"github+tf"}}).SupportsRemote("aws+tf"))
+}
+
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are told apart by their extension on windows")
+	}
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "driftctl-plugin-b"), []byte{}, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "driftctl-plugin-a"), []byte{}, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte{}, 0644))
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0755))
+
+	paths, err := Discover(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "driftctl-plugin-a"),
+		filepath.Join(dir, "driftctl-plugin-b"),
+	}, paths)
+
+	paths, err = Discover(filepath.Join(dir, "missing"))
+	assert.NoError(t, err)
+	assert.Nil(t, paths)
+}
+
+func TestEnumerator(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []Resource
+		expected  []*resource.Resource
+		wantErr   string
+	}{
+		{
+			name: "resources are created with the factory",
+			resources: []Resource{
+				{ID: "widget-1", Type: "acme_widget", Attributes: map[string]interface{}{"name": "widget"}},
+				{ID: "widget-2", Attributes: map[string]interface{}{"name": "other"}},
+			},
+			expected: []*resource.Resource{
+				{Id: "widget-1", Type: "acme_widget", Attrs: &resource.Attributes{"name": "widget"}},
+				{Id: "widget-2", Type: "acme_widget", Attrs: &resource.Attributes{"name": "other"}},
+			},
+		},
+		{
+			name: "resources of another type are refused",
+			resources: []Resource{
+				{ID: "gadget-1", Type: "acme_gadget"},
+			},
+			wantErr: "plugin returned a acme_gadget resource while listing acme_widget",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &MockPlugin{}
+			p.On("Enumerate", mock.Anything, "acme_widget").Return(tt.resources, nil)
+
+			e := NewEnumerator(p, "acme_widget", terraform.NewTerraformResourceFactory())
+			assert.Equal(t, resource.ResourceType("acme_widget"), e.SupportedType())
+
+			got, err := e.Enumerate(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestClients_CheckCollisions(t *testing.T) {
+	tests := []struct {
+		name    string
+		clients Clients
+		wantErr string
+	}{
+		{
+			name: "new types and middlewares are accepted",
+			clients: Clients{
+				{name: "acme", manifest: &Manifest{ResourceTypes: []string{"acme_widget"}, Middlewares: []string{"AcmeWidgetDefaults"}}},
+				{name: "other", manifest: &Manifest{ResourceTypes: []string{"acme_gadget"}}},
+			},
+		},
+		{
+			name: "built-in type is refused",
+			clients: Clients{
+				{name: "acme", manifest: &Manifest{ResourceTypes: []string{"aws_s3_bucket"}}},
+			},
+			wantErr: "plugin acme enumerates aws_s3_bucket which is already enumerated by driftctl",
+		},
+		{
+			name: "type of another plugin is refused",
+			clients: Clients{
+				{name: "acme", manifest: &Manifest{ResourceTypes: []string{"acme_widget"}}},
+				{name: "other", manifest: &Manifest{ResourceTypes: []string{"acme_widget"}}},
+			},
+			wantErr: "plugin other enumerates acme_widget which is already enumerated by plugin acme",
+		},
+		{
+			name: "built-in middleware name is refused",
+			clients: Clients{
+				{name: "acme", manifest: &Manifest{Middlewares: []string{"AwsBucketPolicyExpander"}}},
+			},
+			wantErr: "plugin acme middleware AwsBucketPolicyExpander has the name of a middleware of driftctl",
+		},
+		{
+			name: "middleware name of another plugin is refused",
+			clients: Clients{
+				{name: "acme", manifest: &Manifest{Middlewares: []string{"AcmeWidgetDefaults"}}},
+				{name: "other", manifest: &Manifest{Middlewares: []string{"AcmeWidgetDefaults"}}},
+			},
+			wantErr: "plugin other middleware AcmeWidgetDefaults has the name of a middleware of plugin acme",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.clients.CheckCollisions([]string{"aws_s3_bucket"}, []string{"AwsBucketPolicyExpander"})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
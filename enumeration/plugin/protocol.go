@@ -0,0 +1,161 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The protocol is described in plugin.proto, every call takes and returns a JSON document
+// wrapped in a google.protobuf.BytesValue so that plugins need no generated code
+const serviceName = "driftctl.plugin.v1.Plugin"
+
+type describeRequest struct{}
+
+type enumerateRequest struct {
+	ResourceType string `json:"resource_type"`
+}
+
+type enumerateResponse struct {
+	Resources []Resource `json:"resources"`
+}
+
+type executeRequest struct {
+	Middleware string     `json:"middleware"`
+	Remote     []Resource `json:"remote"`
+	State      []Resource `json:"state"`
+}
+
+type executeResponse struct {
+	Remote []Resource `json:"remote"`
+	State  []Resource `json:"state"`
+}
+
+// GRPCPlugin serves a Plugin, or dispenses a client to it, over go-plugin gRPC transport
+type GRPCPlugin struct {
+	goplugin.NetRPCUnsupportedPlugin
+	// Impl is only set on the plugin side
+	Impl Plugin
+}
+
+func (p *GRPCPlugin) GRPCServer(_ *goplugin.GRPCBroker, s *grpc.Server) error {
+	s.RegisterService(&serviceDesc, p.Impl)
+	return nil
+}
+
+func (p *GRPCPlugin) GRPCClient(_ context.Context, _ *goplugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return &grpcClient{conn: conn}, nil
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*Plugin)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Describe", Handler: unaryHandler("Describe", describe)},
+		{MethodName: "Enumerate", Handler: unaryHandler("Enumerate", enumerate)},
+		{MethodName: "Execute", Handler: unaryHandler("Execute", execute)},
+	},
+	Metadata: "plugin.proto",
+}
+
+func describe(ctx context.Context, impl Plugin, _ []byte) (interface{}, error) {
+	return impl.Describe(ctx)
+}
+
+func enumerate(ctx context.Context, impl Plugin, payload []byte) (interface{}, error) {
+	req := enumerateRequest{}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+	resources, err := impl.Enumerate(ctx, req.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+	return enumerateResponse{Resources: resources}, nil
+}
+
+func execute(ctx context.Context, impl Plugin, payload []byte) (interface{}, error) {
+	req := executeRequest{}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+	remote, state, err := impl.Execute(ctx, req.Middleware, req.Remote, req.State)
+	if err != nil {
+		return nil, err
+	}
+	return executeResponse{Remote: remote, State: state}, nil
+}
+
+// unaryHandler decodes the JSON request of a method and encodes what fn returns
+func unaryHandler(method string, fn func(ctx context.Context, impl Plugin, payload []byte) (interface{}, error)) func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := &wrapperspb.BytesValue{}
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			res, err := fn(ctx, srv.(Plugin), req.(*wrapperspb.BytesValue).GetValue())
+			if err != nil {
+				return nil, err
+			}
+			payload, err := json.Marshal(res)
+			if err != nil {
+				return nil, err
+			}
+			return &wrapperspb.BytesValue{Value: payload}, nil
+		}
+		if interceptor == nil {
+			return handler(ctx, in)
+		}
+		return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/" + method}, handler)
+	}
+}
+
+// grpcClient is the host side implementation of Plugin
+type grpcClient struct {
+	conn *grpc.ClientConn
+}
+
+func (c *grpcClient) invoke(ctx context.Context, method string, req, res interface{}) error {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	out := &wrapperspb.BytesValue{}
+	err = c.conn.Invoke(ctx, "/"+serviceName+"/"+method, &wrapperspb.BytesValue{Value: payload}, out,
+		grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize))
+	if err != nil {
+		// Only keep the message the plugin returned, the gRPC code means nothing to users
+		return errors.New(status.Convert(err).Message())
+	}
+	return json.Unmarshal(out.GetValue(), res)
+}
+
+func (c *grpcClient) Describe(ctx context.Context) (*Manifest, error) {
+	manifest := &Manifest{}
+	if err := c.invoke(ctx, "Describe", describeRequest{}, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (c *grpcClient) Enumerate(ctx context.Context, resourceType string) ([]Resource, error) {
+	res := enumerateResponse{}
+	if err := c.invoke(ctx, "Enumerate", enumerateRequest{ResourceType: resourceType}, &res); err != nil {
+		return nil, err
+	}
+	return res.Resources, nil
+}
+
+func (c *grpcClient) Execute(ctx context.Context, middleware string, remote, state []Resource) ([]Resource, []Resource, error) {
+	res := executeResponse{}
+	if err := c.invoke(ctx, "Execute", executeRequest{Middleware: middleware, Remote: remote, State: state}, &res); err != nil {
+		return nil, nil, err
+	}
+	return res.Remote, res.State, nil
+}
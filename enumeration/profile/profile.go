@@ -0,0 +1,224 @@
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EnumeratorStats is what listing a resource type cost
+type EnumeratorStats struct {
+	Type      string
+	Duration  time.Duration
+	Resources int
+	// APICalls and Retries are the provider API calls made by the enumerator (pages included), a call served
+	// from the repositories cache is only accounted to the enumerator that made it first
+	APICalls int
+	Retries  int
+}
+
+// MethodStats is what a repository method cost for the whole scan
+type MethodStats struct {
+	Method   string
+	Calls    int
+	Duration time.Duration
+	APICalls int
+	Retries  int
+}
+
+type serializableEnumeratorStats struct {
+	Type       string `json:"type"`
+	DurationMs int64  `json:"duration_ms"`
+	Resources  int    `json:"resources"`
+	APICalls   int    `json:"api_calls"`
+	Retries    int    `json:"retries"`
+}
+
+type serializableMethodStats struct {
+	Method     string `json:"method"`
+	Calls      int    `json:"calls"`
+	DurationMs int64  `json:"duration_ms"`
+	APICalls   int    `json:"api_calls"`
+	Retries    int    `json:"retries"`
+}
+
+func (s EnumeratorStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serializableEnumeratorStats{s.Type, s.Duration.Milliseconds(), s.Resources, s.APICalls, s.Retries})
+}
+
+func (s *EnumeratorStats) UnmarshalJSON(bytes []byte) error {
+	stats := serializableEnumeratorStats{}
+	if err := json.Unmarshal(bytes, &stats); err != nil {
+		return err
+	}
+	*s = EnumeratorStats{stats.Type, time.Duration(stats.DurationMs) * time.Millisecond, stats.Resources, stats.APICalls, stats.Retries}
+	return nil
+}
+
+func (s MethodStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serializableMethodStats{s.Method, s.Calls, s.Duration.Milliseconds(), s.APICalls, s.Retries})
+}
+
+func (s *MethodStats) UnmarshalJSON(bytes []byte) error {
+	stats := serializableMethodStats{}
+	if err := json.Unmarshal(bytes, &stats); err != nil {
+		return err
+	}
+	*s = MethodStats{stats.Method, stats.Calls, time.Duration(stats.DurationMs) * time.Millisecond, stats.APICalls, stats.Retries}
+	return nil
+}
+
+// Report is a snapshot of a Profile
+type Report struct {
+	Enumerators []EnumeratorStats `json:"enumerators"`
+	Methods     []MethodStats     `json:"methods"`
+}
+
+// Profile records the cost of every enumerator and repository method of a scan.
+// Enumerators and repositories find it in the context, so every function is a no-op when
+// the context does not come from WithEnumerator.
+type Profile struct {
+	lock        sync.Mutex
+	enumerators map[string]*EnumeratorStats
+	methods     map[string]*MethodStats
+}
+
+func New() *Profile {
+	return &Profile{
+		enumerators: make(map[string]*EnumeratorStats),
+		methods:     make(map[string]*MethodStats),
+	}
+}
+
+type contextKey struct{}
+
+type scope struct {
+	profile    *Profile
+	enumerator string
+	method     string
+}
+
+func fromContext(ctx context.Context) *scope {
+	s, _ := ctx.Value(contextKey{}).(*scope)
+	return s
+}
+
+// WithEnumerator returns a context that accounts API calls to the given resource type
+func WithEnumerator(ctx context.Context, p *Profile, ty string) context.Context {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, exist := p.enumerators[ty]; !exist {
+		p.enumerators[ty] = &EnumeratorStats{Type: ty}
+	}
+	return context.WithValue(ctx, contextKey{}, &scope{profile: p, enumerator: ty})
+}
+
+// RecordEnumeration stores the time spent listing a resource type and how many resources were found
+func (p *Profile) RecordEnumeration(ty string, duration time.Duration, resources int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	stats, exist := p.enumerators[ty]
+	if !exist {
+		stats = &EnumeratorStats{Type: ty}
+		p.enumerators[ty] = stats
+	}
+	stats.Duration = duration
+	stats.Resources = resources
+}
+
+// Method is called at the beginning of a repository method, API calls made with the returned context
+// are accounted to this method. The returned function must be called when the method returns.
+func Method(ctx context.Context, name string) (context.Context, func()) {
+	s := fromContext(ctx)
+	if s == nil {
+		return ctx, func() {}
+	}
+	start := time.Now()
+	return context.WithValue(ctx, contextKey{}, &scope{profile: s.profile, enumerator: s.enumerator, method: name}), func() {
+		duration := time.Since(start)
+		s.profile.lock.Lock()
+		defer s.profile.lock.Unlock()
+		stats := s.profile.method(name)
+		stats.Calls++
+		stats.Duration += duration
+	}
+}
+
+// RecordAPICall is called by providers clients for every API request that was sent
+func RecordAPICall(ctx context.Context, retries int) {
+	s := fromContext(ctx)
+	if s == nil {
+		return
+	}
+	s.profile.lock.Lock()
+	defer s.profile.lock.Unlock()
+	if stats, exist := s.profile.enumerators[s.enumerator]; exist {
+		stats.APICalls++
+		stats.Retries += retries
+	}
+	if s.method != "" {
+		stats := s.profile.method(s.method)
+		stats.APICalls++
+		stats.Retries += retries
+	}
+}
+
+func (p *Profile) method(name string) *MethodStats {
+	stats, exist := p.methods[name]
+	if !exist {
+		stats = &MethodStats{Method: name}
+		p.methods[name] = stats
+	}
+	return stats
+}
+
+// Report returns recorded stats, the slowest first
+func (p *Profile) Report() *Report {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	report := &Report{
+		Enumerators: make([]EnumeratorStats, 0, len(p.enumerators)),
+		Methods:     make([]MethodStats, 0, len(p.methods)),
+	}
+	for _, stats := range p.enumerators {
+		report.Enumerators = append(report.Enumerators, *stats)
+	}
+	for _, stats := range p.methods {
+		report.Methods = append(report.Methods, *stats)
+	}
+	sort.Slice(report.Enumerators, func(i, j int) bool {
+		if report.Enumerators[i].Duration != report.Enumerators[j].Duration {
+			return report.Enumerators[i].Duration > report.Enumerators[j].Duration
+		}
+		return report.Enumerators[i].Type < report.Enumerators[j].Type
+	})
+	sort.Slice(report.Methods, func(i, j int) bool {
+		if report.Methods[i].Duration != report.Methods[j].Duration {
+			return report.Methods[i].Duration > report.Methods[j].Duration
+		}
+		return report.Methods[i].Method < report.Methods[j].Method
+	})
+	return report
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport records every request sent through it as an API call. Clients that retry failed
+// requests by themselves send them again through the transport, so those retries count as API calls.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	RecordAPICall(req.Context(), 0)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,100 @@
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile_WithoutScope(t *testing.T) {
+	ctx, done := Method(context.Background(), "Repository.List")
+	RecordAPICall(ctx, 1)
+	done()
+	assert.Equal(t, context.Background(), ctx)
+}
+
+func TestProfile_Accounting(t *testing.T) {
+	p := New()
+
+	var wg sync.WaitGroup
+	for _, ty := range []string{"type_a", "type_b"} {
+		ctx := WithEnumerator(context.Background(), p, ty)
+		wg.Add(1)
+		go func(ty string) {
+			defer wg.Done()
+			methodCtx, done := Method(ctx, "Repository.ListAll")
+			RecordAPICall(methodCtx, 0)
+			RecordAPICall(methodCtx, 3)
+			done()
+			// A call made outside of any repository method is only accounted to the enumerator
+			RecordAPICall(ctx, 0)
+			p.RecordEnumeration(ty, time.Second, 5)
+		}(ty)
+	}
+	wg.Wait()
+
+	report := p.Report()
+	for i, ty := range []string{"type_a", "type_b"} {
+		assert.Equal(t, EnumeratorStats{Type: ty, Duration: time.Second, Resources: 5, APICalls: 3, Retries: 3}, report.Enumerators[i])
+	}
+	assert.Len(t, report.Methods, 1)
+	assert.Equal(t, "Repository.ListAll", report.Methods[0].Method)
+	assert.Equal(t, 2, report.Methods[0].Calls)
+	assert.Equal(t, 4, report.Methods[0].APICalls)
+	assert.Equal(t, 6, report.Methods[0].Retries)
+}
+
+func TestProfile_ReportSlowestFirst(t *testing.T) {
+	p := New()
+	p.RecordEnumeration("fast", time.Millisecond, 1)
+	p.RecordEnumeration("slow", time.Minute, 1)
+	p.RecordEnumeration("medium", time.Second, 1)
+
+	report := p.Report()
+	types := make([]string, 0, len(report.Enumerators))
+	for _, stats := range report.Enumerators {
+		types = append(types, stats.Type)
+	}
+	assert.Equal(t, []string{"slow", "medium", "fast"}, types)
+}
+
+func TestReport_JSON(t *testing.T) {
+	report := &Report{
+		Enumerators: []EnumeratorStats{{Type: "aws_s3_bucket", Duration: 1500 * time.Millisecond, Resources: 3, APICalls: 4, Retries: 1}},
+		Methods:     []MethodStats{{Method: "S3Repository.ListAllBuckets", Calls: 7, Duration: 20 * time.Millisecond, APICalls: 1}},
+	}
+
+	raw, err := json.Marshal(report)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"enumerators": [{"type": "aws_s3_bucket", "duration_ms": 1500, "resources": 3, "api_calls": 4, "retries": 1}],
+		"methods": [{"method": "S3Repository.ListAllBuckets", "calls": 7, "duration_ms": 20, "api_calls": 1, "retries": 0}]
+	}`, string(raw))
+
+	got := &Report{}
+	assert.Nil(t, json.Unmarshal(raw, got))
+	assert.Equal(t, report, got)
+}
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	p := New()
+	ctx := WithEnumerator(context.Background(), p, "type_a")
+	client := &http.Client{Transport: NewTransport(nil)}
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		resp, err := client.Do(req)
+		assert.Nil(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, 2, p.Report().Enumerators[0].APICalls)
+}
@@ -0,0 +1,223 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultParallelism is the number of resource types listed, and of resources read, at the same time
+	DefaultParallelism = 10
+
+	// throttledRate is where an unlimited service starts from when it is throttled for the first time
+	throttledRate = 20
+	// minRate is the rate a throttled service never goes under
+	minRate = 0.5
+	// unlimitedRate is the rate above which a recovered unlimited service is unlimited again
+	unlimitedRate = 100
+
+	minBackoff = 100 * time.Millisecond
+	maxBackoff = 10 * time.Second
+)
+
+type Config struct {
+	// Parallelism is the number of concurrent enumerators and provider reads, zero means DefaultParallelism
+	Parallelism int
+	// Rate is the maximum number of requests per second to a single service, zero means unlimited until
+	// the service throttles us
+	Rate float64
+	// Services overrides Rate for some services, keyed by the provider service name (e.g. ec2, s3)
+	Services map[string]float64
+}
+
+// ParseRates reads rate limits in the "20" or "ec2=10" form into config, a value without a
+// service sets the default rate
+func ParseRates(config *Config, values []string) error {
+	for _, value := range values {
+		service, rawRate, hasService := strings.Cut(value, "=")
+		if !hasService {
+			service, rawRate = "", value
+		}
+		r, err := strconv.ParseFloat(rawRate, 64)
+		if err != nil || r < 0 {
+			return errors.Errorf("invalid rate limit '%s', expected a positive number of requests per second", value)
+		}
+		if !hasService {
+			config.Rate = r
+			continue
+		}
+		if service == "" {
+			return errors.Errorf("invalid rate limit '%s', service name is empty", value)
+		}
+		if config.Services == nil {
+			config.Services = make(map[string]float64)
+		}
+		config.Services[service] = r
+	}
+	return nil
+}
+
+// Controller holds the limiters of every service of a provider session, it is shared by all
+// repositories so that they cannot exceed a limit together.
+// A nil Controller does not limit anything.
+type Controller struct {
+	lock     sync.Mutex
+	config   Config
+	limiters map[string]*Limiter
+}
+
+func NewController(config Config) *Controller {
+	return &Controller{
+		config:   config,
+		limiters: make(map[string]*Limiter),
+	}
+}
+
+func (c *Controller) Parallelism() int64 {
+	if c == nil || c.config.Parallelism <= 0 {
+		return DefaultParallelism
+	}
+	return int64(c.config.Parallelism)
+}
+
+// Limiter returns the limiter of a service, it is created on first use
+func (c *Controller) Limiter(service string) *Limiter {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if limiter, exist := c.limiters[service]; exist {
+		return limiter
+	}
+	r, exist := c.config.Services[service]
+	if !exist {
+		r = c.config.Rate
+	}
+	limiter := NewLimiter(r)
+	c.limiters[service] = limiter
+	return limiter
+}
+
+// Limiter is a token bucket that halves its rate and backs off when the service throttles us,
+// then slowly gets back to the configured rate as requests succeed.
+// A nil Limiter does not limit anything.
+type Limiter struct {
+	lock        sync.Mutex
+	bucket      *rate.Limiter
+	maxRate     rate.Limit
+	backoff     time.Duration
+	pausedUntil time.Time
+}
+
+// NewLimiter creates a limiter of r requests per second, zero means unlimited
+func NewLimiter(r float64) *Limiter {
+	maxRate := rate.Inf
+	if r > 0 {
+		maxRate = rate.Limit(r)
+	}
+	return &Limiter{
+		bucket:  rate.NewLimiter(maxRate, burst(maxRate)),
+		maxRate: maxRate,
+	}
+}
+
+func burst(r rate.Limit) int {
+	if r == rate.Inf || r < 1 {
+		return 1
+	}
+	return int(r)
+}
+
+// Wait blocks until a request can be sent or ctx is done
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	pause := time.Until(l.pausedUntil)
+	l.lock.Unlock()
+	if pause > 0 {
+		timer := time.NewTimer(pause)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return l.bucket.Wait(ctx)
+}
+
+// Throttled is called when the service answered with a throttling error
+func (l *Limiter) Throttled() {
+	if l == nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	limit := l.bucket.Limit()
+	if limit == rate.Inf {
+		limit = throttledRate
+	} else {
+		limit /= 2
+	}
+	if limit < minRate {
+		limit = minRate
+	}
+	l.setLimit(limit)
+
+	if l.backoff == 0 {
+		l.backoff = minBackoff
+	} else if l.backoff < maxBackoff {
+		l.backoff *= 2
+		if l.backoff > maxBackoff {
+			l.backoff = maxBackoff
+		}
+	}
+	l.pausedUntil = time.Now().Add(l.backoff)
+}
+
+// Succeeded is called when a request was answered without being throttled
+func (l *Limiter) Succeeded() {
+	if l == nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.backoff = 0
+	limit := l.bucket.Limit()
+	if limit == l.maxRate {
+		return
+	}
+	// Additive increase, about one more request per second for every second of successful requests
+	limit += 1 / limit
+	if l.maxRate == rate.Inf && limit >= unlimitedRate {
+		limit = rate.Inf
+	}
+	if limit > l.maxRate {
+		limit = l.maxRate
+	}
+	l.setLimit(limit)
+}
+
+// Rate returns the current number of requests per second allowed, zero means unlimited
+func (l *Limiter) Rate() float64 {
+	if l == nil || l.bucket.Limit() == rate.Inf {
+		return 0
+	}
+	return float64(l.bucket.Limit())
+}
+
+func (l *Limiter) setLimit(limit rate.Limit) {
+	l.bucket.SetLimit(limit)
+	l.bucket.SetBurst(burst(limit))
+}
@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+)
+
+func TestParseRates(t *testing.T) {
+	config := Config{}
+	err := ParseRates(&config, []string{"ec2=10", "20", "s3=0.5"})
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Rate: 20, Services: map[string]float64{"ec2": 10, "s3": 0.5}}, config)
+
+	assert.EqualError(t, ParseRates(&config, []string{"-1"}), "invalid rate limit '-1', expected a positive number of requests per second")
+	assert.EqualError(t, ParseRates(&config, []string{"=1"}), "invalid rate limit '=1', service name is empty")
+}
+
+func TestController(t *testing.T) {
+	c := NewController(Config{Rate: 20, Services: map[string]float64{"ec2": 5}})
+	assert.Equal(t, int64(DefaultParallelism), c.Parallelism())
+	assert.Equal(t, float64(5), c.Limiter("ec2").Rate())
+	assert.Equal(t, float64(20), c.Limiter("s3").Rate())
+	assert.Same(t, c.Limiter("ec2"), c.Limiter("ec2"))
+
+	assert.Equal(t, int64(3), NewController(Config{Parallelism: 3}).Parallelism())
+}
+
+func TestController_Nil(t *testing.T) {
+	var c *Controller
+	assert.Equal(t, int64(DefaultParallelism), c.Parallelism())
+	limiter := c.Limiter("ec2")
+	assert.Nil(t, limiter)
+	assert.NoError(t, limiter.Wait(context.Background()))
+	limiter.Throttled()
+	limiter.Succeeded()
+}
+
+func TestLimiter_AdaptsToThrottling(t *testing.T) {
+	limiter := NewLimiter(10)
+	limiter.Throttled()
+	assert.Equal(t, float64(5), limiter.Rate())
+	limiter.Throttled()
+	assert.Equal(t, 2.5, limiter.Rate())
+
+	for i := 0; i < 100; i++ {
+		limiter.Succeeded()
+	}
+	assert.Equal(t, float64(10), limiter.Rate(), "rate should never exceed the configured one")
+
+	for i := 0; i < 20; i++ {
+		limiter.Throttled()
+	}
+	assert.Equal(t, minRate, limiter.Rate())
+}
+
+func TestLimiter_UnlimitedUntilThrottled(t *testing.T) {
+	limiter := NewLimiter(0)
+	assert.Equal(t, float64(0), limiter.Rate())
+
+	limiter.Throttled()
+	assert.Equal(t, float64(throttledRate), limiter.Rate())
+
+	for i := 0; i < 10000 && limiter.Rate() != 0; i++ {
+		limiter.Succeeded()
+	}
+	assert.Equal(t, float64(0), limiter.Rate())
+}
+
+func TestLimiter_WaitBacksOff(t *testing.T) {
+	limiter := NewLimiter(0)
+	limiter.Throttled()
+
+	ctx, cancel := context.WithTimeout(context.Background(), minBackoff/2)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+
+	start := time.Now()
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), minBackoff/4)
+}
+
+func TestTransport(t *testing.T) {
+	throttle := atomic.NewBool(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if throttle.Load() {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	limiter := NewLimiter(8)
+	client := &http.Client{Transport: NewTransport(limiter, nil)}
+
+	res, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, float64(4), limiter.Rate())
+
+	throttle.Store(false)
+	res, err = client.Get(server.URL)
+	assert.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, 4.25, limiter.Rate())
+}
+
+func TestIsThrottlingResponse(t *testing.T) {
+	exhausted := http.Header{}
+	exhausted.Set("X-RateLimit-Remaining", "0")
+
+	assert.True(t, IsThrottlingResponse(&http.Response{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, IsThrottlingResponse(&http.Response{StatusCode: http.StatusForbidden, Header: exhausted}))
+	assert.False(t, IsThrottlingResponse(&http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}}))
+	assert.False(t, IsThrottlingResponse(&http.Response{StatusCode: http.StatusOK, Header: exhausted}))
+}
@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"net/http"
+)
+
+type transport struct {
+	limiter *Limiter
+	base    http.RoundTripper
+}
+
+// NewTransport waits for the limiter before each request and adapts it to the responses,
+// base defaults to http.DefaultTransport
+func NewTransport(limiter *Limiter, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{limiter: limiter, base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+	if IsThrottlingResponse(res) {
+		t.limiter.Throttled()
+	} else {
+		t.limiter.Succeeded()
+	}
+	return res, nil
+}
+
+// IsThrottlingResponse tells whether an HTTP API refused a request because of its rate limit,
+// some APIs like GitHub answer 403 with an exhausted quota instead of 429
+func IsThrottlingResponse(res *http.Response) bool {
+	if res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return res.StatusCode == http.StatusForbidden && res.Header.Get("X-RateLimit-Remaining") == "0"
+}
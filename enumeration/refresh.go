@@ -1,6 +1,8 @@
 package enumeration
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/snyk/driftctl/enumeration/diagnostic"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -21,6 +23,6 @@ type GetSchemasOutput struct {
 }
 
 type Refresher interface {
-	Refresh(input *RefreshInput) (*RefreshOutput, error)
+	Refresh(ctx context.Context, input *RefreshInput) (*RefreshOutput, error)
 	GetSchema() (*GetSchemasOutput, error)
 }
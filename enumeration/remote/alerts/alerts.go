@@ -3,6 +3,7 @@ package alerts
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/common"
@@ -91,6 +92,10 @@ func (e *RemoteAccessDeniedAlert) GetProviderMessage() string {
 		message += "The latest minimal read-only IAM policy for driftctl is always available here, please update yours: https://docs.driftctl.com/aws/policy"
 	case common.RemoteGoogleTerraform:
 		message += "Please ensure that you have configured the required roles, please check our documentation at https://docs.driftctl.com/google/policy"
+	case common.RemoteKubernetesTerraform:
+		message += "Please ensure that the kubeconfig user is bound to a cluster role allowing to list the scanned resources in all namespaces (e.g. the built-in \"view\" role)"
+	case common.RemoteCloudflareTerraform:
+		message += "Please ensure that your Cloudflare API token has read access to zones, DNS, page rules, firewall services and workers routes of every zone"
 	default:
 		return ""
 	}
@@ -112,3 +117,91 @@ func SendEnumerationAlert(provider string, alerter alerter.AlerterInterface, lis
 func SendDetailsFetchingAlert(provider string, alerter alerter.AlerterInterface, listError *remoteerror.ResourceScanningError) {
 	sendRemoteAccessDeniedAlert(provider, alerter, listError, DetailsFetchingPhase)
 }
+
+// ResourceReadingAlert is sent when the terraform provider fails to read the details of a resource
+type ResourceReadingAlert struct {
+	message  string
+	resource *resource.Resource
+}
+
+func NewResourceReadingAlert(res *resource.Resource, err error) *ResourceReadingAlert {
+	return &ResourceReadingAlert{
+		message:  fmt.Sprintf("An error occured reading %s.%s: %s", res.ResourceType(), res.ResourceId(), err.Error()),
+		resource: res,
+	}
+}
+
+func (e *ResourceReadingAlert) Message() string {
+	return e.message
+}
+
+func (e *ResourceReadingAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+func (e *ResourceReadingAlert) Resource() *resource.Resource {
+	return e.resource
+}
+
+// EnumerationTimeoutAlert is sent when an enumerator did not complete before its deadline
+type EnumerationTimeoutAlert struct {
+	message string
+}
+
+func NewEnumerationTimeoutAlert(ty resource.ResourceType, timeout time.Duration) *EnumerationTimeoutAlert {
+	return &EnumerationTimeoutAlert{
+		message: fmt.Sprintf("Listing %s timed out after %s, resources of this type were not scanned", ty, timeout),
+	}
+}
+
+func (e *EnumerationTimeoutAlert) Message() string {
+	return e.message
+}
+
+func (e *EnumerationTimeoutAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+func (e *EnumerationTimeoutAlert) Resource() *resource.Resource {
+	return nil
+}
+
+// IncompleteEnumerationAlert is sent when listing a resource type did not complete, because the scan
+// was interrupted or the enumerator failed
+type IncompleteEnumerationAlert struct {
+	message string
+	reason  error
+}
+
+func NewIncompleteEnumerationAlert(ty resource.ResourceType, reason error) *IncompleteEnumerationAlert {
+	return &IncompleteEnumerationAlert{
+		message: fmt.Sprintf("Listing %s did not complete, resources of this type were not scanned: %s", ty, reason),
+		reason:  reason,
+	}
+}
+
+func (e *IncompleteEnumerationAlert) Message() string {
+	return e.message
+}
+
+// Reason returns the error the enumerator failed with, or the one that interrupted the scan
+func (e *IncompleteEnumerationAlert) Reason() error {
+	return e.reason
+}
+
+func (e *IncompleteEnumerationAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+func (e *IncompleteEnumerationAlert) Resource() *resource.Resource {
+	return nil
+}
+
+// IsIncompleteEnumeration returns true when the alert means that a resource type was not fully listed
+func IsIncompleteEnumeration(alert alerter.Alert) bool {
+	switch alert.(type) {
+	case *IncompleteEnumerationAlert, *EnumerationTimeoutAlert:
+		return true
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayAuthorizerEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayAuthorizerResourceType
 }
 
-func (e *ApiGatewayAuthorizerEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayAuthorizerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayRestApiResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *ApiGatewayAuthorizerEnumerator) Enumerate() ([]*resource.Resource, erro
 
 	for _, api := range apis {
 		a := api
-		authorizers, err := e.repository.ListAllRestApiAuthorizers(*a.Id)
+		authorizers, err := e.repository.ListAllRestApiAuthorizers(ctx, *a.Id)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -25,8 +27,8 @@ func (e *ApiGatewayGatewayResponseEnumerator) SupportedType() resource.ResourceT
 	return aws.AwsApiGatewayGatewayResponseResourceType
 }
 
-func (e *ApiGatewayGatewayResponseEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayGatewayResponseEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayRestApiResourceType)
 	}
@@ -35,7 +37,7 @@ func (e *ApiGatewayGatewayResponseEnumerator) Enumerate() ([]*resource.Resource,
 
 	for _, api := range apis {
 		a := api
-		gtwResponses, err := e.repository.ListAllRestApiGatewayResponses(*a.Id)
+		gtwResponses, err := e.repository.ListAllRestApiGatewayResponses(ctx, *a.Id)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayRestApiEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayRestApiResourceType
 }
 
-func (e *ApiGatewayRestApiEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayRestApiEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayVpcLinkEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayVpcLinkResourceType
 }
 
-func (e *ApiGatewayVpcLinkEnumerator) Enumerate() ([]*resource.Resource, error) {
-	vpcLinks, err := e.repository.ListAllVpcLinks()
+func (e *ApiGatewayVpcLinkEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	vpcLinks, err := e.repository.ListAllVpcLinks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
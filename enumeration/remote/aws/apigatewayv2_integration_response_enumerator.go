@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayV2IntegrationResponseEnumerator) SupportedType() resource.Res
 	return aws.AwsApiGatewayV2IntegrationResponseResourceType
 }
 
-func (e *ApiGatewayV2IntegrationResponseEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2IntegrationResponseEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2ApiResourceType)
 	}
@@ -33,14 +35,14 @@ func (e *ApiGatewayV2IntegrationResponseEnumerator) Enumerate() ([]*resource.Res
 
 	for _, a := range apis {
 		apiID := *a.ApiId
-		integrations, err := e.repository.ListAllApiIntegrations(apiID)
+		integrations, err := e.repository.ListAllApiIntegrations(ctx, apiID)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2IntegrationResourceType)
 		}
 
 		for _, integration := range integrations {
 			integrationId := *integration.IntegrationId
-			responses, err := e.repository.ListAllApiIntegrationResponses(apiID, integrationId)
+			responses, err := e.repository.ListAllApiIntegrationResponses(ctx, apiID, integrationId)
 			if err != nil {
 				return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 			}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -25,15 +27,15 @@ func (e *ApiGatewayV2MappingEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayV2MappingResourceType
 }
 
-func (e *ApiGatewayV2MappingEnumerator) Enumerate() ([]*resource.Resource, error) {
-	domainNames, err := e.repositoryV1.ListAllDomainNames()
+func (e *ApiGatewayV2MappingEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	domainNames, err := e.repositoryV1.ListAllDomainNames(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayDomainNameResourceType)
 	}
 
 	var results []*resource.Resource
 	for _, domainName := range domainNames {
-		mappings, err := e.repository.ListAllApiMappings(*domainName.DomainName)
+		mappings, err := e.repository.ListAllApiMappings(ctx, *domainName.DomainName)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
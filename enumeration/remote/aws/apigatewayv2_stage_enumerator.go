@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayV2StageEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayV2StageResourceType
 }
 
-func (e *ApiGatewayV2StageEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2StageEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2ApiResourceType)
 	}
@@ -32,7 +34,7 @@ func (e *ApiGatewayV2StageEnumerator) Enumerate() ([]*resource.Resource, error)
 	results := make([]*resource.Resource, 0)
 
 	for _, api := range apis {
-		stages, err := e.repository.ListAllApiStages(*api.ApiId)
+		stages, err := e.repository.ListAllApiStages(ctx, *api.ApiId)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,11 +25,11 @@ func (e *AppAutoscalingPolicyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsAppAutoscalingPolicyResourceType
 }
 
-func (e *AppAutoscalingPolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AppAutoscalingPolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0)
 
 	for _, ns := range e.repository.ServiceNamespaceValues() {
-		policies, err := e.repository.DescribeScalingPolicies(ns)
+		policies, err := e.repository.DescribeScalingPolicies(ctx, ns)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -25,11 +27,11 @@ func (e *AppAutoscalingScheduledActionEnumerator) SupportedType() resource.Resou
 	return aws.AwsAppAutoscalingScheduledActionResourceType
 }
 
-func (e *AppAutoscalingScheduledActionEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AppAutoscalingScheduledActionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0)
 
 	for _, ns := range e.repository.ServiceNamespaceValues() {
-		actions, err := e.repository.DescribeScheduledActions(ns)
+		actions, err := e.repository.DescribeScheduledActions(ctx, ns)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *CloudtrailEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsCloudtrailResourceType
 }
 
-func (e *CloudtrailEnumerator) Enumerate() ([]*resource.Resource, error) {
-	trails, err := e.repository.ListAllTrails()
+func (e *CloudtrailEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	trails, err := e.repository.ListAllTrails(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2AmiEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsAmiResourceType
 }
 
-func (e *EC2AmiEnumerator) Enumerate() ([]*resource.Resource, error) {
-	images, err := e.repository.ListAllImages()
+func (e *EC2AmiEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	images, err := e.repository.ListAllImages(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2DefaultRouteTableEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsDefaultRouteTableResourceType
 }
 
-func (e *EC2DefaultRouteTableEnumerator) Enumerate() ([]*resource.Resource, error) {
-	routeTables, err := e.repository.ListAllRouteTables()
+func (e *EC2DefaultRouteTableEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	routeTables, err := e.repository.ListAllRouteTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
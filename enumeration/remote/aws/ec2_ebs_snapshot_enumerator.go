@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2EbsSnapshotEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsEbsSnapshotResourceType
 }
 
-func (e *EC2EbsSnapshotEnumerator) Enumerate() ([]*resource.Resource, error) {
-	snapshots, err := e.repository.ListAllSnapshots()
+func (e *EC2EbsSnapshotEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	snapshots, err := e.repository.ListAllSnapshots(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2EipAssociationEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsEipAssociationResourceType
 }
 
-func (e *EC2EipAssociationEnumerator) Enumerate() ([]*resource.Resource, error) {
-	addresses, err := e.repository.ListAllAddressesAssociation()
+func (e *EC2EipAssociationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	addresses, err := e.repository.ListAllAddressesAssociation(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2NatGatewayEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsNatGatewayResourceType
 }
 
-func (e *EC2NatGatewayEnumerator) Enumerate() ([]*resource.Resource, error) {
-	natGateways, err := e.repository.ListAllNatGateways()
+func (e *EC2NatGatewayEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	natGateways, err := e.repository.ListAllNatGateways(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
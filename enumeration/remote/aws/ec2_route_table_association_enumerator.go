@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -24,8 +26,8 @@ func (e *EC2RouteTableAssociationEnumerator) SupportedType() resource.ResourceTy
 	return aws.AwsRouteTableAssociationResourceType
 }
 
-func (e *EC2RouteTableAssociationEnumerator) Enumerate() ([]*resource.Resource, error) {
-	routeTables, err := e.repository.ListAllRouteTables()
+func (e *EC2RouteTableAssociationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	routeTables, err := e.repository.ListAllRouteTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsRouteTableResourceType)
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -24,8 +26,8 @@ func (e *ECRRepositoryPolicyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsEcrRepositoryPolicyResourceType
 }
 
-func (e *ECRRepositoryPolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	repos, err := e.repository.ListAllRepositories()
+func (e *ECRRepositoryPolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	repos, err := e.repository.ListAllRepositories(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEcrRepositoryResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *ECRRepositoryPolicyEnumerator) Enumerate() ([]*resource.Resource, error
 	results := make([]*resource.Resource, 0, len(repos))
 
 	for _, repo := range repos {
-		repoOutput, err := e.repository.GetRepositoryPolicy(repo)
+		repoOutput, err := e.repository.GetRepositoryPolicy(ctx, repo)
 		if _, ok := err.(*ecr.RepositoryPolicyNotFoundException); ok {
 			continue
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ElastiCacheClusterEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsElastiCacheClusterResourceType
 }
 
-func (e *ElastiCacheClusterEnumerator) Enumerate() ([]*resource.Resource, error) {
-	clusters, err := e.repository.ListAllCacheClusters()
+func (e *ElastiCacheClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllCacheClusters(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,13 +25,13 @@ func (e *IamAccessKeyEnumerator) SupportedType() resource.ResourceType {
 	return resourceaws.AwsIamAccessKeyResourceType
 }
 
-func (e *IamAccessKeyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	users, err := e.repository.ListAllUsers()
+func (e *IamAccessKeyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	users, err := e.repository.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsIamUserResourceType)
 	}
 
-	keys, err := e.repository.ListAllAccessKeys(users)
+	keys, err := e.repository.ListAllAccessKeys(ctx, users)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
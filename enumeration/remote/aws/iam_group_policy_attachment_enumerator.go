@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -25,15 +27,15 @@ func (e *IamGroupPolicyAttachmentEnumerator) SupportedType() resource.ResourceTy
 	return resourceaws.AwsIamGroupPolicyAttachmentResourceType
 }
 
-func (e *IamGroupPolicyAttachmentEnumerator) Enumerate() ([]*resource.Resource, error) {
-	groups, err := e.repository.ListAllGroups()
+func (e *IamGroupPolicyAttachmentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	groups, err := e.repository.ListAllGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsIamGroupResourceType)
 	}
 
 	results := make([]*resource.Resource, 0)
 
-	policyAttachments, err := e.repository.ListAllGroupPolicyAttachments(groups)
+	policyAttachments, err := e.repository.ListAllGroupPolicyAttachments(ctx, groups)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
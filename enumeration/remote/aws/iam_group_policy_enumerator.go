@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,12 +25,12 @@ func (e *IamGroupPolicyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsIamGroupPolicyResourceType
 }
 
-func (e *IamGroupPolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	groups, err := e.repository.ListAllGroups()
+func (e *IamGroupPolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	groups, err := e.repository.ListAllGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsIamGroupResourceType)
 	}
-	groupPolicies, err := e.repository.ListAllGroupPolicies(groups)
+	groupPolicies, err := e.repository.ListAllGroupPolicies(ctx, groups)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
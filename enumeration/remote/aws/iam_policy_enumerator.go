@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -24,8 +26,8 @@ func (e *IamPolicyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsIamPolicyResourceType
 }
 
-func (e *IamPolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	policies, err := e.repository.ListAllPolicies()
+func (e *IamPolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	policies, err := e.repository.ListAllPolicies(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
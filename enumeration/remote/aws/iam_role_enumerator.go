@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -37,8 +39,8 @@ func awsIamRoleShouldBeIgnored(roleName string) bool {
 	return ok
 }
 
-func (e *IamRoleEnumerator) Enumerate() ([]*resource.Resource, error) {
-	roles, err := e.repository.ListAllRoles()
+func (e *IamRoleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	roles, err := e.repository.ListAllRoles(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
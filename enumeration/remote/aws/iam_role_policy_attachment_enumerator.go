@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -26,8 +28,8 @@ func (e *IamRolePolicyAttachmentEnumerator) SupportedType() resource.ResourceTyp
 	return resourceaws.AwsIamRolePolicyAttachmentResourceType
 }
 
-func (e *IamRolePolicyAttachmentEnumerator) Enumerate() ([]*resource.Resource, error) {
-	roles, err := e.repository.ListAllRoles()
+func (e *IamRolePolicyAttachmentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	roles, err := e.repository.ListAllRoles(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsIamRoleResourceType)
 	}
@@ -46,7 +48,7 @@ func (e *IamRolePolicyAttachmentEnumerator) Enumerate() ([]*resource.Resource, e
 		return results, nil
 	}
 
-	policyAttachments, err := e.repository.ListAllRolePolicyAttachments(rolesNotIgnored)
+	policyAttachments, err := e.repository.ListAllRolePolicyAttachments(ctx, rolesNotIgnored)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -25,13 +27,13 @@ func (e *IamRolePolicyEnumerator) SupportedType() resource.ResourceType {
 	return resourceaws.AwsIamRolePolicyResourceType
 }
 
-func (e *IamRolePolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	roles, err := e.repository.ListAllRoles()
+func (e *IamRolePolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	roles, err := e.repository.ListAllRoles(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsIamRoleResourceType)
 	}
 
-	policies, err := e.repository.ListAllRolePolicies(roles)
+	policies, err := e.repository.ListAllRolePolicies(ctx, roles)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
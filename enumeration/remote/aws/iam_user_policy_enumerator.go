@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,12 +25,12 @@ func (e *IamUserPolicyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsIamUserPolicyResourceType
 }
 
-func (e *IamUserPolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	users, err := e.repository.ListAllUsers()
+func (e *IamUserPolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	users, err := e.repository.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsIamUserResourceType)
 	}
-	userPolicies, err := e.repository.ListAllUserPolicies(users)
+	userPolicies, err := e.repository.ListAllUserPolicies(ctx, users)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
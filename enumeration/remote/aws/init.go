@@ -1,14 +1,22 @@
 package aws
 
 import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	client "github.com/snyk/driftctl/enumeration/remote/aws/client"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/traffic"
 )
 
 /**
@@ -16,22 +24,31 @@ import (
  * Required to use Scanner
  */
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, rateLimits *ratelimit.Controller, repositoryCache cache.Cache, capture *traffic.Capture) error {
 
 	provider, err := NewAWSTerraformProvider(version, progress, configDir)
 	if err != nil {
 		return err
 	}
+	captureRequests(provider, capture)
 	err = provider.CheckCredentialsExist()
 	if err != nil {
 		return err
 	}
-	err = provider.Init()
+	provider.SetParallelism(rateLimits.Parallelism())
+	// A replayed scan only needs the recorded schema, the provider is not started
+	if !capture.Replaying() {
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
+	}
+	tfProvider, err := capture.Provider(provider)
 	if err != nil {
 		return err
 	}
 
-	repositoryCache := cache.New(100)
+	limitRequests(provider.session, rateLimits)
 
 	s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(provider.session), repositoryCache)
 	s3ControlRepository := repository.NewS3ControlRepository(client.NewAWSClientFactory(provider.session), repositoryCache)
@@ -56,7 +73,7 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	elbRepository := repository.NewELBRepository(provider.session, repositoryCache)
 	elasticacheRepository := repository.NewElastiCacheRepository(provider.session, repositoryCache)
 
-	providerLibrary.AddProvider(terraform.AWS, provider)
+	providerLibrary.AddProvider(terraform.AWS, tfProvider)
 
 	remoteLibrary.AddEnumerator(NewS3BucketEnumerator(s3Repository, factory, provider.Config, alerter))
 	remoteLibrary.AddEnumerator(NewS3BucketInventoryEnumerator(s3Repository, factory, provider.Config, alerter))
@@ -188,3 +205,46 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 
 	return nil
 }
+
+// captureRequests records the session requests, or replays them with the recorded region and fake credentials
+func captureRequests(provider *AWSTerraformProvider, capture *traffic.Capture) {
+	if capture == nil {
+		return
+	}
+	region := capture.Setting("aws.region", aws.StringValue(provider.session.Config.Region))
+	provider.session.Config.Region = aws.String(region)
+	provider.Config.DefaultAlias = region
+	if capture.Replaying() {
+		provider.session.Config.Credentials = credentials.NewStaticCredentials("replay", "replay", "")
+	}
+
+	httpClient := &http.Client{}
+	if provider.session.Config.HTTPClient != nil {
+		// Do not alter the default client that the session may share
+		client := *provider.session.Config.HTTPClient
+		httpClient = &client
+	}
+	httpClient.Transport = capture.Transport("aws", httpClient.Transport)
+	provider.session.Config.HTTPClient = httpClient
+}
+
+// limitRequests makes every request of the session, retries included, wait for the limiter of its service
+// and slows the service down when AWS throttles us
+func limitRequests(sess *session.Session, rateLimits *ratelimit.Controller) {
+	// Sign handlers run before each attempt, an error stops the request
+	sess.Handlers.Sign.PushFront(func(r *request.Request) {
+		if err := rateLimits.Limiter(r.ClientInfo.ServiceName).Wait(r.Context()); err != nil {
+			r.Error = err
+		}
+	})
+	sess.Handlers.Retry.PushFront(func(r *request.Request) {
+		if r.IsErrorThrottle() {
+			rateLimits.Limiter(r.ClientInfo.ServiceName).Throttled()
+		}
+	})
+	sess.Handlers.Complete.PushBack(func(r *request.Request) {
+		if r.Error == nil {
+			rateLimits.Limiter(r.ClientInfo.ServiceName).Succeeded()
+		}
+	})
+}
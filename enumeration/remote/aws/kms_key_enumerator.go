@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *KMSKeyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsKmsKeyResourceType
 }
 
-func (e *KMSKeyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	keys, err := e.repository.ListAllKeys()
+func (e *KMSKeyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	keys, err := e.repository.ListAllKeys(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
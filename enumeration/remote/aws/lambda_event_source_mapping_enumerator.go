@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *LambdaEventSourceMappingEnumerator) SupportedType() resource.ResourceTy
 	return resourceaws.AwsLambdaEventSourceMappingResourceType
 }
 
-func (e *LambdaEventSourceMappingEnumerator) Enumerate() ([]*resource.Resource, error) {
-	eventSourceMappings, err := e.repository.ListAllLambdaEventSourceMappings()
+func (e *LambdaEventSourceMappingEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	eventSourceMappings, err := e.repository.ListAllLambdaEventSourceMappings(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *LambdaFunctionEnumerator) SupportedType() resource.ResourceType {
 	return resourceaws.AwsLambdaFunctionResourceType
 }
 
-func (e *LambdaFunctionEnumerator) Enumerate() ([]*resource.Resource, error) {
-	functions, err := e.repository.ListAllLambdaFunctions()
+func (e *LambdaFunctionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	functions, err := e.repository.ListAllLambdaFunctions(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
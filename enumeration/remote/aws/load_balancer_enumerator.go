@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *LoadBalancerEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsLoadBalancerResourceType
 }
 
-func (e *LoadBalancerEnumerator) Enumerate() ([]*resource.Resource, error) {
-	loadBalancers, err := e.repository.ListAllLoadBalancers()
+func (e *LoadBalancerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	loadBalancers, err := e.repository.ListAllLoadBalancers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -3,11 +3,13 @@ package aws
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/terraform"
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 )
@@ -72,6 +74,10 @@ func NewAWSTerraformProvider(version string, progress enumeration.ProgressCounte
 	if err != nil {
 		return nil, err
 	}
+	// Complete handlers run once per request, after every retry
+	p.session.Handlers.Complete.PushBack(func(r *request.Request) {
+		profile.RecordAPICall(r.Context(), r.RetryCount)
+	})
 
 	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
 		Name:         p.name,
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *RDSClusterEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsRDSClusterResourceType
 }
 
-func (e *RDSClusterEnumerator) Enumerate() ([]*resource.Resource, error) {
-	clusters, err := e.repository.ListAllDBClusters()
+func (e *RDSClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllDBClusters(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -11,9 +14,9 @@ import (
 
 type AppAutoScalingRepository interface {
 	ServiceNamespaceValues() []string
-	DescribeScalableTargets(string) ([]*applicationautoscaling.ScalableTarget, error)
-	DescribeScalingPolicies(string) ([]*applicationautoscaling.ScalingPolicy, error)
-	DescribeScheduledActions(string) ([]*applicationautoscaling.ScheduledAction, error)
+	DescribeScalableTargets(context.Context, string) ([]*applicationautoscaling.ScalableTarget, error)
+	DescribeScalingPolicies(context.Context, string) ([]*applicationautoscaling.ScalingPolicy, error)
+	DescribeScheduledActions(context.Context, string) ([]*applicationautoscaling.ScheduledAction, error)
 }
 
 type appAutoScalingRepository struct {
@@ -32,7 +35,10 @@ func (r *appAutoScalingRepository) ServiceNamespaceValues() []string {
 	return applicationautoscaling.ServiceNamespace_Values()
 }
 
-func (r *appAutoScalingRepository) DescribeScalableTargets(namespace string) ([]*applicationautoscaling.ScalableTarget, error) {
+func (r *appAutoScalingRepository) DescribeScalableTargets(ctx context.Context, namespace string) ([]*applicationautoscaling.ScalableTarget, error) {
+	ctx, done := profile.Method(ctx, "AppAutoScalingRepository.DescribeScalableTargets")
+	defer done()
+
 	cacheKey := fmt.Sprintf("appAutoScalingDescribeScalableTargets_%s", namespace)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*applicationautoscaling.ScalableTarget), nil
@@ -41,7 +47,7 @@ func (r *appAutoScalingRepository) DescribeScalableTargets(namespace string) ([]
 	input := &applicationautoscaling.DescribeScalableTargetsInput{
 		ServiceNamespace: &namespace,
 	}
-	result, err := r.client.DescribeScalableTargets(input)
+	result, err := r.client.DescribeScalableTargetsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +56,10 @@ func (r *appAutoScalingRepository) DescribeScalableTargets(namespace string) ([]
 	return result.ScalableTargets, nil
 }
 
-func (r *appAutoScalingRepository) DescribeScalingPolicies(namespace string) ([]*applicationautoscaling.ScalingPolicy, error) {
+func (r *appAutoScalingRepository) DescribeScalingPolicies(ctx context.Context, namespace string) ([]*applicationautoscaling.ScalingPolicy, error) {
+	ctx, done := profile.Method(ctx, "AppAutoScalingRepository.DescribeScalingPolicies")
+	defer done()
+
 	cacheKey := fmt.Sprintf("appAutoScalingDescribeScalingPolicies_%s", namespace)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*applicationautoscaling.ScalingPolicy), nil
@@ -59,7 +68,7 @@ func (r *appAutoScalingRepository) DescribeScalingPolicies(namespace string) ([]
 	input := &applicationautoscaling.DescribeScalingPoliciesInput{
 		ServiceNamespace: &namespace,
 	}
-	result, err := r.client.DescribeScalingPolicies(input)
+	result, err := r.client.DescribeScalingPoliciesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +77,10 @@ func (r *appAutoScalingRepository) DescribeScalingPolicies(namespace string) ([]
 	return result.ScalingPolicies, nil
 }
 
-func (r *appAutoScalingRepository) DescribeScheduledActions(namespace string) ([]*applicationautoscaling.ScheduledAction, error) {
+func (r *appAutoScalingRepository) DescribeScheduledActions(ctx context.Context, namespace string) ([]*applicationautoscaling.ScheduledAction, error) {
+	ctx, done := profile.Method(ctx, "AppAutoScalingRepository.DescribeScheduledActions")
+	defer done()
+
 	cacheKey := fmt.Sprintf("appAutoScalingDescribeScheduledActions_%s", namespace)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*applicationautoscaling.ScheduledAction), nil
@@ -77,7 +89,7 @@ func (r *appAutoScalingRepository) DescribeScheduledActions(namespace string) ([
 	input := &applicationautoscaling.DescribeScheduledActionsInput{
 		ServiceNamespace: &namespace,
 	}
-	result, err := r.client.DescribeScheduledActions(input)
+	result, err := r.client.DescribeScheduledActionsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
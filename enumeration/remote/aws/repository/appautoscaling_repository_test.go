@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -9,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/pkg/errors"
 	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/r3labs/diff/v2"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +35,7 @@ func Test_appautoscalingRepository_DescribeScalableTargets(t *testing.T) {
 				namespace: "test",
 			},
 			mocks: func(client *awstest.MockFakeApplicationAutoScaling, c *cache.MockCache) {
-				client.On("DescribeScalableTargets",
+				client.On("DescribeScalableTargetsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalableTargetsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(nil, errors.New("remote error")).Once()
@@ -54,7 +57,7 @@ func Test_appautoscalingRepository_DescribeScalableTargets(t *testing.T) {
 					},
 				}
 
-				client.On("DescribeScalableTargets",
+				client.On("DescribeScalableTargetsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalableTargetsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(&applicationautoscaling.DescribeScalableTargetsOutput{
@@ -101,7 +104,7 @@ func Test_appautoscalingRepository_DescribeScalableTargets(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeScalableTargets(tt.args.namespace)
+			got, err := r.DescribeScalableTargets(context.Background(), tt.args.namespace)
 			if err != nil {
 				assert.EqualError(t, tt.wantErr, err.Error())
 			} else {
@@ -141,7 +144,7 @@ func Test_appautoscalingRepository_DescribeScalingPolicies(t *testing.T) {
 				namespace: "test",
 			},
 			mocks: func(client *awstest.MockFakeApplicationAutoScaling, c *cache.MockCache) {
-				client.On("DescribeScalingPolicies",
+				client.On("DescribeScalingPoliciesWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalingPoliciesInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(nil, errors.New("remote error")).Once()
@@ -163,7 +166,7 @@ func Test_appautoscalingRepository_DescribeScalingPolicies(t *testing.T) {
 					},
 				}
 
-				client.On("DescribeScalingPolicies",
+				client.On("DescribeScalingPoliciesWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalingPoliciesInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(&applicationautoscaling.DescribeScalingPoliciesOutput{
@@ -210,7 +213,7 @@ func Test_appautoscalingRepository_DescribeScalingPolicies(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeScalingPolicies(tt.args.namespace)
+			got, err := r.DescribeScalingPolicies(context.Background(), tt.args.namespace)
 			if err != nil {
 				assert.EqualError(t, tt.wantErr, err.Error())
 			} else {
@@ -250,7 +253,7 @@ func Test_appautoscalingRepository_DescribeScheduledActions(t *testing.T) {
 				namespace: "test",
 			},
 			mocks: func(client *awstest.MockFakeApplicationAutoScaling, c *cache.MockCache) {
-				client.On("DescribeScheduledActions",
+				client.On("DescribeScheduledActionsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScheduledActionsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(nil, errors.New("remote error")).Once()
@@ -272,7 +275,7 @@ func Test_appautoscalingRepository_DescribeScheduledActions(t *testing.T) {
 					},
 				}
 
-				client.On("DescribeScheduledActions",
+				client.On("DescribeScheduledActionsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScheduledActionsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(&applicationautoscaling.DescribeScheduledActionsOutput{
@@ -319,7 +322,7 @@ func Test_appautoscalingRepository_DescribeScheduledActions(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeScheduledActions(tt.args.namespace)
+			got, err := r.DescribeScheduledActions(context.Background(), tt.args.namespace)
 			if err != nil {
 				assert.EqualError(t, tt.wantErr, err.Error())
 			} else {
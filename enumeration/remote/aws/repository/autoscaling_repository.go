@@ -1,14 +1,17 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 )
 
 type AutoScalingRepository interface {
-	DescribeLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error)
+	DescribeLaunchConfigurations(ctx context.Context) ([]*autoscaling.LaunchConfiguration, error)
 }
 
 type autoScalingRepository struct {
@@ -23,7 +26,10 @@ func NewAutoScalingRepository(session *session.Session, c cache.Cache) *autoScal
 	}
 }
 
-func (r *autoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error) {
+func (r *autoScalingRepository) DescribeLaunchConfigurations(ctx context.Context) ([]*autoscaling.LaunchConfiguration, error) {
+	ctx, done := profile.Method(ctx, "AutoScalingRepository.DescribeLaunchConfigurations")
+	defer done()
+
 	cacheKey := "DescribeLaunchConfigurations"
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*autoscaling.LaunchConfiguration), nil
@@ -31,7 +37,7 @@ func (r *autoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.L
 
 	var results []*autoscaling.LaunchConfiguration
 	input := &autoscaling.DescribeLaunchConfigurationsInput{}
-	err := r.client.DescribeLaunchConfigurationsPages(input, func(resp *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+	err := r.client.DescribeLaunchConfigurationsPagesWithContext(ctx, input, func(resp *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
 		results = append(results, resp.LaunchConfigurations...)
 		return !lastPage
 	})
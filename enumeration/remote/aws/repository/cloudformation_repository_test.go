@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -34,7 +36,7 @@ func Test_cloudformationRepository_ListAllStacks(t *testing.T) {
 		{
 			name: "list multiple stacks",
 			mocks: func(client *awstest.MockFakeCloudformation, store *cache.MockCache) {
-				client.On("DescribeStacksPages",
+				client.On("DescribeStacksPagesWithContext", mock.Anything,
 					&cloudformation.DescribeStacksInput{},
 					mock.MatchedBy(func(callback func(res *cloudformation.DescribeStacksOutput, lastPage bool) bool) bool {
 						callback(&cloudformation.DescribeStacksOutput{
@@ -68,7 +70,7 @@ func Test_cloudformationRepository_ListAllStacks(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllStacks()
+			got, err := r.ListAllStacks(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
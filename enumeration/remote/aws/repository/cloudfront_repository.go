@@ -1,14 +1,17 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 )
 
 type CloudfrontRepository interface {
-	ListAllDistributions() ([]*cloudfront.DistributionSummary, error)
+	ListAllDistributions(ctx context.Context) ([]*cloudfront.DistributionSummary, error)
 }
 
 type cloudfrontRepository struct {
@@ -23,14 +26,17 @@ func NewCloudfrontRepository(session *session.Session, c cache.Cache) *cloudfron
 	}
 }
 
-func (r *cloudfrontRepository) ListAllDistributions() ([]*cloudfront.DistributionSummary, error) {
+func (r *cloudfrontRepository) ListAllDistributions(ctx context.Context) ([]*cloudfront.DistributionSummary, error) {
+	ctx, done := profile.Method(ctx, "CloudfrontRepository.ListAllDistributions")
+	defer done()
+
 	if v := r.cache.Get("cloudfrontListAllDistributions"); v != nil {
 		return v.([]*cloudfront.DistributionSummary), nil
 	}
 
 	var distributions []*cloudfront.DistributionSummary
 	input := cloudfront.ListDistributionsInput{}
-	err := r.client.ListDistributionsPages(&input,
+	err := r.client.ListDistributionsPagesWithContext(ctx, &input,
 		func(resp *cloudfront.ListDistributionsOutput, lastPage bool) bool {
 			if resp.DistributionList != nil {
 				distributions = append(distributions, resp.DistributionList.Items...)
@@ -1,14 +1,17 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 )
 
 type DynamoDBRepository interface {
-	ListAllTables() ([]*string, error)
+	ListAllTables(ctx context.Context) ([]*string, error)
 }
 
 type dynamoDBRepository struct {
@@ -23,14 +26,17 @@ func NewDynamoDBRepository(session *session.Session, c cache.Cache) *dynamoDBRep
 	}
 }
 
-func (r *dynamoDBRepository) ListAllTables() ([]*string, error) {
+func (r *dynamoDBRepository) ListAllTables(ctx context.Context) ([]*string, error) {
+	ctx, done := profile.Method(ctx, "DynamoDBRepository.ListAllTables")
+	defer done()
+
 	if v := r.cache.Get("dynamodbListAllTables"); v != nil {
 		return v.([]*string), nil
 	}
 
 	var tables []*string
 	input := &dynamodb.ListTablesInput{}
-	err := r.client.ListTablesPages(input, func(res *dynamodb.ListTablesOutput, lastPage bool) bool {
+	err := r.client.ListTablesPagesWithContext(ctx, input, func(res *dynamodb.ListTablesOutput, lastPage bool) bool {
 		tables = append(tables, res.TableNames...)
 		return !lastPage
 	})
@@ -1,30 +1,33 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 )
 
 type EC2Repository interface {
-	ListAllImages() ([]*ec2.Image, error)
-	ListAllSnapshots() ([]*ec2.Snapshot, error)
-	ListAllVolumes() ([]*ec2.Volume, error)
-	ListAllAddresses() ([]*ec2.Address, error)
-	ListAllAddressesAssociation() ([]*ec2.Address, error)
-	ListAllInstances() ([]*ec2.Instance, error)
-	ListAllKeyPairs() ([]*ec2.KeyPairInfo, error)
-	ListAllInternetGateways() ([]*ec2.InternetGateway, error)
-	ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error)
-	ListAllNatGateways() ([]*ec2.NatGateway, error)
-	ListAllRouteTables() ([]*ec2.RouteTable, error)
-	ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error)
-	ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)
-	ListAllNetworkACLs() ([]*ec2.NetworkAcl, error)
-	DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error)
-	IsEbsEncryptionEnabledByDefault() (bool, error)
+	ListAllImages(ctx context.Context) ([]*ec2.Image, error)
+	ListAllSnapshots(ctx context.Context) ([]*ec2.Snapshot, error)
+	ListAllVolumes(ctx context.Context) ([]*ec2.Volume, error)
+	ListAllAddresses(ctx context.Context) ([]*ec2.Address, error)
+	ListAllAddressesAssociation(ctx context.Context) ([]*ec2.Address, error)
+	ListAllInstances(ctx context.Context) ([]*ec2.Instance, error)
+	ListAllKeyPairs(ctx context.Context) ([]*ec2.KeyPairInfo, error)
+	ListAllInternetGateways(ctx context.Context) ([]*ec2.InternetGateway, error)
+	ListAllSubnets(ctx context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error)
+	ListAllNatGateways(ctx context.Context) ([]*ec2.NatGateway, error)
+	ListAllRouteTables(ctx context.Context) ([]*ec2.RouteTable, error)
+	ListAllVPCs(ctx context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error)
+	ListAllSecurityGroups(ctx context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)
+	ListAllNetworkACLs(ctx context.Context) ([]*ec2.NetworkAcl, error)
+	DescribeLaunchTemplates(ctx context.Context) ([]*ec2.LaunchTemplate, error)
+	IsEbsEncryptionEnabledByDefault(ctx context.Context) (bool, error)
 }
 
 type ec2Repository struct {
@@ -39,7 +42,10 @@ func NewEC2Repository(session *session.Session, c cache.Cache) *ec2Repository {
 	}
 }
 
-func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
+func (r *ec2Repository) ListAllImages(ctx context.Context) ([]*ec2.Image, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllImages")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllImages"); v != nil {
 		return v.([]*ec2.Image), nil
 	}
@@ -49,7 +55,7 @@ func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
 			aws.String("self"),
 		},
 	}
-	images, err := r.client.DescribeImages(input)
+	images, err := r.client.DescribeImagesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +63,10 @@ func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
 	return images.Images, err
 }
 
-func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
+func (r *ec2Repository) ListAllSnapshots(ctx context.Context) ([]*ec2.Snapshot, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllSnapshots")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllSnapshots"); v != nil {
 		return v.([]*ec2.Snapshot), nil
 	}
@@ -68,7 +77,7 @@ func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 			aws.String("self"),
 		},
 	}
-	err := r.client.DescribeSnapshotsPages(input, func(res *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+	err := r.client.DescribeSnapshotsPagesWithContext(ctx, input, func(res *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
 		snapshots = append(snapshots, res.Snapshots...)
 		return !lastPage
 	})
@@ -79,14 +88,17 @@ func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	return snapshots, err
 }
 
-func (r *ec2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
+func (r *ec2Repository) ListAllVolumes(ctx context.Context) ([]*ec2.Volume, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllVolumes")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllVolumes"); v != nil {
 		return v.([]*ec2.Volume), nil
 	}
 
 	var volumes []*ec2.Volume
 	input := &ec2.DescribeVolumesInput{}
-	err := r.client.DescribeVolumesPages(input, func(res *ec2.DescribeVolumesOutput, lastPage bool) bool {
+	err := r.client.DescribeVolumesPagesWithContext(ctx, input, func(res *ec2.DescribeVolumesOutput, lastPage bool) bool {
 		volumes = append(volumes, res.Volumes...)
 		return !lastPage
 	})
@@ -97,7 +109,10 @@ func (r *ec2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
 	return volumes, nil
 }
 
-func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
+func (r *ec2Repository) ListAllAddresses(ctx context.Context) ([]*ec2.Address, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllAddresses")
+	defer done()
+
 	cacheKey := "ec2ListAllAddresses"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -106,7 +121,7 @@ func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	}
 
 	input := &ec2.DescribeAddressesInput{}
-	response, err := r.client.DescribeAddresses(input)
+	response, err := r.client.DescribeAddressesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -114,12 +129,15 @@ func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	return response.Addresses, nil
 }
 
-func (r *ec2Repository) ListAllAddressesAssociation() ([]*ec2.Address, error) {
+func (r *ec2Repository) ListAllAddressesAssociation(ctx context.Context) ([]*ec2.Address, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllAddressesAssociation")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllAddressesAssociation"); v != nil {
 		return v.([]*ec2.Address), nil
 	}
 
-	addresses, err := r.ListAllAddresses()
+	addresses, err := r.ListAllAddresses(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +152,10 @@ func (r *ec2Repository) ListAllAddressesAssociation() ([]*ec2.Address, error) {
 	return results, nil
 }
 
-func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
+func (r *ec2Repository) ListAllInstances(ctx context.Context) ([]*ec2.Instance, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllInstances")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllInstances"); v != nil {
 		return v.([]*ec2.Instance), nil
 	}
@@ -155,7 +176,7 @@ func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 			},
 		},
 	}
-	err := r.client.DescribeInstancesPages(input, func(res *ec2.DescribeInstancesOutput, lastPage bool) bool {
+	err := r.client.DescribeInstancesPagesWithContext(ctx, input, func(res *ec2.DescribeInstancesOutput, lastPage bool) bool {
 		for _, reservation := range res.Reservations {
 			instances = append(instances, reservation.Instances...)
 		}
@@ -168,13 +189,16 @@ func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 	return instances, nil
 }
 
-func (r *ec2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
+func (r *ec2Repository) ListAllKeyPairs(ctx context.Context) ([]*ec2.KeyPairInfo, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllKeyPairs")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllKeyPairs"); v != nil {
 		return v.([]*ec2.KeyPairInfo), nil
 	}
 
 	input := &ec2.DescribeKeyPairsInput{}
-	pairs, err := r.client.DescribeKeyPairs(input)
+	pairs, err := r.client.DescribeKeyPairsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -182,14 +206,17 @@ func (r *ec2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	return pairs.KeyPairs, err
 }
 
-func (r *ec2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error) {
+func (r *ec2Repository) ListAllInternetGateways(ctx context.Context) ([]*ec2.InternetGateway, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllInternetGateways")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllInternetGateways"); v != nil {
 		return v.([]*ec2.InternetGateway), nil
 	}
 
 	var internetGateways []*ec2.InternetGateway
 	input := ec2.DescribeInternetGatewaysInput{}
-	err := r.client.DescribeInternetGatewaysPages(&input,
+	err := r.client.DescribeInternetGatewaysPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool {
 			internetGateways = append(internetGateways, resp.InternetGateways...)
 			return !lastPage
@@ -202,7 +229,10 @@ func (r *ec2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error
 	return internetGateways, nil
 }
 
-func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
+func (r *ec2Repository) ListAllSubnets(ctx context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllSubnets")
+	defer done()
+
 	cacheKey := "ec2ListAllSubnets"
 	cacheSubnets := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -217,7 +247,7 @@ func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	input := ec2.DescribeSubnetsInput{}
 	var subnets []*ec2.Subnet
 	var defaultSubnets []*ec2.Subnet
-	err := r.client.DescribeSubnetsPages(&input,
+	err := r.client.DescribeSubnetsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeSubnetsOutput, lastPage bool) bool {
 			for _, subnet := range resp.Subnets {
 				if subnet.DefaultForAz != nil && *subnet.DefaultForAz {
@@ -236,14 +266,17 @@ func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	return subnets, defaultSubnets, nil
 }
 
-func (r *ec2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
+func (r *ec2Repository) ListAllNatGateways(ctx context.Context) ([]*ec2.NatGateway, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllNatGateways")
+	defer done()
+
 	if v := r.cache.Get("ec2ListAllNatGateways"); v != nil {
 		return v.([]*ec2.NatGateway), nil
 	}
 
 	var result []*ec2.NatGateway
 	input := ec2.DescribeNatGatewaysInput{}
-	err := r.client.DescribeNatGatewaysPages(&input,
+	err := r.client.DescribeNatGatewaysPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
 			result = append(result, resp.NatGateways...)
 			return !lastPage
@@ -258,7 +291,10 @@ func (r *ec2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
 	return result, nil
 }
 
-func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
+func (r *ec2Repository) ListAllRouteTables(ctx context.Context) ([]*ec2.RouteTable, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllRouteTables")
+	defer done()
+
 	cacheKey := "ec2ListAllRouteTables"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -268,7 +304,7 @@ func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 
 	var routeTables []*ec2.RouteTable
 	input := ec2.DescribeRouteTablesInput{}
-	err := r.client.DescribeRouteTablesPages(&input,
+	err := r.client.DescribeRouteTablesPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
 			routeTables = append(routeTables, resp.RouteTables...)
 			return !lastPage
@@ -283,7 +319,10 @@ func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 	return routeTables, nil
 }
 
-func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
+func (r *ec2Repository) ListAllVPCs(ctx context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllVPCs")
+	defer done()
+
 	cacheKey := "ec2ListAllVPCs"
 	cacheVPCs := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -297,7 +336,7 @@ func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	input := ec2.DescribeVpcsInput{}
 	var VPCs []*ec2.Vpc
 	var defaultVPCs []*ec2.Vpc
-	err := r.client.DescribeVpcsPages(&input,
+	err := r.client.DescribeVpcsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeVpcsOutput, lastPage bool) bool {
 			for _, vpc := range resp.Vpcs {
 				if vpc.IsDefault != nil && *vpc.IsDefault {
@@ -318,7 +357,10 @@ func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	return VPCs, defaultVPCs, nil
 }
 
-func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
+func (r *ec2Repository) ListAllSecurityGroups(ctx context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllSecurityGroups")
+	defer done()
+
 	cacheKey := "ec2ListAllSecurityGroups"
 	cacheSecurityGroups := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -332,7 +374,7 @@ func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.Se
 	var securityGroups []*ec2.SecurityGroup
 	var defaultSecurityGroups []*ec2.SecurityGroup
 	input := &ec2.DescribeSecurityGroupsInput{}
-	err := r.client.DescribeSecurityGroupsPages(input, func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+	err := r.client.DescribeSecurityGroupsPagesWithContext(ctx, input, func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
 		for _, securityGroup := range res.SecurityGroups {
 			if securityGroup.GroupName != nil && *securityGroup.GroupName == "default" {
 				defaultSecurityGroups = append(defaultSecurityGroups, securityGroup)
@@ -351,7 +393,9 @@ func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.Se
 	return securityGroups, defaultSecurityGroups, nil
 }
 
-func (r *ec2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
+func (r *ec2Repository) ListAllNetworkACLs(ctx context.Context) ([]*ec2.NetworkAcl, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.ListAllNetworkACLs")
+	defer done()
 
 	cacheKey := "ec2ListAllNetworkACLs"
 	v := r.cache.GetAndLock(cacheKey)
@@ -362,7 +406,7 @@ func (r *ec2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
 
 	var ACLs []*ec2.NetworkAcl
 	input := ec2.DescribeNetworkAclsInput{}
-	err := r.client.DescribeNetworkAclsPages(&input,
+	err := r.client.DescribeNetworkAclsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
 			ACLs = append(ACLs, resp.NetworkAcls...)
 			return !lastPage
@@ -377,14 +421,17 @@ func (r *ec2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
 	return ACLs, nil
 }
 
-func (r *ec2Repository) DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error) {
+func (r *ec2Repository) DescribeLaunchTemplates(ctx context.Context) ([]*ec2.LaunchTemplate, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.DescribeLaunchTemplates")
+	defer done()
+
 	cacheKey := "DescribeLaunchTemplates"
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*ec2.LaunchTemplate), nil
 	}
 
 	input := ec2.DescribeLaunchTemplatesInput{}
-	resp, err := r.client.DescribeLaunchTemplates(&input)
+	resp, err := r.client.DescribeLaunchTemplatesWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -393,13 +440,16 @@ func (r *ec2Repository) DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error)
 	return resp.LaunchTemplates, nil
 }
 
-func (r *ec2Repository) IsEbsEncryptionEnabledByDefault() (bool, error) {
+func (r *ec2Repository) IsEbsEncryptionEnabledByDefault(ctx context.Context) (bool, error) {
+	ctx, done := profile.Method(ctx, "EC2Repository.IsEbsEncryptionEnabledByDefault")
+	defer done()
+
 	if v := r.cache.Get("ec2IsEbsEncryptionEnabledByDefault"); v != nil {
 		return v.(bool), nil
 	}
 
 	input := &ec2.GetEbsEncryptionByDefaultInput{}
-	resp, err := r.client.GetEbsEncryptionByDefault(input)
+	resp, err := r.client.GetEbsEncryptionByDefaultWithContext(ctx, input)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -10,8 +13,8 @@ import (
 )
 
 type ECRRepository interface {
-	ListAllRepositories() ([]*ecr.Repository, error)
-	GetRepositoryPolicy(*ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error)
+	ListAllRepositories(ctx context.Context) ([]*ecr.Repository, error)
+	GetRepositoryPolicy(context.Context, *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error)
 }
 
 type ecrRepository struct {
@@ -26,14 +29,17 @@ func NewECRRepository(session *session.Session, c cache.Cache) *ecrRepository {
 	}
 }
 
-func (r *ecrRepository) ListAllRepositories() ([]*ecr.Repository, error) {
+func (r *ecrRepository) ListAllRepositories(ctx context.Context) ([]*ecr.Repository, error) {
+	ctx, done := profile.Method(ctx, "ECRRepository.ListAllRepositories")
+	defer done()
+
 	if v := r.cache.Get("ecrListAllRepositories"); v != nil {
 		return v.([]*ecr.Repository), nil
 	}
 
 	var repositories []*ecr.Repository
 	input := &ecr.DescribeRepositoriesInput{}
-	err := r.client.DescribeRepositoriesPages(input, func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+	err := r.client.DescribeRepositoriesPagesWithContext(ctx, input, func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
 		repositories = append(repositories, res.Repositories...)
 		return !lastPage
 	})
@@ -45,7 +51,10 @@ func (r *ecrRepository) ListAllRepositories() ([]*ecr.Repository, error) {
 	return repositories, nil
 }
 
-func (r *ecrRepository) GetRepositoryPolicy(repo *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error) {
+func (r *ecrRepository) GetRepositoryPolicy(ctx context.Context, repo *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error) {
+	ctx, done := profile.Method(ctx, "ECRRepository.GetRepositoryPolicy")
+	defer done()
+
 	cacheKey := fmt.Sprintf("ecrListAllRepositoriesGetRepositoryPolicy_%s_%s", *repo.RegistryId, *repo.RepositoryName)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.(*ecr.GetRepositoryPolicyOutput), nil
@@ -56,7 +65,7 @@ func (r *ecrRepository) GetRepositoryPolicy(repo *ecr.Repository) (*ecr.GetRepos
 		RepositoryName: repo.RepositoryName,
 	}
 
-	repoOutput, err := r.client.GetRepositoryPolicy(repositoryPolicyInput)
+	repoOutput, err := r.client.GetRepositoryPolicyWithContext(ctx, repositoryPolicyInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -29,7 +31,7 @@ func Test_ecrRepository_ListAllRepositories(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeECR) {
-				client.On("DescribeRepositoriesPages",
+				client.On("DescribeRepositoriesPagesWithContext", mock.Anything,
 					&ecr.DescribeRepositoriesInput{},
 					mock.MatchedBy(func(callback func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool) bool {
 						callback(&ecr.DescribeRepositoriesOutput{
@@ -68,12 +70,12 @@ func Test_ecrRepository_ListAllRepositories(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllRepositories()
+			got, err := r.ListAllRepositories(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllRepositories()
+				cachedData, err := r.ListAllRepositories(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ecr.Repository{}, store.Get("ecrListAllRepositories"))
@@ -103,7 +105,7 @@ func Test_ecrRepository_GetRepositoryPolicy(t *testing.T) {
 		{
 			name: "Get repository policy",
 			mocks: func(client *awstest.MockFakeECR) {
-				client.On("GetRepositoryPolicy",
+				client.On("GetRepositoryPolicyWithContext", mock.Anything,
 					&ecr.GetRepositoryPolicyInput{
 						RegistryId:     aws.String("1"),
 						RepositoryName: aws.String("2"),
@@ -121,7 +123,7 @@ func Test_ecrRepository_GetRepositoryPolicy(t *testing.T) {
 		{
 			name: "Get repository policy error",
 			mocks: func(client *awstest.MockFakeECR) {
-				client.On("GetRepositoryPolicy",
+				client.On("GetRepositoryPolicyWithContext", mock.Anything,
 					&ecr.GetRepositoryPolicyInput{
 						RegistryId:     aws.String("1"),
 						RepositoryName: aws.String("2"),
@@ -146,12 +148,12 @@ func Test_ecrRepository_GetRepositoryPolicy(t *testing.T) {
 				RepositoryName: aws.String("2"),
 			}
 
-			got, err := r.GetRepositoryPolicy(repo)
+			got, err := r.GetRepositoryPolicy(context.Background(), repo)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.GetRepositoryPolicy(repo)
+				cachedData, err := r.GetRepositoryPolicy(context.Background(), repo)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				cacheKey := fmt.Sprintf("ecrListAllRepositoriesGetRepositoryPolicy_%s_%s", *repo.RegistryId, *repo.RepositoryName)
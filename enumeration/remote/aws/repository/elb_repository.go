@@ -1,14 +1,17 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 )
 
 type ELBRepository interface {
-	ListAllLoadBalancers() ([]*elb.LoadBalancerDescription, error)
+	ListAllLoadBalancers(ctx context.Context) ([]*elb.LoadBalancerDescription, error)
 }
 
 type elbRepository struct {
@@ -23,14 +26,17 @@ func NewELBRepository(session *session.Session, c cache.Cache) *elbRepository {
 	}
 }
 
-func (r *elbRepository) ListAllLoadBalancers() ([]*elb.LoadBalancerDescription, error) {
+func (r *elbRepository) ListAllLoadBalancers(ctx context.Context) ([]*elb.LoadBalancerDescription, error) {
+	ctx, done := profile.Method(ctx, "ELBRepository.ListAllLoadBalancers")
+	defer done()
+
 	if v := r.cache.Get("elbListAllLoadBalancers"); v != nil {
 		return v.([]*elb.LoadBalancerDescription), nil
 	}
 
 	results := make([]*elb.LoadBalancerDescription, 0)
 	input := elb.DescribeLoadBalancersInput{}
-	err := r.client.DescribeLoadBalancersPages(&input, func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+	err := r.client.DescribeLoadBalancersPagesWithContext(ctx, &input, func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
 		results = append(results, res.LoadBalancerDescriptions...)
 		return !lastPage
 	})
@@ -1,17 +1,19 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 )
 
 type ELBV2Repository interface {
-	ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error)
-	ListAllLoadBalancerListeners(string) ([]*elbv2.Listener, error)
+	ListAllLoadBalancers(ctx context.Context) ([]*elbv2.LoadBalancer, error)
+	ListAllLoadBalancerListeners(context.Context, string) ([]*elbv2.Listener, error)
 }
 
 type elbv2Repository struct {
@@ -26,7 +28,10 @@ func NewELBV2Repository(session *session.Session, c cache.Cache) *elbv2Repositor
 	}
 }
 
-func (r *elbv2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error) {
+func (r *elbv2Repository) ListAllLoadBalancers(ctx context.Context) ([]*elbv2.LoadBalancer, error) {
+	ctx, done := profile.Method(ctx, "ELBV2Repository.ListAllLoadBalancers")
+	defer done()
+
 	cacheKey := "elbv2ListAllLoadBalancers"
 	defer r.cache.Unlock(cacheKey)
 	if v := r.cache.GetAndLock(cacheKey); v != nil {
@@ -35,7 +40,7 @@ func (r *elbv2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error)
 
 	results := make([]*elbv2.LoadBalancer, 0)
 	input := &elbv2.DescribeLoadBalancersInput{}
-	err := r.client.DescribeLoadBalancersPages(input, func(res *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+	err := r.client.DescribeLoadBalancersPagesWithContext(ctx, input, func(res *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
 		results = append(results, res.LoadBalancers...)
 		return !lastPage
 	})
@@ -46,7 +51,10 @@ func (r *elbv2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error)
 	return results, err
 }
 
-func (r *elbv2Repository) ListAllLoadBalancerListeners(loadBalancerArn string) ([]*elbv2.Listener, error) {
+func (r *elbv2Repository) ListAllLoadBalancerListeners(ctx context.Context, loadBalancerArn string) ([]*elbv2.Listener, error) {
+	ctx, done := profile.Method(ctx, "ELBV2Repository.ListAllLoadBalancerListeners")
+	defer done()
+
 	cacheKey := fmt.Sprintf("elbv2ListAllLoadBalancerListeners_%s", loadBalancerArn)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*elbv2.Listener), nil
@@ -56,7 +64,7 @@ func (r *elbv2Repository) ListAllLoadBalancerListeners(loadBalancerArn string) (
 	input := &elbv2.DescribeListenersInput{
 		LoadBalancerArn: &loadBalancerArn,
 	}
-	err := r.client.DescribeListenersPages(input, func(res *elbv2.DescribeListenersOutput, lastPage bool) bool {
+	err := r.client.DescribeListenersPagesWithContext(ctx, input, func(res *elbv2.DescribeListenersOutput, lastPage bool) bool {
 		results = append(results, res.Listeners...)
 		return !lastPage
 	})
@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,17 +14,17 @@ import (
 )
 
 type IAMRepository interface {
-	ListAllAccessKeys([]*iam.User) ([]*iam.AccessKeyMetadata, error)
-	ListAllUsers() ([]*iam.User, error)
-	ListAllPolicies() ([]*iam.Policy, error)
-	ListAllRoles() ([]*iam.Role, error)
-	ListAllRolePolicyAttachments([]*iam.Role) ([]*AttachedRolePolicy, error)
-	ListAllRolePolicies([]*iam.Role) ([]RolePolicy, error)
-	ListAllUserPolicyAttachments([]*iam.User) ([]*AttachedUserPolicy, error)
-	ListAllUserPolicies([]*iam.User) ([]string, error)
-	ListAllGroups() ([]*iam.Group, error)
-	ListAllGroupPolicies([]*iam.Group) ([]string, error)
-	ListAllGroupPolicyAttachments([]*iam.Group) ([]*AttachedGroupPolicy, error)
+	ListAllAccessKeys(context.Context, []*iam.User) ([]*iam.AccessKeyMetadata, error)
+	ListAllUsers(ctx context.Context) ([]*iam.User, error)
+	ListAllPolicies(ctx context.Context) ([]*iam.Policy, error)
+	ListAllRoles(ctx context.Context) ([]*iam.Role, error)
+	ListAllRolePolicyAttachments(context.Context, []*iam.Role) ([]*AttachedRolePolicy, error)
+	ListAllRolePolicies(context.Context, []*iam.Role) ([]RolePolicy, error)
+	ListAllUserPolicyAttachments(context.Context, []*iam.User) ([]*AttachedUserPolicy, error)
+	ListAllUserPolicies(context.Context, []*iam.User) ([]string, error)
+	ListAllGroups(ctx context.Context) ([]*iam.Group, error)
+	ListAllGroupPolicies(context.Context, []*iam.Group) ([]string, error)
+	ListAllGroupPolicyAttachments(context.Context, []*iam.Group) ([]*AttachedGroupPolicy, error)
 }
 
 type iamRepository struct {
@@ -36,7 +39,10 @@ func NewIAMRepository(session *session.Session, c cache.Cache) *iamRepository {
 	}
 }
 
-func (r *iamRepository) ListAllAccessKeys(users []*iam.User) ([]*iam.AccessKeyMetadata, error) {
+func (r *iamRepository) ListAllAccessKeys(ctx context.Context, users []*iam.User) ([]*iam.AccessKeyMetadata, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllAccessKeys")
+	defer done()
+
 	var resources []*iam.AccessKeyMetadata
 	for _, user := range users {
 		cacheKey := fmt.Sprintf("iamListAllAccessKeys_user_%s", *user.UserName)
@@ -49,7 +55,7 @@ func (r *iamRepository) ListAllAccessKeys(users []*iam.User) ([]*iam.AccessKeyMe
 		input := &iam.ListAccessKeysInput{
 			UserName: user.UserName,
 		}
-		err := r.client.ListAccessKeysPages(input, func(res *iam.ListAccessKeysOutput, lastPage bool) bool {
+		err := r.client.ListAccessKeysPagesWithContext(ctx, input, func(res *iam.ListAccessKeysOutput, lastPage bool) bool {
 			userResources = append(userResources, res.AccessKeyMetadata...)
 			return !lastPage
 		})
@@ -64,7 +70,9 @@ func (r *iamRepository) ListAllAccessKeys(users []*iam.User) ([]*iam.AccessKeyMe
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllUsers() ([]*iam.User, error) {
+func (r *iamRepository) ListAllUsers(ctx context.Context) ([]*iam.User, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllUsers")
+	defer done()
 
 	cacheKey := "iamListAllUsers"
 	v := r.cache.GetAndLock(cacheKey)
@@ -75,7 +83,7 @@ func (r *iamRepository) ListAllUsers() ([]*iam.User, error) {
 
 	var resources []*iam.User
 	input := &iam.ListUsersInput{}
-	err := r.client.ListUsersPages(input, func(res *iam.ListUsersOutput, lastPage bool) bool {
+	err := r.client.ListUsersPagesWithContext(ctx, input, func(res *iam.ListUsersOutput, lastPage bool) bool {
 		resources = append(resources, res.Users...)
 		return !lastPage
 	})
@@ -87,7 +95,10 @@ func (r *iamRepository) ListAllUsers() ([]*iam.User, error) {
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllPolicies() ([]*iam.Policy, error) {
+func (r *iamRepository) ListAllPolicies(ctx context.Context) ([]*iam.Policy, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllPolicies")
+	defer done()
+
 	if v := r.cache.Get("iamListAllPolicies"); v != nil {
 		return v.([]*iam.Policy), nil
 	}
@@ -96,7 +107,7 @@ func (r *iamRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
-	err := r.client.ListPoliciesPages(input, func(res *iam.ListPoliciesOutput, lastPage bool) bool {
+	err := r.client.ListPoliciesPagesWithContext(ctx, input, func(res *iam.ListPoliciesOutput, lastPage bool) bool {
 		resources = append(resources, res.Policies...)
 		return !lastPage
 	})
@@ -108,7 +119,10 @@ func (r *iamRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllRoles() ([]*iam.Role, error) {
+func (r *iamRepository) ListAllRoles(ctx context.Context) ([]*iam.Role, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllRoles")
+	defer done()
+
 	cacheKey := "iamListAllRoles"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -118,7 +132,7 @@ func (r *iamRepository) ListAllRoles() ([]*iam.Role, error) {
 
 	var resources []*iam.Role
 	input := &iam.ListRolesInput{}
-	err := r.client.ListRolesPages(input, func(res *iam.ListRolesOutput, lastPage bool) bool {
+	err := r.client.ListRolesPagesWithContext(ctx, input, func(res *iam.ListRolesOutput, lastPage bool) bool {
 		resources = append(resources, res.Roles...)
 		return !lastPage
 	})
@@ -130,7 +144,10 @@ func (r *iamRepository) ListAllRoles() ([]*iam.Role, error) {
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllRolePolicyAttachments(roles []*iam.Role) ([]*AttachedRolePolicy, error) {
+func (r *iamRepository) ListAllRolePolicyAttachments(ctx context.Context, roles []*iam.Role) ([]*AttachedRolePolicy, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllRolePolicyAttachments")
+	defer done()
+
 	var resources []*AttachedRolePolicy
 	for _, role := range roles {
 		cacheKey := fmt.Sprintf("iamListAllRolePolicyAttachments_role_%s", *role.RoleName)
@@ -143,7 +160,7 @@ func (r *iamRepository) ListAllRolePolicyAttachments(roles []*iam.Role) ([]*Atta
 		input := &iam.ListAttachedRolePoliciesInput{
 			RoleName: role.RoleName,
 		}
-		err := r.client.ListAttachedRolePoliciesPages(input, func(res *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		err := r.client.ListAttachedRolePoliciesPagesWithContext(ctx, input, func(res *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
 			for _, policy := range res.AttachedPolicies {
 				p := *policy
 				roleResources = append(roleResources, &AttachedRolePolicy{
@@ -164,7 +181,10 @@ func (r *iamRepository) ListAllRolePolicyAttachments(roles []*iam.Role) ([]*Atta
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllRolePolicies(roles []*iam.Role) ([]RolePolicy, error) {
+func (r *iamRepository) ListAllRolePolicies(ctx context.Context, roles []*iam.Role) ([]RolePolicy, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllRolePolicies")
+	defer done()
+
 	var resources []RolePolicy
 	for _, role := range roles {
 		cacheKey := fmt.Sprintf("iamListAllRolePolicies_role_%s", *role.RoleName)
@@ -177,7 +197,7 @@ func (r *iamRepository) ListAllRolePolicies(roles []*iam.Role) ([]RolePolicy, er
 		input := &iam.ListRolePoliciesInput{
 			RoleName: role.RoleName,
 		}
-		err := r.client.ListRolePoliciesPages(input, func(res *iam.ListRolePoliciesOutput, lastPage bool) bool {
+		err := r.client.ListRolePoliciesPagesWithContext(ctx, input, func(res *iam.ListRolePoliciesOutput, lastPage bool) bool {
 			for _, policy := range res.PolicyNames {
 				roleResources = append(roleResources, RolePolicy{*policy, *input.RoleName})
 			}
@@ -194,7 +214,10 @@ func (r *iamRepository) ListAllRolePolicies(roles []*iam.Role) ([]RolePolicy, er
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllUserPolicyAttachments(users []*iam.User) ([]*AttachedUserPolicy, error) {
+func (r *iamRepository) ListAllUserPolicyAttachments(ctx context.Context, users []*iam.User) ([]*AttachedUserPolicy, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllUserPolicyAttachments")
+	defer done()
+
 	var resources []*AttachedUserPolicy
 	for _, user := range users {
 		cacheKey := fmt.Sprintf("iamListAllUserPolicyAttachments_user_%s", *user.UserName)
@@ -207,7 +230,7 @@ func (r *iamRepository) ListAllUserPolicyAttachments(users []*iam.User) ([]*Atta
 		input := &iam.ListAttachedUserPoliciesInput{
 			UserName: user.UserName,
 		}
-		err := r.client.ListAttachedUserPoliciesPages(input, func(res *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		err := r.client.ListAttachedUserPoliciesPagesWithContext(ctx, input, func(res *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
 			for _, policy := range res.AttachedPolicies {
 				p := *policy
 				userResources = append(userResources, &AttachedUserPolicy{
@@ -228,7 +251,10 @@ func (r *iamRepository) ListAllUserPolicyAttachments(users []*iam.User) ([]*Atta
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllUserPolicies(users []*iam.User) ([]string, error) {
+func (r *iamRepository) ListAllUserPolicies(ctx context.Context, users []*iam.User) ([]string, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllUserPolicies")
+	defer done()
+
 	var resources []string
 	for _, user := range users {
 		cacheKey := fmt.Sprintf("iamListAllUserPolicies_user_%s", *user.UserName)
@@ -241,7 +267,7 @@ func (r *iamRepository) ListAllUserPolicies(users []*iam.User) ([]string, error)
 		input := &iam.ListUserPoliciesInput{
 			UserName: user.UserName,
 		}
-		err := r.client.ListUserPoliciesPages(input, func(res *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		err := r.client.ListUserPoliciesPagesWithContext(ctx, input, func(res *iam.ListUserPoliciesOutput, lastPage bool) bool {
 			for _, polName := range res.PolicyNames {
 				userResources = append(userResources, fmt.Sprintf("%s:%s", *input.UserName, *polName))
 			}
@@ -258,7 +284,9 @@ func (r *iamRepository) ListAllUserPolicies(users []*iam.User) ([]string, error)
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllGroups() ([]*iam.Group, error) {
+func (r *iamRepository) ListAllGroups(ctx context.Context) ([]*iam.Group, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllGroups")
+	defer done()
 
 	cacheKey := "iamListAllGroups"
 	v := r.cache.GetAndLock(cacheKey)
@@ -270,7 +298,7 @@ func (r *iamRepository) ListAllGroups() ([]*iam.Group, error) {
 
 	var resources []*iam.Group
 	input := &iam.ListGroupsInput{}
-	err := r.client.ListGroupsPages(input, func(res *iam.ListGroupsOutput, lastPage bool) bool {
+	err := r.client.ListGroupsPagesWithContext(ctx, input, func(res *iam.ListGroupsOutput, lastPage bool) bool {
 		resources = append(resources, res.Groups...)
 		return !lastPage
 	})
@@ -282,7 +310,10 @@ func (r *iamRepository) ListAllGroups() ([]*iam.Group, error) {
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllGroupPolicies(groups []*iam.Group) ([]string, error) {
+func (r *iamRepository) ListAllGroupPolicies(ctx context.Context, groups []*iam.Group) ([]string, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllGroupPolicies")
+	defer done()
+
 	var resources []string
 	for _, group := range groups {
 		cacheKey := fmt.Sprintf("iamListAllGroupPolicies_group_%s", *group.GroupName)
@@ -295,7 +326,7 @@ func (r *iamRepository) ListAllGroupPolicies(groups []*iam.Group) ([]string, err
 		input := &iam.ListGroupPoliciesInput{
 			GroupName: group.GroupName,
 		}
-		err := r.client.ListGroupPoliciesPages(input, func(res *iam.ListGroupPoliciesOutput, lastPage bool) bool {
+		err := r.client.ListGroupPoliciesPagesWithContext(ctx, input, func(res *iam.ListGroupPoliciesOutput, lastPage bool) bool {
 			for _, polName := range res.PolicyNames {
 				groupResources = append(groupResources, fmt.Sprintf("%s:%s", *input.GroupName, *polName))
 			}
@@ -312,7 +343,10 @@ func (r *iamRepository) ListAllGroupPolicies(groups []*iam.Group) ([]string, err
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllGroupPolicyAttachments(groups []*iam.Group) ([]*AttachedGroupPolicy, error) {
+func (r *iamRepository) ListAllGroupPolicyAttachments(ctx context.Context, groups []*iam.Group) ([]*AttachedGroupPolicy, error) {
+	ctx, done := profile.Method(ctx, "IAMRepository.ListAllGroupPolicyAttachments")
+	defer done()
+
 	var resources []*AttachedGroupPolicy
 	for _, group := range groups {
 		cacheKey := fmt.Sprintf("iamListAllGroupPolicyAttachments_%s", *group.GroupId)
@@ -325,7 +359,7 @@ func (r *iamRepository) ListAllGroupPolicyAttachments(groups []*iam.Group) ([]*A
 		input := &iam.ListAttachedGroupPoliciesInput{
 			GroupName: group.GroupName,
 		}
-		err := r.client.ListAttachedGroupPoliciesPages(input, func(res *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		err := r.client.ListAttachedGroupPoliciesPagesWithContext(ctx, input, func(res *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
 			for _, policy := range res.AttachedPolicies {
 				p := *policy
 				attachedGroupPolicies = append(attachedGroupPolicies, &AttachedGroupPolicy{
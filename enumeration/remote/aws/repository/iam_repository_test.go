@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -36,7 +38,7 @@ func Test_IAMRepository_ListAllAccessKeys(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeIAM) {
 
-				client.On("ListAccessKeysPages",
+				client.On("ListAccessKeysPagesWithContext", mock.Anything,
 					&iam.ListAccessKeysInput{
 						UserName: aws.String("test-driftctl"),
 					},
@@ -55,7 +57,7 @@ func Test_IAMRepository_ListAllAccessKeys(t *testing.T) {
 						}}, true)
 						return true
 					})).Return(nil).Once()
-				client.On("ListAccessKeysPages",
+				client.On("ListAccessKeysPagesWithContext", mock.Anything,
 					&iam.ListAccessKeysInput{
 						UserName: aws.String("test-driftctl2"),
 					},
@@ -112,12 +114,12 @@ func Test_IAMRepository_ListAllAccessKeys(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllAccessKeys(tt.users)
+			got, err := r.ListAllAccessKeys(context.Background(), tt.users)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAccessKeys(tt.users)
+				cachedData, err := r.ListAllAccessKeys(context.Background(), tt.users)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				for _, user := range tt.users {
@@ -148,7 +150,7 @@ func Test_IAMRepository_ListAllUsers(t *testing.T) {
 			name: "List only users with multiple pages",
 			mocks: func(client *awstest.MockFakeIAM) {
 
-				client.On("ListUsersPages",
+				client.On("ListUsersPagesWithContext", mock.Anything,
 					&iam.ListUsersInput{},
 					mock.MatchedBy(func(callback func(res *iam.ListUsersOutput, lastPage bool) bool) bool {
 						callback(&iam.ListUsersOutput{Users: []*iam.User{
@@ -195,12 +197,12 @@ func Test_IAMRepository_ListAllUsers(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllUsers()
+			got, err := r.ListAllUsers(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllUsers()
+				cachedData, err := r.ListAllUsers(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*iam.User{}, store.Get("iamListAllUsers"))
@@ -229,7 +231,7 @@ func Test_IAMRepository_ListAllPolicies(t *testing.T) {
 			name: "List only policies with multiple pages",
 			mocks: func(client *awstest.MockFakeIAM) {
 
-				client.On("ListPoliciesPages",
+				client.On("ListPoliciesPagesWithContext", mock.Anything,
 					&iam.ListPoliciesInput{Scope: aws.String(iam.PolicyScopeTypeLocal)},
 					mock.MatchedBy(func(callback func(res *iam.ListPoliciesOutput, lastPage bool) bool) bool {
 						callback(&iam.ListPoliciesOutput{Policies: []*iam.Policy{
@@ -276,12 +278,12 @@ func Test_IAMRepository_ListAllPolicies(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllPolicies()
+			got, err := r.ListAllPolicies(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllPolicies()
+				cachedData, err := r.ListAllPolicies(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*iam.Policy{}, store.Get("iamListAllPolicies"))
@@ -310,7 +312,7 @@ func Test_IAMRepository_ListAllRoles(t *testing.T) {
 			name: "List only roles with multiple pages",
 			mocks: func(client *awstest.MockFakeIAM) {
 
-				client.On("ListRolesPages",
+				client.On("ListRolesPagesWithContext", mock.Anything,
 					&iam.ListRolesInput{},
 					mock.MatchedBy(func(callback func(res *iam.ListRolesOutput, lastPage bool) bool) bool {
 						callback(&iam.ListRolesOutput{Roles: []*iam.Role{
@@ -357,12 +359,12 @@ func Test_IAMRepository_ListAllRoles(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRoles()
+			got, err := r.ListAllRoles(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllRoles()
+				cachedData, err := r.ListAllRoles(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*iam.Role{}, store.Get("iamListAllRoles"))
@@ -403,7 +405,7 @@ func Test_IAMRepository_ListAllRolePolicyAttachments(t *testing.T) {
 				shouldSkipfirst := false
 				shouldSkipSecond := false
 
-				client.On("ListAttachedRolePoliciesPages",
+				client.On("ListAttachedRolePoliciesPagesWithContext", mock.Anything,
 					&iam.ListAttachedRolePoliciesInput{
 						RoleName: aws.String("test-role"),
 					},
@@ -431,7 +433,7 @@ func Test_IAMRepository_ListAllRolePolicyAttachments(t *testing.T) {
 						return true
 					})).Return(nil).Once()
 
-				client.On("ListAttachedRolePoliciesPages",
+				client.On("ListAttachedRolePoliciesPagesWithContext", mock.Anything,
 					&iam.ListAttachedRolePoliciesInput{
 						RoleName: aws.String("test-role2"),
 					},
@@ -514,12 +516,12 @@ func Test_IAMRepository_ListAllRolePolicyAttachments(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRolePolicyAttachments(tt.roles)
+			got, err := r.ListAllRolePolicyAttachments(context.Background(), tt.roles)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllRolePolicyAttachments(tt.roles)
+				cachedData, err := r.ListAllRolePolicyAttachments(context.Background(), tt.roles)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				for _, role := range tt.roles {
@@ -559,7 +561,7 @@ func Test_IAMRepository_ListAllRolePolicies(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeIAM) {
 				firstMockCalled := false
-				client.On("ListRolePoliciesPages",
+				client.On("ListRolePoliciesPagesWithContext", mock.Anything,
 					&iam.ListRolePoliciesInput{
 						RoleName: aws.String("test_role_0"),
 					},
@@ -581,7 +583,7 @@ func Test_IAMRepository_ListAllRolePolicies(t *testing.T) {
 						firstMockCalled = true
 						return true
 					})).Once().Return(nil)
-				client.On("ListRolePoliciesPages",
+				client.On("ListRolePoliciesPagesWithContext", mock.Anything,
 					&iam.ListRolePoliciesInput{
 						RoleName: aws.String("test_role_1"),
 					},
@@ -619,12 +621,12 @@ func Test_IAMRepository_ListAllRolePolicies(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRolePolicies(tt.roles)
+			got, err := r.ListAllRolePolicies(context.Background(), tt.roles)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllRolePolicies(tt.roles)
+				cachedData, err := r.ListAllRolePolicies(context.Background(), tt.roles)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				for _, role := range tt.roles {
@@ -664,7 +666,7 @@ func Test_IAMRepository_ListAllUserPolicyAttachments(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeIAM) {
 
-				client.On("ListAttachedUserPoliciesPages",
+				client.On("ListAttachedUserPoliciesPagesWithContext", mock.Anything,
 					&iam.ListAttachedUserPoliciesInput{
 						UserName: aws.String("loadbalancer"),
 					},
@@ -688,7 +690,7 @@ func Test_IAMRepository_ListAllUserPolicyAttachments(t *testing.T) {
 						return true
 					})).Return(nil).Once()
 
-				client.On("ListAttachedUserPoliciesPages",
+				client.On("ListAttachedUserPoliciesPagesWithContext", mock.Anything,
 					&iam.ListAttachedUserPoliciesInput{
 						UserName: aws.String("loadbalancer2"),
 					},
@@ -789,12 +791,12 @@ func Test_IAMRepository_ListAllUserPolicyAttachments(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllUserPolicyAttachments(tt.users)
+			got, err := r.ListAllUserPolicyAttachments(context.Background(), tt.users)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllUserPolicyAttachments(tt.users)
+				cachedData, err := r.ListAllUserPolicyAttachments(context.Background(), tt.users)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				for _, user := range tt.users {
@@ -834,7 +836,7 @@ func Test_IAMRepository_ListAllUserPolicies(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeIAM) {
 
-				client.On("ListUserPoliciesPages",
+				client.On("ListUserPoliciesPagesWithContext", mock.Anything,
 					&iam.ListUserPoliciesInput{
 						UserName: aws.String("loadbalancer"),
 					},
@@ -850,7 +852,7 @@ func Test_IAMRepository_ListAllUserPolicies(t *testing.T) {
 						return true
 					})).Return(nil).Once()
 
-				client.On("ListUserPoliciesPages",
+				client.On("ListUserPoliciesPagesWithContext", mock.Anything,
 					&iam.ListUserPoliciesInput{
 						UserName: aws.String("loadbalancer2"),
 					},
@@ -891,12 +893,12 @@ func Test_IAMRepository_ListAllUserPolicies(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllUserPolicies(tt.users)
+			got, err := r.ListAllUserPolicies(context.Background(), tt.users)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllUserPolicies(tt.users)
+				cachedData, err := r.ListAllUserPolicies(context.Background(), tt.users)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				for _, user := range tt.users {
@@ -927,7 +929,7 @@ func Test_IAMRepository_ListAllGroups(t *testing.T) {
 			name: "List groups with multiple pages",
 			mocks: func(client *awstest.MockFakeIAM) {
 
-				client.On("ListGroupsPages",
+				client.On("ListGroupsPagesWithContext", mock.Anything,
 					&iam.ListGroupsInput{},
 					mock.MatchedBy(func(callback func(res *iam.ListGroupsOutput, lastPage bool) bool) bool {
 						callback(&iam.ListGroupsOutput{Groups: []*iam.Group{
@@ -974,12 +976,12 @@ func Test_IAMRepository_ListAllGroups(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllGroups()
+			got, err := r.ListAllGroups(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllGroups()
+				cachedData, err := r.ListAllGroups(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*iam.Group{}, store.Get("iamListAllGroups"))
@@ -1017,7 +1019,7 @@ func Test_IAMRepository_ListAllGroupPolicies(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeIAM) {
 				firstMockCalled := false
-				client.On("ListGroupPoliciesPages",
+				client.On("ListGroupPoliciesPagesWithContext", mock.Anything,
 					&iam.ListGroupPoliciesInput{
 						GroupName: aws.String("group1"),
 					},
@@ -1037,7 +1039,7 @@ func Test_IAMRepository_ListAllGroupPolicies(t *testing.T) {
 						return true
 					})).Return(nil).Once()
 
-				client.On("ListGroupPoliciesPages",
+				client.On("ListGroupPoliciesPagesWithContext", mock.Anything,
 					&iam.ListGroupPoliciesInput{
 						GroupName: aws.String("group2"),
 					},
@@ -1074,12 +1076,12 @@ func Test_IAMRepository_ListAllGroupPolicies(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllGroupPolicies(tt.groups)
+			got, err := r.ListAllGroupPolicies(context.Background(), tt.groups)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllGroupPolicies(tt.groups)
+				cachedData, err := r.ListAllGroupPolicies(context.Background(), tt.groups)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				for _, group := range tt.groups {
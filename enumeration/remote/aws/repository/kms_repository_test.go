@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"sync"
@@ -25,7 +27,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 		{
 			name: "List only enabled keys",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListKeysPages",
+				client.On("ListKeysPagesWithContext", mock.Anything,
 					&kms.ListKeysInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListKeysOutput, lastPage bool) bool) bool {
 						callback(&kms.ListKeysOutput{
@@ -36,7 +38,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						}, true)
 						return true
 					})).Return(nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("1"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -46,7 +48,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						KeyState:   aws.String(kms.KeyStateEnabled),
 					},
 				}, nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("2"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -64,7 +66,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 		{
 			name: "List only customer keys",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListKeysPages",
+				client.On("ListKeysPagesWithContext", mock.Anything,
 					&kms.ListKeysInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListKeysOutput, lastPage bool) bool) bool {
 						callback(&kms.ListKeysOutput{
@@ -76,7 +78,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						}, true)
 						return true
 					})).Return(nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("1"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -86,7 +88,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						KeyState:   aws.String(kms.KeyStateEnabled),
 					},
 				}, nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("2"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -96,7 +98,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						KeyState:   aws.String(kms.KeyStateEnabled),
 					},
 				}, nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("3"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -122,12 +124,12 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 				cache:           store,
 				describeKeyLock: &sync.Mutex{},
 			}
-			got, err := r.ListAllKeys()
+			got, err := r.ListAllKeys(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllKeys()
+				cachedData, err := r.ListAllKeys(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*kms.KeyListEntry{}, store.Get("kmsListAllKeys"))
@@ -155,7 +157,7 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 		{
 			name: "List only aliases for enabled keys",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListAliasesPages",
+				client.On("ListAliasesPagesWithContext", mock.Anything,
 					&kms.ListAliasesInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListAliasesOutput, lastPage bool) bool) bool {
 						callback(&kms.ListAliasesOutput{
@@ -166,12 +168,12 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 						}, true)
 						return true
 					})).Return(nil).Once()
-				client.On("DescribeKey", &kms.DescribeKeyInput{KeyId: aws.String("key-id-1")}).Return(&kms.DescribeKeyOutput{
+				client.On("DescribeKeyWithContext", mock.Anything, &kms.DescribeKeyInput{KeyId: aws.String("key-id-1")}).Return(&kms.DescribeKeyOutput{
 					KeyMetadata: &kms.KeyMetadata{
 						KeyState: aws.String(kms.KeyStatePendingDeletion),
 					},
 				}, nil)
-				client.On("DescribeKey", &kms.DescribeKeyInput{KeyId: aws.String("key-id-2")}).Return(&kms.DescribeKeyOutput{
+				client.On("DescribeKeyWithContext", mock.Anything, &kms.DescribeKeyInput{KeyId: aws.String("key-id-2")}).Return(&kms.DescribeKeyOutput{
 					KeyMetadata: &kms.KeyMetadata{
 						KeyState: aws.String(kms.KeyStateEnabled),
 					},
@@ -184,7 +186,7 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 		{
 			name: "List only customer aliases",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListAliasesPages",
+				client.On("ListAliasesPagesWithContext", mock.Anything,
 					&kms.ListAliasesInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListAliasesOutput, lastPage bool) bool) bool {
 						callback(&kms.ListAliasesOutput{
@@ -200,7 +202,7 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 						}, true)
 						return true
 					})).Return(nil).Once()
-				client.On("DescribeKey", mock.Anything).Return(&kms.DescribeKeyOutput{
+				client.On("DescribeKeyWithContext", mock.Anything, mock.Anything).Return(&kms.DescribeKeyOutput{
 					KeyMetadata: &kms.KeyMetadata{
 						KeyState: aws.String(kms.KeyStateEnabled),
 					},
@@ -225,12 +227,12 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 				cache:           store,
 				describeKeyLock: &sync.Mutex{},
 			}
-			got, err := r.ListAllAliases()
+			got, err := r.ListAllAliases(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAliases()
+				cachedData, err := r.ListAllAliases(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*kms.AliasListEntry{}, store.Get("kmsListAllAliases"))
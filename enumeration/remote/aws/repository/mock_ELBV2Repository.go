@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	elbv2 "github.com/aws/aws-sdk-go/service/elbv2"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockELBV2Repository struct {
 	mock.Mock
 }
 
-// ListAllLoadBalancerListeners provides a mock function with given fields: _a0
-func (_m *MockELBV2Repository) ListAllLoadBalancerListeners(_a0 string) ([]*elbv2.Listener, error) {
-	ret := _m.Called(_a0)
+// ListAllLoadBalancerListeners provides a mock function with given fields: _a0, _a1
+func (_m *MockELBV2Repository) ListAllLoadBalancerListeners(_a0 context.Context, _a1 string) ([]*elbv2.Listener, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*elbv2.Listener
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*elbv2.Listener, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*elbv2.Listener, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*elbv2.Listener); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*elbv2.Listener); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*elbv2.Listener)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockELBV2Repository) ListAllLoadBalancerListeners(_a0 string) ([]*elbv
 	return r0, r1
 }
 
-// ListAllLoadBalancers provides a mock function with given fields:
-func (_m *MockELBV2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error) {
-	ret := _m.Called()
+// ListAllLoadBalancers provides a mock function with given fields: ctx
+func (_m *MockELBV2Repository) ListAllLoadBalancers(ctx context.Context) ([]*elbv2.LoadBalancer, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*elbv2.LoadBalancer
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*elbv2.LoadBalancer, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*elbv2.LoadBalancer, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*elbv2.LoadBalancer); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*elbv2.LoadBalancer); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*elbv2.LoadBalancer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
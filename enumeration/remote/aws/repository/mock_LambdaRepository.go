@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	lambda "github.com/aws/aws-sdk-go/service/lambda"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockLambdaRepository struct {
 	mock.Mock
 }
 
-// ListAllLambdaEventSourceMappings provides a mock function with given fields:
-func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.EventSourceMappingConfiguration, error) {
-	ret := _m.Called()
+// ListAllLambdaEventSourceMappings provides a mock function with given fields: ctx
+func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings(ctx context.Context) ([]*lambda.EventSourceMappingConfiguration, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*lambda.EventSourceMappingConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*lambda.EventSourceMappingConfiguration, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*lambda.EventSourceMappingConfiguration, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*lambda.EventSourceMappingConfiguration); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*lambda.EventSourceMappingConfiguration); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*lambda.EventSourceMappingConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.Ev
 	return r0, r1
 }
 
-// ListAllLambdaFunctions provides a mock function with given fields:
-func (_m *MockLambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConfiguration, error) {
-	ret := _m.Called()
+// ListAllLambdaFunctions provides a mock function with given fields: ctx
+func (_m *MockLambdaRepository) ListAllLambdaFunctions(ctx context.Context) ([]*lambda.FunctionConfiguration, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*lambda.FunctionConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*lambda.FunctionConfiguration, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*lambda.FunctionConfiguration, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*lambda.FunctionConfiguration); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*lambda.FunctionConfiguration); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*lambda.FunctionConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
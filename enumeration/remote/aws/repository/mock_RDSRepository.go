@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	rds "github.com/aws/aws-sdk-go/service/rds"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockRDSRepository struct {
 	mock.Mock
 }
 
-// ListAllDBClusters provides a mock function with given fields:
-func (_m *MockRDSRepository) ListAllDBClusters() ([]*rds.DBCluster, error) {
-	ret := _m.Called()
+// ListAllDBClusters provides a mock function with given fields: ctx
+func (_m *MockRDSRepository) ListAllDBClusters(ctx context.Context) ([]*rds.DBCluster, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*rds.DBCluster
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*rds.DBCluster, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*rds.DBCluster, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*rds.DBCluster); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*rds.DBCluster); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*rds.DBCluster)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockRDSRepository) ListAllDBClusters() ([]*rds.DBCluster, error) {
 	return r0, r1
 }
 
-// ListAllDBInstances provides a mock function with given fields:
-func (_m *MockRDSRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
-	ret := _m.Called()
+// ListAllDBInstances provides a mock function with given fields: ctx
+func (_m *MockRDSRepository) ListAllDBInstances(ctx context.Context) ([]*rds.DBInstance, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*rds.DBInstance
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*rds.DBInstance, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*rds.DBInstance, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*rds.DBInstance); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*rds.DBInstance); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*rds.DBInstance)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +66,25 @@ func (_m *MockRDSRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
 	return r0, r1
 }
 
-// ListAllDBSubnetGroups provides a mock function with given fields:
-func (_m *MockRDSRepository) ListAllDBSubnetGroups() ([]*rds.DBSubnetGroup, error) {
-	ret := _m.Called()
+// ListAllDBSubnetGroups provides a mock function with given fields: ctx
+func (_m *MockRDSRepository) ListAllDBSubnetGroups(ctx context.Context) ([]*rds.DBSubnetGroup, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*rds.DBSubnetGroup
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*rds.DBSubnetGroup, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*rds.DBSubnetGroup, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*rds.DBSubnetGroup); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*rds.DBSubnetGroup); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*rds.DBSubnetGroup)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
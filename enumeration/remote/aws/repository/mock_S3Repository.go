@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	s3 "github.com/aws/aws-sdk-go/service/s3"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,23 +14,23 @@ type MockS3Repository struct {
 	mock.Mock
 }
 
-// GetBucketLocation provides a mock function with given fields: bucketName
-func (_m *MockS3Repository) GetBucketLocation(bucketName string) (string, error) {
-	ret := _m.Called(bucketName)
+// GetBucketLocation provides a mock function with given fields: ctx, bucketName
+func (_m *MockS3Repository) GetBucketLocation(ctx context.Context, bucketName string) (string, error) {
+	ret := _m.Called(ctx, bucketName)
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return rf(bucketName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, bucketName)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(bucketName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, bucketName)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(bucketName)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bucketName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,25 +38,25 @@ func (_m *MockS3Repository) GetBucketLocation(bucketName string) (string, error)
 	return r0, r1
 }
 
-// GetBucketNotification provides a mock function with given fields: bucketName, region
-func (_m *MockS3Repository) GetBucketNotification(bucketName string, region string) (*s3.NotificationConfiguration, error) {
-	ret := _m.Called(bucketName, region)
+// GetBucketNotification provides a mock function with given fields: ctx, bucketName, region
+func (_m *MockS3Repository) GetBucketNotification(ctx context.Context, bucketName string, region string) (*s3.NotificationConfiguration, error) {
+	ret := _m.Called(ctx, bucketName, region)
 
 	var r0 *s3.NotificationConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*s3.NotificationConfiguration, error)); ok {
-		return rf(bucketName, region)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*s3.NotificationConfiguration, error)); ok {
+		return rf(ctx, bucketName, region)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *s3.NotificationConfiguration); ok {
-		r0 = rf(bucketName, region)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *s3.NotificationConfiguration); ok {
+		r0 = rf(ctx, bucketName, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*s3.NotificationConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(bucketName, region)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bucketName, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,25 +64,25 @@ func (_m *MockS3Repository) GetBucketNotification(bucketName string, region stri
 	return r0, r1
 }
 
-// GetBucketPolicy provides a mock function with given fields: bucketName, region
-func (_m *MockS3Repository) GetBucketPolicy(bucketName string, region string) (*string, error) {
-	ret := _m.Called(bucketName, region)
+// GetBucketPolicy provides a mock function with given fields: ctx, bucketName, region
+func (_m *MockS3Repository) GetBucketPolicy(ctx context.Context, bucketName string, region string) (*string, error) {
+	ret := _m.Called(ctx, bucketName, region)
 
 	var r0 *string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*string, error)); ok {
-		return rf(bucketName, region)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*string, error)); ok {
+		return rf(ctx, bucketName, region)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *string); ok {
-		r0 = rf(bucketName, region)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *string); ok {
+		r0 = rf(ctx, bucketName, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(bucketName, region)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bucketName, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,25 +90,25 @@ func (_m *MockS3Repository) GetBucketPolicy(bucketName string, region string) (*
 	return r0, r1
 }
 
-// GetBucketPublicAccessBlock provides a mock function with given fields: bucketName, region
-func (_m *MockS3Repository) GetBucketPublicAccessBlock(bucketName string, region string) (*s3.PublicAccessBlockConfiguration, error) {
-	ret := _m.Called(bucketName, region)
+// GetBucketPublicAccessBlock provides a mock function with given fields: ctx, bucketName, region
+func (_m *MockS3Repository) GetBucketPublicAccessBlock(ctx context.Context, bucketName string, region string) (*s3.PublicAccessBlockConfiguration, error) {
+	ret := _m.Called(ctx, bucketName, region)
 
 	var r0 *s3.PublicAccessBlockConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*s3.PublicAccessBlockConfiguration, error)); ok {
-		return rf(bucketName, region)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*s3.PublicAccessBlockConfiguration, error)); ok {
+		return rf(ctx, bucketName, region)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *s3.PublicAccessBlockConfiguration); ok {
-		r0 = rf(bucketName, region)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *s3.PublicAccessBlockConfiguration); ok {
+		r0 = rf(ctx, bucketName, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*s3.PublicAccessBlockConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(bucketName, region)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bucketName, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,25 +116,25 @@ func (_m *MockS3Repository) GetBucketPublicAccessBlock(bucketName string, region
 	return r0, r1
 }
 
-// ListAllBuckets provides a mock function with given fields:
-func (_m *MockS3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
-	ret := _m.Called()
+// ListAllBuckets provides a mock function with given fields: ctx
+func (_m *MockS3Repository) ListAllBuckets(ctx context.Context) ([]*s3.Bucket, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*s3.Bucket
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*s3.Bucket, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*s3.Bucket, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*s3.Bucket); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*s3.Bucket); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.Bucket)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -140,25 +142,25 @@ func (_m *MockS3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
 	return r0, r1
 }
 
-// ListBucketAnalyticsConfigurations provides a mock function with given fields: bucket, region
-func (_m *MockS3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
-	ret := _m.Called(bucket, region)
+// ListBucketAnalyticsConfigurations provides a mock function with given fields: ctx, bucket, region
+func (_m *MockS3Repository) ListBucketAnalyticsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
+	ret := _m.Called(ctx, bucket, region)
 
 	var r0 []*s3.AnalyticsConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) ([]*s3.AnalyticsConfiguration, error)); ok {
-		return rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) ([]*s3.AnalyticsConfiguration, error)); ok {
+		return rf(ctx, bucket, region)
 	}
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) []*s3.AnalyticsConfiguration); ok {
-		r0 = rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) []*s3.AnalyticsConfiguration); ok {
+		r0 = rf(ctx, bucket, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.AnalyticsConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*s3.Bucket, string) error); ok {
-		r1 = rf(bucket, region)
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.Bucket, string) error); ok {
+		r1 = rf(ctx, bucket, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,25 +168,25 @@ func (_m *MockS3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket,
 	return r0, r1
 }
 
-// ListBucketInventoryConfigurations provides a mock function with given fields: bucket, region
-func (_m *MockS3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
-	ret := _m.Called(bucket, region)
+// ListBucketInventoryConfigurations provides a mock function with given fields: ctx, bucket, region
+func (_m *MockS3Repository) ListBucketInventoryConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
+	ret := _m.Called(ctx, bucket, region)
 
 	var r0 []*s3.InventoryConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) ([]*s3.InventoryConfiguration, error)); ok {
-		return rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) ([]*s3.InventoryConfiguration, error)); ok {
+		return rf(ctx, bucket, region)
 	}
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) []*s3.InventoryConfiguration); ok {
-		r0 = rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) []*s3.InventoryConfiguration); ok {
+		r0 = rf(ctx, bucket, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.InventoryConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*s3.Bucket, string) error); ok {
-		r1 = rf(bucket, region)
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.Bucket, string) error); ok {
+		r1 = rf(ctx, bucket, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -192,25 +194,25 @@ func (_m *MockS3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket,
 	return r0, r1
 }
 
-// ListBucketMetricsConfigurations provides a mock function with given fields: bucket, region
-func (_m *MockS3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
-	ret := _m.Called(bucket, region)
+// ListBucketMetricsConfigurations provides a mock function with given fields: ctx, bucket, region
+func (_m *MockS3Repository) ListBucketMetricsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
+	ret := _m.Called(ctx, bucket, region)
 
 	var r0 []*s3.MetricsConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) ([]*s3.MetricsConfiguration, error)); ok {
-		return rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) ([]*s3.MetricsConfiguration, error)); ok {
+		return rf(ctx, bucket, region)
 	}
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) []*s3.MetricsConfiguration); ok {
-		r0 = rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) []*s3.MetricsConfiguration); ok {
+		r0 = rf(ctx, bucket, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.MetricsConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*s3.Bucket, string) error); ok {
-		r1 = rf(bucket, region)
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.Bucket, string) error); ok {
+		r1 = rf(ctx, bucket, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	sns "github.com/aws/aws-sdk-go/service/sns"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockSNSRepository struct {
 	mock.Mock
 }
 
-// ListAllSubscriptions provides a mock function with given fields:
-func (_m *MockSNSRepository) ListAllSubscriptions() ([]*sns.Subscription, error) {
-	ret := _m.Called()
+// ListAllSubscriptions provides a mock function with given fields: ctx
+func (_m *MockSNSRepository) ListAllSubscriptions(ctx context.Context) ([]*sns.Subscription, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*sns.Subscription
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*sns.Subscription, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*sns.Subscription, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*sns.Subscription); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*sns.Subscription); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*sns.Subscription)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockSNSRepository) ListAllSubscriptions() ([]*sns.Subscription, error)
 	return r0, r1
 }
 
-// ListAllTopics provides a mock function with given fields:
-func (_m *MockSNSRepository) ListAllTopics() ([]*sns.Topic, error) {
-	ret := _m.Called()
+// ListAllTopics provides a mock function with given fields: ctx
+func (_m *MockSNSRepository) ListAllTopics(ctx context.Context) ([]*sns.Topic, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*sns.Topic
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*sns.Topic, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*sns.Topic, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*sns.Topic); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*sns.Topic); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*sns.Topic)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
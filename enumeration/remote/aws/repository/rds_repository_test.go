@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -23,7 +24,7 @@ func Test_rdsRepository_ListAllDBInstances(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeRDS) {
-				client.On("DescribeDBInstancesPages",
+				client.On("DescribeDBInstancesPagesWithContext", mock.Anything,
 					&rds.DescribeDBInstancesInput{},
 					mock.MatchedBy(func(callback func(res *rds.DescribeDBInstancesOutput, lastPage bool) bool) bool {
 						callback(&rds.DescribeDBInstancesOutput{
@@ -62,12 +63,12 @@ func Test_rdsRepository_ListAllDBInstances(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllDBInstances()
+			got, err := r.ListAllDBInstances(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllDBInstances()
+				cachedData, err := r.ListAllDBInstances(context.Background())
 				assert.Nil(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*rds.DBInstance{}, store.Get("rdsListAllDBInstances"))
@@ -95,7 +96,7 @@ func Test_rdsRepository_ListAllDBSubnetGroups(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeRDS) {
-				client.On("DescribeDBSubnetGroupsPages",
+				client.On("DescribeDBSubnetGroupsPagesWithContext", mock.Anything,
 					&rds.DescribeDBSubnetGroupsInput{},
 					mock.MatchedBy(func(callback func(res *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool) bool {
 						callback(&rds.DescribeDBSubnetGroupsOutput{
@@ -134,12 +135,12 @@ func Test_rdsRepository_ListAllDBSubnetGroups(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllDBSubnetGroups()
+			got, err := r.ListAllDBSubnetGroups(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllDBSubnetGroups()
+				cachedData, err := r.ListAllDBSubnetGroups(context.Background())
 				assert.Nil(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*rds.DBSubnetGroup{}, store.Get("rdsListAllDBSubnetGroups"))
@@ -176,7 +177,7 @@ func Test_rdsRepository_ListAllDBClusters(t *testing.T) {
 					{DBClusterIdentifier: aws.String("6")},
 				}
 
-				client.On("DescribeDBClustersPages",
+				client.On("DescribeDBClustersPagesWithContext", mock.Anything,
 					&rds.DescribeDBClustersInput{},
 					mock.MatchedBy(func(callback func(res *rds.DescribeDBClustersOutput, lastPage bool) bool) bool {
 						callback(&rds.DescribeDBClustersOutput{DBClusters: clusters[:3]}, false)
@@ -229,7 +230,7 @@ func Test_rdsRepository_ListAllDBClusters(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllDBClusters()
+			got, err := r.ListAllDBClusters(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,9 +14,9 @@ import (
 )
 
 type Route53Repository interface {
-	ListAllHealthChecks() ([]*route53.HealthCheck, error)
-	ListAllZones() ([]*route53.HostedZone, error)
-	ListRecordsForZone(zoneId string) ([]*route53.ResourceRecordSet, error)
+	ListAllHealthChecks(ctx context.Context) ([]*route53.HealthCheck, error)
+	ListAllZones(ctx context.Context) ([]*route53.HostedZone, error)
+	ListRecordsForZone(ctx context.Context, zoneId string) ([]*route53.ResourceRecordSet, error)
 }
 
 type route53Repository struct {
@@ -28,14 +31,17 @@ func NewRoute53Repository(session *session.Session, c cache.Cache) *route53Repos
 	}
 }
 
-func (r *route53Repository) ListAllHealthChecks() ([]*route53.HealthCheck, error) {
+func (r *route53Repository) ListAllHealthChecks(ctx context.Context) ([]*route53.HealthCheck, error) {
+	ctx, done := profile.Method(ctx, "Route53Repository.ListAllHealthChecks")
+	defer done()
+
 	if v := r.cache.Get("route53ListAllHealthChecks"); v != nil {
 		return v.([]*route53.HealthCheck), nil
 	}
 
 	var tables []*route53.HealthCheck
 	input := &route53.ListHealthChecksInput{}
-	err := r.client.ListHealthChecksPages(input, func(res *route53.ListHealthChecksOutput, lastPage bool) bool {
+	err := r.client.ListHealthChecksPagesWithContext(ctx, input, func(res *route53.ListHealthChecksOutput, lastPage bool) bool {
 		tables = append(tables, res.HealthChecks...)
 		return !lastPage
 	})
@@ -47,7 +53,10 @@ func (r *route53Repository) ListAllHealthChecks() ([]*route53.HealthCheck, error
 	return tables, nil
 }
 
-func (r *route53Repository) ListAllZones() ([]*route53.HostedZone, error) {
+func (r *route53Repository) ListAllZones(ctx context.Context) ([]*route53.HostedZone, error) {
+	ctx, done := profile.Method(ctx, "Route53Repository.ListAllZones")
+	defer done()
+
 	cacheKey := "route53ListAllZones"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -57,7 +66,7 @@ func (r *route53Repository) ListAllZones() ([]*route53.HostedZone, error) {
 
 	var result []*route53.HostedZone
 	input := &route53.ListHostedZonesInput{}
-	err := r.client.ListHostedZonesPages(input, func(res *route53.ListHostedZonesOutput, lastPage bool) bool {
+	err := r.client.ListHostedZonesPagesWithContext(ctx, input, func(res *route53.ListHostedZonesOutput, lastPage bool) bool {
 		result = append(result, res.HostedZones...)
 		return !lastPage
 	})
@@ -69,7 +78,10 @@ func (r *route53Repository) ListAllZones() ([]*route53.HostedZone, error) {
 	return result, nil
 }
 
-func (r *route53Repository) ListRecordsForZone(zoneId string) ([]*route53.ResourceRecordSet, error) {
+func (r *route53Repository) ListRecordsForZone(ctx context.Context, zoneId string) ([]*route53.ResourceRecordSet, error) {
+	ctx, done := profile.Method(ctx, "Route53Repository.ListRecordsForZone")
+	defer done()
+
 	cacheKey := fmt.Sprintf("route53ListRecordsForZone_%s", zoneId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*route53.ResourceRecordSet), nil
@@ -79,7 +91,7 @@ func (r *route53Repository) ListRecordsForZone(zoneId string) ([]*route53.Resour
 	input := &route53.ListResourceRecordSetsInput{
 		HostedZoneId: aws.String(zoneId),
 	}
-	err := r.client.ListResourceRecordSetsPages(input, func(res *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+	err := r.client.ListResourceRecordSetsPagesWithContext(ctx, input, func(res *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
 		results = append(results, res.ResourceRecordSets...)
 		return !lastPage
 	})
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -26,7 +28,7 @@ func Test_route53Repository_ListAllHealthChecks(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeRoute53) {
-				client.On("ListHealthChecksPages",
+				client.On("ListHealthChecksPagesWithContext", mock.Anything,
 					&route53.ListHealthChecksInput{},
 					mock.MatchedBy(func(callback func(res *route53.ListHealthChecksOutput, lastPage bool) bool) bool {
 						callback(&route53.ListHealthChecksOutput{
@@ -65,12 +67,12 @@ func Test_route53Repository_ListAllHealthChecks(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllHealthChecks()
+			got, err := r.ListAllHealthChecks(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllHealthChecks()
+				cachedData, err := r.ListAllHealthChecks(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*route53.HealthCheck{}, store.Get("route53ListAllHealthChecks"))
@@ -97,7 +99,7 @@ func Test_route53Repository_ListAllZones(t *testing.T) {
 	}{
 		{name: "Zones with 2 pages",
 			mocks: func(client *awstest.MockFakeRoute53) {
-				client.On("ListHostedZonesPages",
+				client.On("ListHostedZonesPagesWithContext", mock.Anything,
 					&route53.ListHostedZonesInput{},
 					mock.MatchedBy(func(callback func(res *route53.ListHostedZonesOutput, lastPage bool) bool) bool {
 						callback(&route53.ListHostedZonesOutput{
@@ -136,12 +138,12 @@ func Test_route53Repository_ListAllZones(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllZones()
+			got, err := r.ListAllZones(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllZones()
+				cachedData, err := r.ListAllZones(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*route53.HostedZone{}, store.Get("route53ListAllZones"))
@@ -173,7 +175,7 @@ func Test_route53Repository_ListRecordsForZone(t *testing.T) {
 				"1",
 			},
 			mocks: func(client *awstest.MockFakeRoute53) {
-				client.On("ListResourceRecordSetsPages",
+				client.On("ListResourceRecordSetsPagesWithContext", mock.Anything,
 					&route53.ListResourceRecordSetsInput{
 						HostedZoneId: aws.String("1"),
 					},
@@ -215,12 +217,12 @@ func Test_route53Repository_ListRecordsForZone(t *testing.T) {
 				cache:  store,
 			}
 			for _, id := range tt.zoneIds {
-				got, err := r.ListRecordsForZone(id)
+				got, err := r.ListRecordsForZone(context.Background(), id)
 				assert.Equal(t, tt.wantErr, err)
 
 				if err == nil {
 					// Check that results were cached
-					cachedData, err := r.ListRecordsForZone(id)
+					cachedData, err := r.ListRecordsForZone(context.Background(), id)
 					assert.NoError(t, err)
 					assert.Equal(t, got, cachedData)
 					assert.IsType(t, []*route53.ResourceRecordSet{}, store.Get(fmt.Sprintf("route53ListRecordsForZone_%s", id)))
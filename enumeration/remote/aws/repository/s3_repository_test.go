@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/aws/client"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -15,6 +17,7 @@ import (
 	"github.com/r3labs/diff/v2"
 	awstest "github.com/snyk/driftctl/test/aws"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_s3Repository_ListAllBuckets(t *testing.T) {
@@ -28,7 +31,7 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 		{
 			name: "List buckets",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListBuckets", &s3.ListBucketsInput{}).Return(
+				client.On("ListBucketsWithContext", mock.Anything, &s3.ListBucketsInput{}).Return(
 					&s3.ListBucketsOutput{
 						Buckets: []*s3.Bucket{
 							{Name: aws.String("bucket1")},
@@ -48,7 +51,7 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 		{
 			name: "Error listing buckets",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListBuckets", &s3.ListBucketsInput{}).Return(
+				client.On("ListBucketsWithContext", mock.Anything, &s3.ListBucketsInput{}).Return(
 					nil,
 					awserr.NewRequestFailure(nil, 403, ""),
 				).Once()
@@ -65,13 +68,13 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", (*aws.Config)(nil)).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListAllBuckets()
+			got, err := r.ListAllBuckets(context.Background())
 			factory.AssertExpectations(t)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllBuckets()
+				cachedData, err := r.ListAllBuckets(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.Bucket{}, store.Get("s3ListAllBuckets"))
@@ -103,7 +106,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{},
@@ -117,7 +120,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{
@@ -143,7 +146,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{
@@ -169,7 +172,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{
@@ -195,7 +198,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					nil,
@@ -213,7 +216,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: &tt.region}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.GetBucketNotification(tt.bucketName, tt.region)
+			got, err := r.GetBucketNotification(context.Background(), tt.bucketName, tt.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -224,7 +227,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 
 			if err == nil && tt.want != nil {
 				// Check that results were cached
-				cachedData, err := r.GetBucketNotification(tt.bucketName, tt.region)
+				cachedData, err := r.GetBucketNotification(context.Background(), tt.bucketName, tt.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, &s3.NotificationConfiguration{}, store.Get(fmt.Sprintf("s3GetBucketNotification_%s_%s", tt.bucketName, tt.region)))
@@ -256,7 +259,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketPolicyOutput{},
@@ -270,7 +273,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketPolicyOutput{
@@ -286,7 +289,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketPolicyOutput{
@@ -302,7 +305,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					nil,
@@ -316,7 +319,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					nil,
@@ -334,7 +337,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: &tt.region}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.GetBucketPolicy(tt.bucketName, tt.region)
+			got, err := r.GetBucketPolicy(context.Background(), tt.bucketName, tt.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -345,7 +348,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 
 			if err == nil && tt.want != nil {
 				// Check that results were cached
-				cachedData, err := r.GetBucketPolicy(tt.bucketName, tt.region)
+				cachedData, err := r.GetBucketPolicy(context.Background(), tt.bucketName, tt.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, awssdk.String(""), store.Get(fmt.Sprintf("s3GetBucketPolicy_%s_%s", tt.bucketName, tt.region)))
@@ -387,7 +390,8 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -405,7 +409,8 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 					nil,
 				).Once()
 				client.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -444,7 +449,8 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -465,7 +471,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketInventoryConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketInventoryConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -476,7 +482,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketInventoryConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketInventoryConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.InventoryConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketInventoryConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -518,7 +524,8 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -536,7 +543,8 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 					nil,
 				).Once()
 				client.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -575,7 +583,8 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -596,7 +605,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketMetricsConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketMetricsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -607,7 +616,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketMetricsConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketMetricsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.MetricsConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketMetricsConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -649,7 +658,8 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -667,7 +677,8 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 					nil,
 				).Once()
 				client.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -706,7 +717,8 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurationsWithContext",
+					mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -727,7 +739,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketAnalyticsConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketAnalyticsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -738,7 +750,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketAnalyticsConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketAnalyticsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.AnalyticsConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketAnalyticsConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -771,7 +783,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketLocationOutput{
@@ -788,7 +800,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketLocationOutput{},
@@ -803,7 +815,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					nil,
@@ -818,7 +830,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					nil,
@@ -836,7 +848,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", (*aws.Config)(nil)).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.GetBucketLocation(*tt.bucket.Name)
+			got, err := r.GetBucketLocation(context.Background(), *tt.bucket.Name)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -847,7 +859,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 
 			if err == nil && tt.want != "" {
 				// Check that results were cached
-				cachedData, err := r.GetBucketLocation(*tt.bucket.Name)
+				cachedData, err := r.GetBucketLocation(context.Background(), *tt.bucket.Name)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, "", store.Get(fmt.Sprintf("s3GetBucketLocation_%s", *tt.bucket.Name)))
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -27,7 +28,7 @@ func Test_s3ControlRepository_DescribeAccountPublicAccessBlock(t *testing.T) {
 		{
 			name: "describe account public access block",
 			mocks: func(client *awstest.MockFakeS3Control) {
-				client.On("GetPublicAccessBlock", mock.Anything).Return(
+				client.On("GetPublicAccessBlockWithContext", mock.Anything, mock.Anything).Return(
 					&s3control.GetPublicAccessBlockOutput{
 						PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
 							BlockPublicAcls:       aws.Bool(false),
@@ -51,7 +52,7 @@ func Test_s3ControlRepository_DescribeAccountPublicAccessBlock(t *testing.T) {
 			mocks: func(client *awstest.MockFakeS3Control) {
 				fakeRequestFailure := &awstest.MockFakeRequestFailure{}
 				fakeRequestFailure.On("Code").Return("FakeErrorCode")
-				client.On("GetPublicAccessBlock", mock.Anything).Return(
+				client.On("GetPublicAccessBlockWithContext", mock.Anything, mock.Anything).Return(
 					nil,
 					fakeRequestFailure,
 				).Once()
@@ -64,7 +65,7 @@ func Test_s3ControlRepository_DescribeAccountPublicAccessBlock(t *testing.T) {
 			mocks: func(client *awstest.MockFakeS3Control) {
 				fakeRequestFailure := &awstest.MockFakeRequestFailure{}
 				fakeRequestFailure.On("Code").Return("NoSuchPublicAccessBlockConfiguration")
-				client.On("GetPublicAccessBlock", mock.Anything).Return(
+				client.On("GetPublicAccessBlockWithContext", mock.Anything, mock.Anything).Return(
 					nil,
 					fakeRequestFailure,
 				).Once()
@@ -80,13 +81,13 @@ func Test_s3ControlRepository_DescribeAccountPublicAccessBlock(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3ControlClient", (*aws.Config)(nil)).Return(mockedClient).Once()
 			r := NewS3ControlRepository(&factory, store)
-			got, err := r.DescribeAccountPublicAccessBlock(accountID)
+			got, err := r.DescribeAccountPublicAccessBlock(context.Background(), accountID)
 			factory.AssertExpectations(t)
 			assert.Equal(t, tt.wantErr, err != nil)
 
 			if err == nil && got != nil {
 				// Check that results were cached
-				cachedData, err := r.DescribeAccountPublicAccessBlock(accountID)
+				cachedData, err := r.DescribeAccountPublicAccessBlock(context.Background(), accountID)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, &s3control.PublicAccessBlockConfiguration{}, store.Get("S3DescribeAccountPublicAccessBlock"))
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -24,7 +26,7 @@ func Test_sqsRepository_ListAllQueues(t *testing.T) {
 		{
 			name: "list with multiple pages",
 			mocks: func(client *awstest.MockFakeSQS) {
-				client.On("ListQueuesPages",
+				client.On("ListQueuesPagesWithContext", mock.Anything,
 					&sqs.ListQueuesInput{},
 					mock.MatchedBy(func(callback func(res *sqs.ListQueuesOutput, lastPage bool) bool) bool {
 						callback(&sqs.ListQueuesOutput{
@@ -57,12 +59,12 @@ func Test_sqsRepository_ListAllQueues(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllQueues()
+			got, err := r.ListAllQueues(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllQueues()
+				cachedData, err := r.ListAllQueues(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*string{}, store.Get("sqsListAllQueues"))
@@ -91,7 +93,8 @@ func Test_sqsRepository_GetQueueAttributes(t *testing.T) {
 			name: "get attributes",
 			mocks: func(client *awstest.MockFakeSQS) {
 				client.On(
-					"GetQueueAttributes",
+					"GetQueueAttributesWithContext",
+					mock.Anything,
 					&sqs.GetQueueAttributesInput{
 						AttributeNames: awssdk.StringSlice([]string{sqs.QueueAttributeNamePolicy}),
 						QueueUrl:       awssdk.String("http://example.com"),
@@ -121,12 +124,12 @@ func Test_sqsRepository_GetQueueAttributes(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.GetQueueAttributes("http://example.com")
+			got, err := r.GetQueueAttributes(context.Background(), "http://example.com")
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.GetQueueAttributes("http://example.com")
+				cachedData, err := r.GetQueueAttributes(context.Background(), "http://example.com")
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, &sqs.GetQueueAttributesOutput{}, store.Get("sqsGetQueueAttributes_http://example.com"))
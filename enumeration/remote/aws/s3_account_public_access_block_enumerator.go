@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
@@ -29,8 +31,8 @@ func (e *S3AccountPublicAccessBlockEnumerator) SupportedType() resource.Resource
 	return aws.AwsS3AccountPublicAccessBlock
 }
 
-func (e *S3AccountPublicAccessBlockEnumerator) Enumerate() ([]*resource.Resource, error) {
-	accountPublicAccessBlock, err := e.repository.DescribeAccountPublicAccessBlock(e.accountID)
+func (e *S3AccountPublicAccessBlockEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	accountPublicAccessBlock, err := e.repository.DescribeAccountPublicAccessBlock(ctx, e.accountID)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
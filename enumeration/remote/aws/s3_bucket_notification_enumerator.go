@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
@@ -32,8 +34,8 @@ func (e *S3BucketNotificationEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsS3BucketNotificationResourceType
 }
 
-func (e *S3BucketNotificationEnumerator) Enumerate() ([]*resource.Resource, error) {
-	buckets, err := e.repository.ListAllBuckets()
+func (e *S3BucketNotificationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	buckets, err := e.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsS3BucketResourceType)
 	}
@@ -41,7 +43,7 @@ func (e *S3BucketNotificationEnumerator) Enumerate() ([]*resource.Resource, erro
 	results := make([]*resource.Resource, 0, len(buckets))
 
 	for _, bucket := range buckets {
-		region, err := e.repository.GetBucketLocation(*bucket.Name)
+		region, err := e.repository.GetBucketLocation(ctx, *bucket.Name)
 		if err != nil {
 			alerts.SendEnumerationAlert(common.RemoteAWSTerraform, e.alerter, remoteerror.NewResourceScanningError(err, string(e.SupportedType()), *bucket.Name))
 			continue
@@ -54,7 +56,7 @@ func (e *S3BucketNotificationEnumerator) Enumerate() ([]*resource.Resource, erro
 			continue
 		}
 
-		notification, err := e.repository.GetBucketNotification(*bucket.Name, region)
+		notification, err := e.repository.GetBucketNotification(ctx, *bucket.Name, region)
 		if err != nil {
 			alerts.SendEnumerationAlert(common.RemoteAWSTerraform, e.alerter, remoteerror.NewResourceScanningError(err, string(e.SupportedType()), *bucket.Name))
 			continue
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
@@ -59,8 +60,8 @@ func (e *SNSTopicSubscriptionEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsSnsTopicSubscriptionResourceType
 }
 
-func (e *SNSTopicSubscriptionEnumerator) Enumerate() ([]*resource.Resource, error) {
-	allSubscriptions, err := e.repository.ListAllSubscriptions()
+func (e *SNSTopicSubscriptionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	allSubscriptions, err := e.repository.ListAllSubscriptions(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
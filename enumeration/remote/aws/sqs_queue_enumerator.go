@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -25,8 +27,8 @@ func (e *SQSQueueEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsSqsQueueResourceType
 }
 
-func (e *SQSQueueEnumerator) Enumerate() ([]*resource.Resource, error) {
-	queues, err := e.repository.ListAllQueues()
+func (e *SQSQueueEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	queues, err := e.repository.ListAllQueues(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
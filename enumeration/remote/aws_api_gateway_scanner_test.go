@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -29,12 +30,11 @@ func TestApiGatewayRestApi(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{}, nil)
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,7 +43,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "multiple api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("3of73v5ob4")},
 					{Id: awssdk.String("1jitcobwol")},
 				}, nil)
@@ -61,10 +61,12 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "cannot list api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayRestApiResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 	}
 
@@ -87,11 +89,8 @@ func TestApiGatewayRestApi(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -108,12 +107,11 @@ func TestApiGatewayAccount(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(nil, nil)
+				repository.On("GetAccount", mock.Anything).Return(nil, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -122,7 +120,7 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "empty api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(&apigateway.Account{}, nil)
+				repository.On("GetAccount", mock.Anything).Return(&apigateway.Account{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -134,10 +132,12 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "cannot get api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayAccountResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAccountResourceType, resourceaws.AwsApiGatewayAccountResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("GetAccount", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayAccountResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayAccountResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAccountResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAccountResourceType),
 		},
 	}
 
@@ -160,11 +160,8 @@ func TestApiGatewayAccount(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -181,12 +178,11 @@ func TestApiGatewayApiKey(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return([]*apigateway.ApiKey{}, nil)
+				repository.On("ListAllApiKeys", mock.Anything).Return([]*apigateway.ApiKey{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -195,7 +191,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "multiple api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return([]*apigateway.ApiKey{
+				repository.On("ListAllApiKeys", mock.Anything).Return([]*apigateway.ApiKey{
 					{Id: awssdk.String("fuwnl8lrva")},
 					{Id: awssdk.String("9ge737dd45")},
 				}, nil)
@@ -213,10 +209,12 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "cannot list api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayApiKeyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayApiKeyResourceType, resourceaws.AwsApiGatewayApiKeyResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApiKeys", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayApiKeyResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayApiKeyResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayApiKeyResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayApiKeyResourceType),
 		},
 	}
 
@@ -239,11 +237,8 @@ func TestApiGatewayApiKey(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -264,14 +259,13 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway authorizers",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return([]*apigateway.Authorizer{}, nil).Once()
-				repo.On("ListAllRestApiAuthorizers", *apis[1].Id).Return([]*apigateway.Authorizer{}, nil).Once()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return([]*apigateway.Authorizer{}, nil).Once()
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[1].Id).Return([]*apigateway.Authorizer{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -280,11 +274,11 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "multiple api gateway authorizers",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return([]*apigateway.Authorizer{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return([]*apigateway.Authorizer{
 					{Id: awssdk.String("ypcpde")},
 				}, nil).Once()
-				repo.On("ListAllRestApiAuthorizers", *apis[1].Id).Return([]*apigateway.Authorizer{
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[1].Id).Return([]*apigateway.Authorizer{
 					{Id: awssdk.String("bwhebj")},
 				}, nil).Once()
 			},
@@ -301,19 +295,23 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayAuthorizerResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayAuthorizerResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayAuthorizerResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType),
 		},
 	}
 
@@ -336,11 +334,8 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -360,13 +355,12 @@ func TestApiGatewayStage(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -375,8 +369,8 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "multiple api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo")},
 					{StageName: awssdk.String("baz")},
 				}, nil)
@@ -394,19 +388,23 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayStageResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayStageResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayStageResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayStageResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayStageResourceType),
 		},
 	}
 
@@ -429,11 +427,8 @@ func TestApiGatewayStage(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -453,13 +448,12 @@ func TestApiGatewayResource(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -468,8 +462,8 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "multiple api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("21zk4y"), Path: awssdk.String("/")},
 					{Id: awssdk.String("2ltv32p058"), Path: awssdk.String("/")},
 				}, nil)
@@ -487,19 +481,23 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayResourceResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayResourceResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayResourceResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayResourceResourceType),
 		},
 	}
 
@@ -522,11 +520,8 @@ func TestApiGatewayResource(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -543,12 +538,11 @@ func TestApiGatewayDomainName(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -557,7 +551,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "single api gateway domain name",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example-driftctl.com")},
 				}, nil)
 			},
@@ -571,10 +565,12 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "cannot list api gateway domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayDomainNameResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType, resourceaws.AwsApiGatewayDomainNameResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayDomainNameResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayDomainNameResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType),
 		},
 	}
 
@@ -597,11 +593,8 @@ func TestApiGatewayDomainName(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -618,12 +611,11 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway vpc links",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigateway.UpdateVpcLinkOutput{}, nil)
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigateway.UpdateVpcLinkOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -632,7 +624,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "single api gateway vpc link",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigateway.UpdateVpcLinkOutput{
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigateway.UpdateVpcLinkOutput{
 					{Id: awssdk.String("ipu24n")},
 				}, nil)
 			},
@@ -646,10 +638,12 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "cannot list api gateway vpc links",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayVpcLinkResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayVpcLinkResourceType, resourceaws.AwsApiGatewayVpcLinkResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllVpcLinks", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayVpcLinkResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayVpcLinkResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayVpcLinkResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayVpcLinkResourceType),
 		},
 	}
 
@@ -672,11 +666,8 @@ func TestApiGatewayVpcLink(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -696,13 +687,12 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -711,8 +701,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "multiple api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{
 					{Id: awssdk.String("ywlcuf")},
 					{Id: awssdk.String("qmpbs8")},
 				}, nil)
@@ -730,19 +720,23 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayRequestValidatorResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRequestValidatorResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayRequestValidatorResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType),
 		},
 	}
 
@@ -765,11 +759,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -786,12 +777,11 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway rest api policies",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("3of73v5ob4")},
 					{Id: awssdk.String("9x7kq9pbyh"), Policy: awssdk.String("")},
 				}, nil)
@@ -803,7 +793,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "multiple api gateway rest api policies",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("c3n3aqga5d"), Policy: awssdk.String("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"},\"Action\":\"execute-api:Invoke\",\"Resource\":\"arn:aws:execute-api:us-east-1:111111111111:c3n3aqga5d/*\",\"Condition\":{\"IpAddress\":{\"aws:SourceIp\":\"123.123.123.123/32\"}}}]}")},
 					{Id: awssdk.String("9y1eus3hr7"), Policy: awssdk.String("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"},\"Action\":\"execute-api:Invoke\",\"Resource\":\"arn:aws:execute-api:us-east-1:111111111111:9y1eus3hr7/*\",\"Condition\":{\"IpAddress\":{\"aws:SourceIp\":\"123.123.123.123/32\"}}}]}")},
 				}, nil)
@@ -821,10 +811,12 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiPolicyResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiPolicyResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayRestApiPolicyResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiPolicyResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiPolicyResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 	}
 
@@ -847,11 +839,8 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -871,13 +860,12 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{}, nil)
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -886,8 +874,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "multiple domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{
 					{BasePath: awssdk.String("foo")},
 					{BasePath: awssdk.String("(none)")},
 				}, nil)
@@ -905,19 +893,23 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "cannot list domain names",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayBasePathMappingResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType),
 		},
 		{
 			test: "cannot list domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayBasePathMappingResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayBasePathMappingResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType),
 		},
 	}
 
@@ -940,11 +932,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -964,13 +953,12 @@ func TestApiGatewayMethod(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway methods",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo")},
 				}, nil)
 			},
@@ -981,8 +969,8 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "multiple api gateway methods",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET":    {},
 						"POST":   {},
@@ -1006,19 +994,23 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayMethodResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayMethodResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType),
 		},
 	}
 
@@ -1041,11 +1033,8 @@ func TestApiGatewayMethod(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, testFilter))
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1065,13 +1054,12 @@ func TestApiGatewayModel(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return([]*apigateway.Model{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return([]*apigateway.Model{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1080,8 +1068,8 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "multiple api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return([]*apigateway.Model{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return([]*apigateway.Model{
 					{Id: awssdk.String("g68a4s")},
 					{Id: awssdk.String("85v536")},
 				}, nil)
@@ -1099,19 +1087,23 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayModelResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayModelResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayModelResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayModelResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayModelResourceType),
 		},
 	}
 
@@ -1134,11 +1126,8 @@ func TestApiGatewayModel(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1158,13 +1147,12 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway method responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {},
 					}},
@@ -1177,8 +1165,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "multiple api gateway method responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {MethodResponses: map[string]*apigateway.MethodResponse{
 							"200": {},
@@ -1204,19 +1192,23 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayMethodResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayMethodResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType),
 		},
 	}
 
@@ -1239,11 +1231,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, testFilter))
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1263,13 +1252,12 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1278,8 +1266,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "multiple api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{
 					{ResponseType: awssdk.String("UNAUTHORIZED")},
 					{ResponseType: awssdk.String("ACCESS_DENIED")},
 				}, nil)
@@ -1297,19 +1285,23 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayGatewayResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayGatewayResponseResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayGatewayResponseResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType),
 		},
 	}
 
@@ -1332,11 +1324,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1356,13 +1345,12 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway method settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo"), MethodSettings: map[string]*apigateway.MethodSetting{}},
 				}, nil)
 			},
@@ -1373,8 +1361,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "multiple api gateway method settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo"), MethodSettings: map[string]*apigateway.MethodSetting{
 						"*/*":        {},
 						"foo/GET":    {},
@@ -1398,19 +1386,23 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayMethodSettingsResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayStageResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayMethodSettingsResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayStageResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayStageResourceType),
 		},
 	}
 
@@ -1433,11 +1425,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, testFilter))
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1457,13 +1446,12 @@ func TestApiGatewayIntegration(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway integrations",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo")},
 				}, nil)
 			},
@@ -1474,8 +1462,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "multiple api gateway integrations",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET":    {},
 						"POST":   {},
@@ -1499,19 +1487,23 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayIntegrationResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayIntegrationResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType),
 		},
 	}
 
@@ -1534,11 +1526,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, testFilter))
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1558,13 +1547,12 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway integration responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {},
 					}},
@@ -1577,8 +1565,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "multiple api gateway integration responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {
 							MethodIntegration: &apigateway.Integration{
@@ -1604,19 +1592,23 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayIntegrationResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
 		},
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayIntegrationResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType),
 		},
 	}
 
@@ -1639,11 +1631,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, testFilter))
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
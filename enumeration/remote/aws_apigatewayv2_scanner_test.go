@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -30,12 +31,11 @@ func TestApiGatewayV2Api(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +44,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "single api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("f5vdrg12tk")},
 				}, nil)
 			},
@@ -58,10 +58,12 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ApiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ApiResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2ApiResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 	}
 
@@ -84,11 +86,8 @@ func TestApiGatewayV2Api(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -105,12 +104,11 @@ func TestApiGatewayV2Route(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -119,10 +117,10 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single route",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("a-gateway")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Route{{
 						RouteId:  awssdk.String("a-route"),
 						RouteKey: awssdk.String("POST /an-example"),
@@ -143,10 +141,12 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2RouteResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 	}
 
@@ -169,11 +169,8 @@ func TestApiGatewayV2Route(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -190,15 +187,14 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "single api gateway v2 api with a single deployment",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Deployment{{
 						DeploymentId: awssdk.String("a-deployment"),
 					}}, nil)
@@ -215,7 +211,7 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "no API gateways",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -224,10 +220,10 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "single API gateway with no deployments",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Deployment{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -237,21 +233,25 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "error listing API gateways",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2DeploymentResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 		{
 			test: "error listing deployments of an API",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2DeploymentResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2DeploymentResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2DeploymentResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2DeploymentResourceType),
 		},
 	}
 
@@ -274,11 +274,8 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -295,12 +292,11 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 vpc links",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigatewayv2.VpcLink{}, nil)
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigatewayv2.VpcLink{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -309,7 +305,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "single api gateway v2 vpc link",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigatewayv2.VpcLink{
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigatewayv2.VpcLink{
 					{VpcLinkId: awssdk.String("b8r351")},
 				}, nil)
 			},
@@ -323,10 +319,12 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 vpc links",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2VpcLinkResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2VpcLinkResourceType, resourceaws.AwsApiGatewayV2VpcLinkResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllVpcLinks", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2VpcLinkResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2VpcLinkResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2VpcLinkResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2VpcLinkResourceType),
 		},
 	}
 
@@ -349,11 +347,8 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -375,14 +370,13 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
-				repo.On("ListAllApiAuthorizers", *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -391,11 +385,11 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "multiple api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{
 					{AuthorizerId: awssdk.String("xaappu")},
 				}, nil).Once()
-				repo.On("ListAllApiAuthorizers", *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{
 					{AuthorizerId: awssdk.String("bwhebj")},
 				}, nil).Once()
 			},
@@ -412,19 +406,23 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2AuthorizerResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2AuthorizerResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2AuthorizerResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType),
 		},
 	}
 
@@ -447,11 +445,8 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -473,14 +468,13 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
-				repo.On("ListAllApiIntegrations", *apis[1].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -489,14 +483,14 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "multiple api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return([]*apigatewayv2.Integration{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Integration{
 					{
 						IntegrationId:   awssdk.String("xaappu"),
 						IntegrationType: awssdk.String("MOCK"),
 					},
 				}, nil).Once()
-				repo.On("ListAllApiIntegrations", *apis[1].ApiId).Return([]*apigatewayv2.Integration{
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Integration{
 					{
 						IntegrationId:   awssdk.String("bwhebj"),
 						IntegrationType: awssdk.String("MOCK"),
@@ -516,19 +510,23 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2IntegrationResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2IntegrationResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2IntegrationResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType),
 		},
 	}
 
@@ -551,11 +549,8 @@ func TestApiGatewayV2Integration(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -577,14 +572,13 @@ func TestApiGatewayV2Model(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 models",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
-				repo.On("ListAllApiModels", *apis[1].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
+				repo.On("ListAllApiModels", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -593,14 +587,14 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "multiple api gateway v2 models",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return([]*apigatewayv2.Model{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Model{
 					{
 						ModelId: awssdk.String("vdw6up"),
 						Name:    awssdk.String("model1"),
 					},
 				}, nil).Once()
-				repo.On("ListAllApiModels", *apis[1].ApiId).Return([]*apigatewayv2.Model{
+				repo.On("ListAllApiModels", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Model{
 					{
 						ModelId: awssdk.String("bwhebj"),
 						Name:    awssdk.String("model2"),
@@ -623,19 +617,23 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2ModelResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 model",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ModelResourceType), alerts.EnumerationPhase)).Return()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2ModelResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType),
 		},
 	}
 
@@ -658,11 +656,8 @@ func TestApiGatewayV2Model(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -679,12 +674,11 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -693,10 +687,10 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single stage",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiStages", "a-gateway").
+				repository.On("ListAllApiStages", mock.Anything, "a-gateway").
 					Return([]*apigatewayv2.Stage{{
 						StageName: awssdk.String("a-stage"),
 					}}, nil)
@@ -711,10 +705,12 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2StageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2StageResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2StageResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2StageResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2StageResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2StageResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 	}
 
@@ -737,11 +733,8 @@ func TestApiGatewayV2Stage(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -758,19 +751,18 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 route responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").
 					Return([]*apigatewayv2.RouteResponse{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -780,14 +772,14 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "single api gateway v2 route with one route response",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").
 					Return([]*apigatewayv2.RouteResponse{
 						{RouteResponseId: awssdk.String("nbw7vw")},
 					}, nil)
@@ -802,36 +794,42 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2RouteResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 routes",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2RouteResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 route responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2RouteResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType),
 		},
 	}
 
@@ -854,11 +852,8 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -875,12 +870,11 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 domains",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -889,10 +883,10 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "single api gateway v2 domain with a single mapping",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return([]*apigatewayv2.ApiMapping{{
 						Stage:        awssdk.String("a-stage"),
 						ApiId:        awssdk.String("foobar"),
@@ -909,30 +903,34 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 domains",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType, resourceaws.AwsApiGatewayV2MappingResourceType), alerts.EnumerationPhase)).Return()
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2MappingResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 mappings",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType, resourceaws.AwsApiGatewayV2MappingResourceType), alerts.EnumerationPhase)).Return()
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2MappingResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType),
 		},
 		{
 			test: "returning mapping with invalid attributes",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return([]*apigatewayv2.ApiMapping{
 						{
 							ApiMappingId: awssdk.String("barfoo"),
@@ -974,11 +972,8 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -996,12 +991,11 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1010,7 +1004,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "single api gateway v2 domain name",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("b8r351.example.com")},
 				}, nil)
 			},
@@ -1024,10 +1018,12 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DomainNameResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DomainNameResourceType, resourceaws.AwsApiGatewayV2DomainNameResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DomainNameResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2DomainNameResourceType, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2DomainNameResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2DomainNameResourceType),
 		},
 	}
 
@@ -1050,11 +1046,8 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1071,19 +1064,18 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockApiGatewayV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no api gateway v2 integration responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").
 					Return([]*apigatewayv2.IntegrationResponse{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -1093,14 +1085,14 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "single api gateway v2 integration with one integration response",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").
 					Return([]*apigatewayv2.IntegrationResponse{
 						{IntegrationResponseId: awssdk.String("sf67ti7")},
 					}, nil)
@@ -1115,36 +1107,42 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 integrations",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResourceType),
 		},
 		{
 			test: "cannot list api gateway v2 integration responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").Return(nil, dummyError)
-				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType),
 		},
 	}
 
@@ -1167,11 +1165,8 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/aws"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -31,7 +33,6 @@ func TestAppAutoScalingTarget(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockAppAutoScalingRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "should return one target",
@@ -39,7 +40,7 @@ func TestAppAutoScalingTarget(t *testing.T) {
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
 				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalableTargets", "dynamodb").Return([]*applicationautoscaling.ScalableTarget{
+				client.On("DescribeScalableTargets", mock.Anything, "dynamodb").Return([]*applicationautoscaling.ScalableTarget{
 					{
 						ResourceId:        awssdk.String("table/GameScores"),
 						RoleARN:           awssdk.String("arn:aws:iam::533948124879:role/aws-service-role/dynamodb.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_DynamoDBTable"),
@@ -50,7 +51,7 @@ func TestAppAutoScalingTarget(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScalableTargets", mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalableTarget{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScalableTargets", mock.Anything, mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalableTarget{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -58,17 +59,20 @@ func TestAppAutoScalingTarget(t *testing.T) {
 				assert.Equal(t, got[0].ResourceId(), "table/GameScores")
 				assert.Equal(t, got[0].ResourceType(), resourceaws.AwsAppAutoscalingTargetResourceType)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "should return remote error",
 			dirName: "aws_appautoscaling_target_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				remoteErr := errors.New("remote error")
 				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalableTargets", mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
+				client.On("DescribeScalableTargets", mock.Anything, mock.AnythingOfType("string")).Return(nil, remoteErr).Once()
+				alerter.On("SendAlert", resourceaws.AwsAppAutoscalingTargetResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsAppAutoscalingTargetResourceType, remoteerror.NewResourceListingError(remoteErr, resourceaws.AwsAppAutoscalingTargetResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerror.NewResourceListingError(errors.New("remote error"), resourceaws.AwsAppAutoscalingTargetResourceType),
 		},
 	}
 
@@ -115,16 +119,8 @@ func TestAppAutoScalingTarget(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			if err != nil {
-				assert.EqualError(tt, c.wantErr, err.Error())
-			} else {
-				assert.Equal(tt, err, c.wantErr)
-			}
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
 			fakeRepo.AssertExpectations(tt)
@@ -138,7 +134,6 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockAppAutoScalingRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "should return one policy",
@@ -146,7 +141,7 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
 				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalingPolicies", "dynamodb").Return([]*applicationautoscaling.ScalingPolicy{
+				client.On("DescribeScalingPolicies", mock.Anything, "dynamodb").Return([]*applicationautoscaling.ScalingPolicy{
 					{
 						PolicyName:        awssdk.String("DynamoDBReadCapacityUtilization:table/GameScores"),
 						ResourceId:        awssdk.String("table/GameScores"),
@@ -155,7 +150,7 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScalingPolicies", mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalingPolicy{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScalingPolicies", mock.Anything, mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalingPolicy{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -163,17 +158,20 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 				assert.Equal(t, got[0].ResourceId(), "DynamoDBReadCapacityUtilization:table/GameScores")
 				assert.Equal(t, got[0].ResourceType(), resourceaws.AwsAppAutoscalingPolicyResourceType)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "should return remote error",
 			dirName: "aws_appautoscaling_policy_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				remoteErr := errors.New("remote error")
 				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalingPolicies", mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
+				client.On("DescribeScalingPolicies", mock.Anything, mock.AnythingOfType("string")).Return(nil, remoteErr).Once()
+				alerter.On("SendAlert", resourceaws.AwsAppAutoscalingPolicyResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsAppAutoscalingPolicyResourceType, remoteerror.NewResourceListingError(remoteErr, resourceaws.AwsAppAutoscalingPolicyResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerror.NewResourceListingError(errors.New("remote error"), resourceaws.AwsAppAutoscalingPolicyResourceType),
 		},
 	}
 
@@ -220,16 +218,8 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			if err != nil {
-				assert.EqualError(tt, c.wantErr, err.Error())
-			} else {
-				assert.Equal(tt, err, c.wantErr)
-			}
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -245,7 +235,6 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockAppAutoScalingRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "should return one scheduled action",
@@ -261,7 +250,7 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 
 				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScheduledActions", mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{
+				client.On("DescribeScheduledActions", mock.Anything, mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{
 					{
 						ScheduledActionName: awssdk.String("action"),
 						ResourceId:          awssdk.String("table/GameScores"),
@@ -270,26 +259,25 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScheduledActions", mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScheduledActions", mock.Anything, mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
 				assert.Equal(t, "action-dynamodb-table/GameScores", got[0].ResourceId())
 				assert.Equal(t, resourceaws.AwsAppAutoscalingScheduledActionResourceType, got[0].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test: "should return remote error",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
 				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScheduledActions", mock.AnythingOfType("string")).Return(nil, dummyError).Once()
+				client.On("DescribeScheduledActions", mock.Anything, mock.AnythingOfType("string")).Return(nil, dummyError).Once()
+				alerter.On("SendAlert", resourceaws.AwsAppAutoscalingScheduledActionResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsAppAutoscalingScheduledActionResourceType, remoteerror.NewResourceListingError(dummyError, resourceaws.AwsAppAutoscalingScheduledActionResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerror.NewResourceListingError(dummyError, resourceaws.AwsAppAutoscalingScheduledActionResourceType),
 		},
 	}
 
@@ -312,11 +300,8 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -34,13 +35,12 @@ func TestCloudformationStack(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockCloudformationRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no cloudformation stacks",
 			dirName: "aws_cloudformation_stack_empty",
 			mocks: func(repository *repository.MockCloudformationRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStacks").Return([]*cloudformation.Stack{}, nil)
+				repository.On("ListAllStacks", mock.Anything).Return([]*cloudformation.Stack{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -50,7 +50,7 @@ func TestCloudformationStack(t *testing.T) {
 			test:    "multiple cloudformation stacks",
 			dirName: "aws_cloudformation_stack_multiple",
 			mocks: func(repository *repository.MockCloudformationRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStacks").Return([]*cloudformation.Stack{
+				repository.On("ListAllStacks", mock.Anything).Return([]*cloudformation.Stack{
 					{StackId: awssdk.String("arn:aws:cloudformation:us-east-1:047081014315:stack/bar-stack/c7a96e70-0f21-11ec-bd2a-0a2d95c2b2ab")},
 					{StackId: awssdk.String("arn:aws:cloudformation:us-east-1:047081014315:stack/foo-stack/c7aa0ab0-0f21-11ec-ba25-129d8c0b3757")},
 				}, nil)
@@ -69,11 +69,10 @@ func TestCloudformationStack(t *testing.T) {
 			test:    "cannot list cloudformation stacks",
 			dirName: "aws_cloudformation_stack_list",
 			mocks: func(repository *repository.MockCloudformationRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStacks").Return(nil, awsError)
+				repository.On("ListAllStacks", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsCloudformationStackResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudformationStackResourceType, resourceaws.AwsCloudformationStackResourceType), alerts.EnumerationPhase)).Return()
 			},
-			wantErr: nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
@@ -123,11 +122,8 @@ func TestCloudformationStack(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
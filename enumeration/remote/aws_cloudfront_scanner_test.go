@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -33,13 +34,12 @@ func TestCloudfrontDistribution(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockCloudfrontRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no cloudfront distributions",
 			dirName: "aws_cloudfront_distribution_empty",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{}, nil)
+				repository.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -49,7 +49,7 @@ func TestCloudfrontDistribution(t *testing.T) {
 			test:    "single cloudfront distribution",
 			dirName: "aws_cloudfront_distribution_single",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{
+				repository.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{
 					{Id: awssdk.String("E1M9CNS0XSHI19")},
 				}, nil)
 			},
@@ -65,11 +65,10 @@ func TestCloudfrontDistribution(t *testing.T) {
 			dirName: "aws_cloudfront_distribution_list",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				repository.On("ListAllDistributions").Return(nil, awsError)
+				repository.On("ListAllDistributions", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsCloudfrontDistributionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudfrontDistributionResourceType, resourceaws.AwsCloudfrontDistributionResourceType), alerts.EnumerationPhase)).Return()
 			},
-			wantErr: nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
@@ -119,11 +118,8 @@ func TestCloudfrontDistribution(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -32,24 +33,22 @@ func TestDynamoDBTable(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockDynamoDBRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no DynamoDB Table",
 			dirName: "aws_dynamodb_table_empty",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTables").Return([]*string{}, nil)
+				client.On("ListAllTables", mock.Anything).Return([]*string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "Multiple DynamoDB Table",
 			dirName: "aws_dynamodb_table_multiple",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTables").Return([]*string{
+				client.On("ListAllTables", mock.Anything).Return([]*string{
 					awssdk.String("GameScores"),
 					awssdk.String("example"),
 				}, nil)
@@ -63,21 +62,19 @@ func TestDynamoDBTable(t *testing.T) {
 				assert.Equal(t, "example", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsDynamodbTableResourceType, got[1].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "cannot list DynamoDB Table",
 			dirName: "aws_dynamodb_table_list",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				client.On("ListAllTables").Return(nil, awsError)
+				client.On("ListAllTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDynamodbTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDynamodbTableResourceType, resourceaws.AwsDynamodbTableResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -124,11 +121,8 @@ func TestDynamoDBTable(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
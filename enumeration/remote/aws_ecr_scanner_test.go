@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -33,24 +34,22 @@ func TestECRRepository(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockECRRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no repository",
 			dirName: "aws_ecr_repository_empty",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{}, nil)
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "multiple repositories",
 			dirName: "aws_ecr_repository_multiple",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{
 					{RepositoryName: awssdk.String("test_ecr")},
 					{RepositoryName: awssdk.String("bar")},
 				}, nil)
@@ -64,21 +63,19 @@ func TestECRRepository(t *testing.T) {
 				assert.Equal(t, "bar", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsEcrRepositoryResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list repository",
 			dirName: "aws_ecr_repository_empty",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllRepositories").Return(nil, awsError)
+				client.On("ListAllRepositories", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEcrRepositoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcrRepositoryResourceType, resourceaws.AwsEcrRepositoryResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -125,11 +122,8 @@ func TestECRRepository(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -143,22 +137,21 @@ func TestECRRepositoryPolicy(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockECRRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		err            error
 	}{
 		{
 			test: "single repository policy",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{
 					{RepositoryName: awssdk.String("test_ecr_repo_policy")},
 					{RepositoryName: awssdk.String("test_ecr_repo_without_policy")},
 				}, nil)
-				client.On("GetRepositoryPolicy", &ecr.Repository{
+				client.On("GetRepositoryPolicy", mock.Anything, &ecr.Repository{
 					RepositoryName: awssdk.String("test_ecr_repo_policy"),
 				}).Return(&ecr.GetRepositoryPolicyOutput{
 					RegistryId:     awssdk.String("1"),
 					RepositoryName: awssdk.String("test_ecr_repo_policy"),
 				}, nil)
-				client.On("GetRepositoryPolicy", &ecr.Repository{
+				client.On("GetRepositoryPolicy", mock.Anything, &ecr.Repository{
 					RepositoryName: awssdk.String("test_ecr_repo_without_policy"),
 				}).Return(nil, &ecr.RepositoryPolicyNotFoundException{})
 			},
@@ -167,7 +160,6 @@ func TestECRRepositoryPolicy(t *testing.T) {
 
 				assert.Equal(t, got[0].ResourceId(), "test_ecr_repo_policy")
 			},
-			err: nil,
 		},
 	}
 
@@ -190,11 +182,8 @@ func TestECRRepositoryPolicy(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
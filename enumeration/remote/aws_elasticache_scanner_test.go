@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -30,12 +31,11 @@ func TestElastiCacheCluster(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockElastiCacheRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no elasticache clusters",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllCacheClusters").Return([]*elasticache.CacheCluster{}, nil)
+				repository.On("ListAllCacheClusters", mock.Anything).Return([]*elasticache.CacheCluster{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +44,7 @@ func TestElastiCacheCluster(t *testing.T) {
 		{
 			test: "should list elasticache clusters",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllCacheClusters").Return([]*elasticache.CacheCluster{
+				repository.On("ListAllCacheClusters", mock.Anything).Return([]*elasticache.CacheCluster{
 					{CacheClusterId: awssdk.String("cluster-foo")},
 				}, nil)
 			},
@@ -58,7 +58,7 @@ func TestElastiCacheCluster(t *testing.T) {
 			test: "cannot list elasticache clusters (403)",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllCacheClusters").Return(nil, awsError)
+				repository.On("ListAllCacheClusters", mock.Anything).Return(nil, awsError)
 				alerter.On("SendAlert", resourceaws.AwsElastiCacheClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsElastiCacheClusterResourceType, resourceaws.AwsElastiCacheClusterResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -68,12 +68,12 @@ func TestElastiCacheCluster(t *testing.T) {
 		{
 			test: "cannot list elasticache clusters (dummy error)",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllCacheClusters").Return(nil, dummyError)
+				repository.On("ListAllCacheClusters", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsElastiCacheClusterResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsElastiCacheClusterResourceType, remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsElastiCacheClusterResourceType, ""))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsElastiCacheClusterResourceType, ""),
 		},
 	}
 
@@ -96,8 +96,8 @@ func TestElastiCacheCluster(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
 			fakeRepo.AssertExpectations(tt)
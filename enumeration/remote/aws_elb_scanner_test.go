@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -30,12 +31,11 @@ func TestELB_LoadBalancer(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockELBRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no load balancer",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elb.LoadBalancerDescription{}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elb.LoadBalancerDescription{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +44,7 @@ func TestELB_LoadBalancer(t *testing.T) {
 		{
 			test: "should list load balancers",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elb.LoadBalancerDescription{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elb.LoadBalancerDescription{
 					{
 						LoadBalancerName: awssdk.String("acc-test-lb-tf"),
 					},
@@ -60,7 +60,7 @@ func TestELB_LoadBalancer(t *testing.T) {
 			test: "cannot list load balancers",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancers").Return(nil, awsError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsClassicLoadBalancerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsClassicLoadBalancerResourceType, resourceaws.AwsClassicLoadBalancerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -71,12 +71,12 @@ func TestELB_LoadBalancer(t *testing.T) {
 		{
 			test: "cannot list load balancers (dummy error)",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsClassicLoadBalancerResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsClassicLoadBalancerResourceType, remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsClassicLoadBalancerResourceType, ""))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsClassicLoadBalancerResourceType, ""),
 		},
 	}
 
@@ -99,11 +99,8 @@ func TestELB_LoadBalancer(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
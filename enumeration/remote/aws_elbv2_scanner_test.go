@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -30,12 +31,11 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockELBV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no load balancer",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +44,7 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 		{
 			test: "should list load balancers",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn:  awssdk.String("arn:aws:elasticloadbalancing:us-east-1:533948124879:loadbalancer/app/acc-test-lb-tf/9114c60e08560420"),
 						LoadBalancerName: awssdk.String("acc-test-lb-tf"),
@@ -61,7 +61,7 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 			test: "cannot list load balancers (403)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancers").Return(nil, awsError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLoadBalancerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLoadBalancerResourceType, resourceaws.AwsLoadBalancerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -72,12 +72,12 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 		{
 			test: "cannot list load balancers (dummy error)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsLoadBalancerResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsLoadBalancerResourceType, remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsLoadBalancerResourceType, ""))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsLoadBalancerResourceType, ""),
 		},
 	}
 
@@ -100,11 +100,8 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -120,17 +117,16 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockELBV2Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no load balancer listener",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return([]*elbv2.Listener{}, nil)
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return([]*elbv2.Listener{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -139,13 +135,13 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		{
 			test: "should list load balancer listener",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
 
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return([]*elbv2.Listener{
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return([]*elbv2.Listener{
 					{
 						ListenerArn: awssdk.String("test-lb-listener-1"),
 					},
@@ -160,14 +156,14 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		{
 			test: "cannot list load balancer listeners (403)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
 
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return(nil, awsError)
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLoadBalancerListenerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingError(awsError, resourceaws.AwsLoadBalancerListenerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -179,7 +175,7 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 			test: "cannot list load balancers (403)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancers").Return(nil, awsError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLoadBalancerListenerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLoadBalancerListenerResourceType, resourceaws.AwsLoadBalancerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -190,18 +186,18 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		{
 			test: "cannot list load balancer listeners (dummy error)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
 
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsLoadBalancerListenerResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsLoadBalancerListenerResourceType, remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsLoadBalancerListenerResourceType, ""))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsLoadBalancerListenerResourceType, ""),
 		},
 	}
 
@@ -224,11 +220,8 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
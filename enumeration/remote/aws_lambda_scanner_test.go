@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -35,24 +36,22 @@ func TestScanLambdaFunction(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockLambdaRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no lambda functions",
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, nil)
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "with lambda functions",
 			dirName: "aws_lambda_function_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: awssdk.String("foo"),
 					},
@@ -70,13 +69,12 @@ func TestScanLambdaFunction(t *testing.T) {
 				assert.Equal(t, "bar", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsLambdaFunctionResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "One lambda with signing",
 			dirName: "aws_lambda_function_signed",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: awssdk.String("foo"),
 					},
@@ -88,21 +86,19 @@ func TestScanLambdaFunction(t *testing.T) {
 				assert.Equal(t, "foo", got[0].ResourceId())
 				assert.Equal(t, resourceaws.AwsLambdaFunctionResourceType, got[0].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list lambda functions",
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, awsError)
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLambdaFunctionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaFunctionResourceType, resourceaws.AwsLambdaFunctionResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -149,11 +145,8 @@ func TestScanLambdaFunction(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.err, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -168,24 +161,22 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockLambdaRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, nil)
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "with 2 sqs EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_sqs_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: awssdk.String("13ff66f8-37eb-4ad6-a0a8-594fea72df4f"),
 					},
@@ -203,13 +194,12 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 				assert.Equal(t, "4ad7e2b3-79e9-4713-9d9d-5af2c01d9058", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsLambdaEventSourceMappingResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "with dynamo EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_dynamo_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: awssdk.String("1aa9c4a0-060b-41c1-a9ae-dc304ebcdb00"),
 					},
@@ -221,21 +211,19 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 				assert.Equal(t, "1aa9c4a0-060b-41c1-a9ae-dc304ebcdb00", got[0].ResourceId())
 				assert.Equal(t, resourceaws.AwsLambdaEventSourceMappingResourceType, got[0].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list lambda functions",
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, awsError)
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLambdaEventSourceMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaEventSourceMappingResourceType, resourceaws.AwsLambdaEventSourceMappingResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -282,11 +270,8 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.err, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -36,24 +37,22 @@ func TestRoute53_HealthCheck(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockRoute53Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no health check",
 			dirName: "aws_route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{}, nil)
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple health check",
 			dirName: "aws_route53_health_check_multiple",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{
 					{Id: awssdk.String("7001a9df-ded4-4802-9909-668eb80b972b")},
 					{Id: awssdk.String("84fc318a-2e0d-41d6-b638-280e2f0f4e26")},
 				}, nil)
@@ -67,21 +66,19 @@ func TestRoute53_HealthCheck(t *testing.T) {
 				assert.Equal(t, "84fc318a-2e0d-41d6-b638-280e2f0f4e26", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsRoute53HealthCheckResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list health check",
 			dirName: "aws_route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllHealthChecks").Return(nil, awsError)
+				client.On("ListAllHealthChecks", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRoute53HealthCheckResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRoute53HealthCheckResourceType, resourceaws.AwsRoute53HealthCheckResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -128,11 +125,8 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.err, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -148,13 +142,12 @@ func TestRoute53_Zone(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockRoute53Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no zones",
 			dirName: "aws_route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					nil,
 				)
@@ -162,13 +155,12 @@ func TestRoute53_Zone(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "single zone",
 			dirName: "aws_route53_zone_single",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z08068311RGDXPHF8KE62"),
@@ -184,13 +176,12 @@ func TestRoute53_Zone(t *testing.T) {
 				assert.Equal(t, "Z08068311RGDXPHF8KE62", got[0].ResourceId())
 				assert.Equal(t, resourceaws.AwsRoute53ZoneResourceType, got[0].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "multiples zone (test pagination)",
 			dirName: "aws_route53_zone_multiples",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z01809283VH9BBALZHO7B"),
@@ -220,14 +211,13 @@ func TestRoute53_Zone(t *testing.T) {
 				assert.Equal(t, "Z01874941AR1TCGV5K65C", got[2].ResourceId())
 				assert.Equal(t, resourceaws.AwsRoute53ZoneResourceType, got[2].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list zones",
 			dirName: "aws_route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awsError,
 				)
@@ -237,7 +227,6 @@ func TestRoute53_Zone(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -284,11 +273,8 @@ func TestRoute53_Zone(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.err, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -304,13 +290,12 @@ func TestRoute53_Record(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockRoute53Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no records",
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -319,18 +304,17 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "multiples records in multiples zones",
 			dirName: "aws_route53_record_multiples",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -343,7 +327,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("foo-0.com"),
 						Type: awssdk.String("NS"),
@@ -365,7 +349,7 @@ func TestRoute53_Record(t *testing.T) {
 						Type: awssdk.String("A"),
 					},
 				}, nil)
-				client.On("ListRecordsForZone", "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test2"),
 						Type: awssdk.String("A"),
@@ -381,13 +365,12 @@ func TestRoute53_Record(t *testing.T) {
 				assert.Equal(t, "Z10347383HV75H96J919W_test2_A", got[5].ResourceId())
 				assert.Equal(t, resourceaws.AwsRoute53RecordResourceType, got[5].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "explicit subdomain records",
 			dirName: "aws_route53_record_explicit_subdomain",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -396,7 +379,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test0"),
 						Type: awssdk.String("TXT"),
@@ -432,14 +415,13 @@ func TestRoute53_Record(t *testing.T) {
 				assert.Equal(t, "Z06486383UC8WYSBZTWFM__test2.foo-2.com_A", got[5].ResourceId())
 				assert.Equal(t, resourceaws.AwsRoute53RecordResourceType, got[5].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list zones",
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awsError)
 
@@ -448,13 +430,12 @@ func TestRoute53_Record(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list records",
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -463,7 +444,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return(
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return(
 					[]*route53.ResourceRecordSet{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRoute53RecordResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRoute53RecordResourceType, resourceaws.AwsRoute53RecordResourceType), alerts.EnumerationPhase)).Return()
@@ -471,7 +452,6 @@ func TestRoute53_Record(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -518,11 +498,8 @@ func TestRoute53_Record(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.err, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
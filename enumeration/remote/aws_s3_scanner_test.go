@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3control"
@@ -38,13 +39,12 @@ func TestS3Bucket(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "multiple bucket", dirName: "aws_s3_bucket_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -53,6 +53,7 @@ func TestS3Bucket(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -61,6 +62,7 @@ func TestS3Bucket(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -69,6 +71,7 @@ func TestS3Bucket(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -86,14 +89,13 @@ func TestS3Bucket(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_list",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -142,11 +144,8 @@ func TestS3Bucket(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -161,13 +160,12 @@ func TestS3BucketInventory(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "multiple bucket with multiple inventories", dirName: "aws_s3_bucket_inventories_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -176,6 +174,7 @@ func TestS3BucketInventory(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -184,6 +183,7 @@ func TestS3BucketInventory(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -192,6 +192,7 @@ func TestS3BucketInventory(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"eu-west-1",
@@ -200,6 +201,7 @@ func TestS3BucketInventory(t *testing.T) {
 
 				repository.On(
 					"ListBucketInventoryConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -224,19 +226,18 @@ func TestS3BucketInventory(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_inventories_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketInventoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketInventoryResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test: "cannot list bucket inventories", dirName: "aws_s3_bucket_inventories_list_inventories",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
@@ -244,6 +245,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -252,6 +254,7 @@ func TestS3BucketInventory(t *testing.T) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
 					"ListBucketInventoryConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -264,7 +267,6 @@ func TestS3BucketInventory(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -313,11 +315,8 @@ func TestS3BucketInventory(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -332,20 +331,20 @@ func TestS3BucketNotification(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "single bucket without notifications",
 			dirName: "aws_s3_bucket_notifications_no_notif",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-notifications")},
 				}, nil)
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"dritftctl-test-no-notifications",
 				).Return(
 					"eu-west-3",
@@ -354,6 +353,7 @@ func TestS3BucketNotification(t *testing.T) {
 
 				repository.On(
 					"GetBucketNotification",
+					mock.Anything,
 					"dritftctl-test-no-notifications",
 					"eu-west-3",
 				).Return(
@@ -369,7 +369,7 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "multiple bucket with notifications", dirName: "aws_s3_bucket_notifications_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -378,6 +378,7 @@ func TestS3BucketNotification(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -386,6 +387,7 @@ func TestS3BucketNotification(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -394,6 +396,7 @@ func TestS3BucketNotification(t *testing.T) {
 
 				repository.On(
 					"GetBucketNotification",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 					"eu-west-3",
 				).Return(
@@ -412,6 +415,7 @@ func TestS3BucketNotification(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -429,39 +433,38 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "Cannot get bucket notification", dirName: "aws_s3_bucket_notifications_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-notifications-error")},
 				}, nil)
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"dritftctl-test-notifications-error",
 				).Return(
 					"eu-west-3",
 					nil,
 				)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("GetBucketNotification", "dritftctl-test-notifications-error", "eu-west-3").Return(nil, awsError)
+				repository.On("GetBucketNotification", mock.Anything, "dritftctl-test-notifications-error", "eu-west-3").Return(nil, awsError)
 
 				alerter.On("SendAlert", "aws_s3_bucket_notification.dritftctl-test-notifications-error", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, "aws_s3_bucket_notification.dritftctl-test-notifications-error", resourceaws.AwsS3BucketNotificationResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test: "Cannot list bucket", dirName: "aws_s3_bucket_notifications_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketNotificationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketNotificationResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -510,11 +513,8 @@ func TestS3BucketNotification(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -529,13 +529,12 @@ func TestS3BucketMetrics(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "multiple bucket with multiple metrics", dirName: "aws_s3_bucket_metrics_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -544,6 +543,7 @@ func TestS3BucketMetrics(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -552,6 +552,7 @@ func TestS3BucketMetrics(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -560,6 +561,7 @@ func TestS3BucketMetrics(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -568,6 +570,7 @@ func TestS3BucketMetrics(t *testing.T) {
 
 				repository.On(
 					"ListBucketMetricsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -592,19 +595,18 @@ func TestS3BucketMetrics(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_metrics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketMetricResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketMetricResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test: "cannot list metrics", dirName: "aws_s3_bucket_metrics_list_metrics",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
@@ -612,6 +614,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				)
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -621,6 +624,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
 					"ListBucketMetricsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -633,7 +637,6 @@ func TestS3BucketMetrics(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -682,11 +685,8 @@ func TestS3BucketMetrics(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -701,20 +701,20 @@ func TestS3BucketPolicy(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "single bucket without policy",
 			dirName: "aws_s3_bucket_policy_no_policy",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-policy")},
 				}, nil)
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"dritftctl-test-no-policy",
 				).Return(
 					"eu-west-3",
@@ -723,6 +723,7 @@ func TestS3BucketPolicy(t *testing.T) {
 
 				repository.On(
 					"GetBucketPolicy",
+					mock.Anything,
 					"dritftctl-test-no-policy",
 					"eu-west-3",
 				).Return(
@@ -738,7 +739,7 @@ func TestS3BucketPolicy(t *testing.T) {
 			test: "multiple bucket with policies", dirName: "aws_s3_bucket_policies_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -747,6 +748,7 @@ func TestS3BucketPolicy(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -755,6 +757,7 @@ func TestS3BucketPolicy(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -763,6 +766,7 @@ func TestS3BucketPolicy(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -771,6 +775,7 @@ func TestS3BucketPolicy(t *testing.T) {
 
 				repository.On(
 					"GetBucketPolicy",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 					"eu-west-3",
 				).Return(
@@ -792,14 +797,13 @@ func TestS3BucketPolicy(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_policies_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketPolicyResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -848,11 +852,8 @@ func TestS3BucketPolicy(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -868,28 +869,27 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "multiple bucket, one with access block",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return([]*s3.Bucket{
+				repository.On("ListAllBuckets", mock.Anything).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-with-public-access-block")},
 					{Name: awssdk.String("bucket-without-public-access-block")},
 				}, nil)
 
-				repository.On("GetBucketLocation", "bucket-with-public-access-block").
+				repository.On("GetBucketLocation", mock.Anything, "bucket-with-public-access-block").
 					Return("us-east-1", nil)
-				repository.On("GetBucketLocation", "bucket-without-public-access-block").
+				repository.On("GetBucketLocation", mock.Anything, "bucket-without-public-access-block").
 					Return("us-east-1", nil)
 
-				repository.On("GetBucketPublicAccessBlock", "bucket-with-public-access-block", "us-east-1").
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "bucket-with-public-access-block", "us-east-1").
 					Return(&s3.PublicAccessBlockConfiguration{
 						BlockPublicAcls:   awssdk.Bool(true),
 						BlockPublicPolicy: awssdk.Bool(false),
 					}, nil)
 
-				repository.On("GetBucketPublicAccessBlock", "bucket-without-public-access-block", "us-east-1").
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "bucket-without-public-access-block", "us-east-1").
 					Return(nil, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -907,16 +907,19 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 		{
 			test: "cannot list bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(nil, dummyError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsS3BucketPublicAccessBlockResourceType, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsS3BucketPublicAccessBlockResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, resourceaws.AwsS3BucketResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, resourceaws.AwsS3BucketResourceType),
 		},
 		{
 			test: "cannot list public access block",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return([]*s3.Bucket{{Name: awssdk.String("foobar")}}, nil)
-				repository.On("GetBucketLocation", "foobar").Return("us-east-1", nil)
-				repository.On("GetBucketPublicAccessBlock", "foobar", "us-east-1").Return(nil, dummyError)
+				repository.On("ListAllBuckets", mock.Anything).Return([]*s3.Bucket{{Name: awssdk.String("foobar")}}, nil)
+				repository.On("GetBucketLocation", mock.Anything, "foobar").Return("us-east-1", nil)
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "foobar", "us-east-1").Return(nil, dummyError)
 				alerter.On("SendAlert", "aws_s3_bucket_public_access_block.foobar", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, "foobar"), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -948,11 +951,8 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -968,14 +968,13 @@ func TestS3BucketAnalytic(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "multiple bucket with multiple analytics",
 			dirName: "aws_s3_bucket_analytics_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -984,6 +983,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -992,6 +992,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -1000,6 +1001,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -1008,6 +1010,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 
 				repository.On(
 					"ListBucketAnalyticsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -1032,19 +1035,18 @@ func TestS3BucketAnalytic(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_analytics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketAnalyticsConfigurationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketAnalyticsConfigurationResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test: "cannot list Analytics", dirName: "aws_s3_bucket_analytics_list_analytics",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
@@ -1052,6 +1054,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				)
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -1061,6 +1064,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
 					"ListBucketAnalyticsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -1073,7 +1077,6 @@ func TestS3BucketAnalytic(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -1122,11 +1125,8 @@ func TestS3BucketAnalytic(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1143,12 +1143,11 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockS3ControlRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "existing access block",
 			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeAccountPublicAccessBlock", accountID).Return(&s3control.PublicAccessBlockConfiguration{
+				repository.On("DescribeAccountPublicAccessBlock", mock.Anything, accountID).Return(&s3control.PublicAccessBlockConfiguration{
 					BlockPublicAcls:       awssdk.Bool(false),
 					BlockPublicPolicy:     awssdk.Bool(true),
 					IgnorePublicAcls:      awssdk.Bool(false),
@@ -1170,9 +1169,12 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 		{
 			test: "cannot list access block",
 			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeAccountPublicAccessBlock", accountID).Return(nil, dummyError)
+				repository.On("DescribeAccountPublicAccessBlock", mock.Anything, accountID).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceaws.AwsS3AccountPublicAccessBlock, alerts.NewIncompleteEnumerationAlert(resourceaws.AwsS3AccountPublicAccessBlock, remoteerr.NewResourceListingError(dummyError, resourceaws.AwsS3AccountPublicAccessBlock))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsS3AccountPublicAccessBlock),
 		},
 	}
 
@@ -1199,11 +1201,8 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
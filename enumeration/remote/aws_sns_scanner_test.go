@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -35,24 +36,22 @@ func TestScanSNSTopic(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockSNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no SNS Topic",
 			dirName: "aws_sns_topic_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple SNSTopic",
 			dirName: "aws_sns_topic_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic")},
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic2")},
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic3")},
@@ -70,21 +69,19 @@ func TestScanSNSTopic(t *testing.T) {
 				assert.Equal(t, "arn:aws:sns:eu-west-3:526954929923:user-updates-topic3", got[2].ResourceId())
 				assert.Equal(t, resourceaws.AwsSnsTopicResourceType, got[2].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list SNSTopic",
 			dirName: "aws_sns_topic_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllTopics").Return(nil, awsError)
+				client.On("ListAllTopics", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicResourceType, resourceaws.AwsSnsTopicResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -131,11 +128,8 @@ func TestScanSNSTopic(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.err, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -150,24 +144,22 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockSNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no SNS Topic policy",
 			dirName: "aws_sns_topic_policy_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple SNSTopicPolicy",
 			dirName: "aws_sns_topic_policy_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy")},
 					{TopicArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy2")},
 				}, nil)
@@ -181,21 +173,19 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 				assert.Equal(t, "arn:aws:sns:us-east-1:526954929923:my-topic-with-policy2", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsSnsTopicPolicyResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list SNSTopic",
 			dirName: "aws_sns_topic_policy_topic_list",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllTopics").Return(nil, awsError)
+				client.On("ListAllTopics", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicPolicyResourceType, resourceaws.AwsSnsTopicResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -242,11 +232,8 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.err, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -261,24 +248,22 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockSNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no SNS Topic Subscription",
 			dirName: "aws_sns_topic_subscription_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{}, nil)
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple SNSTopic Subscription",
 			dirName: "aws_sns_topic_subscription_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic:b6e66147-2b31-4486-8d4b-2a2272264c8e")},
 				}, nil)
@@ -292,13 +277,12 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 				assert.Equal(t, "arn:aws:sns:us-east-1:526954929923:user-updates-topic:b6e66147-2b31-4486-8d4b-2a2272264c8e", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsSnsTopicSubscriptionResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple SNSTopic Subscription with one pending and one incorrect",
 			dirName: "aws_sns_topic_subscription_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: awssdk.String("PendingConfirmation"), Endpoint: awssdk.String("TEST")},
 					{SubscriptionArn: awssdk.String("Incorrect"), Endpoint: awssdk.String("INCORRECT")},
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
@@ -318,21 +302,19 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 				assert.Equal(t, "arn:aws:sns:us-east-1:526954929923:user-updates-topic:b6e66147-2b31-4486-8d4b-2a2272264c8e", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsSnsTopicSubscriptionResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list SNSTopic subscription",
 			dirName: "aws_sns_topic_subscription_list",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSubscriptions").Return(nil, awsError)
+				client.On("ListAllSubscriptions", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicSubscriptionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicSubscriptionResourceType, resourceaws.AwsSnsTopicSubscriptionResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -379,11 +361,8 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -33,24 +34,22 @@ func TestSQSQueue(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockSQSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no sqs queues",
 			dirName: "aws_sqs_queue_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiple sqs queues",
 			dirName: "aws_sqs_queue_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 				}, nil)
@@ -64,21 +63,19 @@ func TestSQSQueue(t *testing.T) {
 				assert.Equal(t, "https://sqs.eu-west-3.amazonaws.com/047081014315/foo", got[1].ResourceId())
 				assert.Equal(t, resourceaws.AwsSqsQueueResourceType, got[1].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "cannot list sqs queues",
 			dirName: "aws_sqs_queue_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllQueues").Return(nil, awsError)
+				client.On("ListAllQueues", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSqsQueueResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSqsQueueResourceType, resourceaws.AwsSqsQueueResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -124,11 +121,8 @@ func TestSQSQueue(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			fakeRepo.AssertExpectations(tt)
@@ -143,7 +137,6 @@ func TestSQSQueuePolicy(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockSQSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			// sqs queue with no policy case is not possible
@@ -151,24 +144,23 @@ func TestSQSQueuePolicy(t *testing.T) {
 			test:    "no sqs queue policies",
 			dirName: "aws_sqs_queue_policy_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiple sqs queue policies (default or not)",
 			dirName: "aws_sqs_queue_policy_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/baz"),
 				}, nil)
 
-				client.On("GetQueueAttributes", mock.Anything).Return(
+				client.On("GetQueueAttributes", mock.Anything, mock.Anything).Return(
 					&sqs.GetQueueAttributesOutput{
 						Attributes: map[string]*string{
 							sqs.QueueAttributeNamePolicy: awssdk.String(""),
@@ -189,19 +181,18 @@ func TestSQSQueuePolicy(t *testing.T) {
 				assert.Equal(t, "https://sqs.eu-west-3.amazonaws.com/047081014315/baz", got[2].ResourceId())
 				assert.Equal(t, resourceaws.AwsSqsQueuePolicyResourceType, got[2].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiple sqs queue policies (with nil attributes)",
 			dirName: "aws_sqs_queue_policy_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/baz"),
 				}, nil)
 
-				client.On("GetQueueAttributes", mock.Anything).Return(
+				client.On("GetQueueAttributes", mock.Anything, mock.Anything).Return(
 					&sqs.GetQueueAttributesOutput{},
 					nil,
 				)
@@ -218,21 +209,19 @@ func TestSQSQueuePolicy(t *testing.T) {
 				assert.Equal(t, "https://sqs.eu-west-3.amazonaws.com/047081014315/baz", got[2].ResourceId())
 				assert.Equal(t, resourceaws.AwsSqsQueuePolicyResourceType, got[2].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "cannot list sqs queues, thus sqs queue policies",
 			dirName: "aws_sqs_queue_policy_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllQueues").Return(nil, awsError)
+				client.On("ListAllQueues", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSqsQueuePolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSqsQueuePolicyResourceType, resourceaws.AwsSqsQueueResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -278,11 +267,8 @@ func TestSQSQueuePolicy(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			fakeRepo.AssertExpectations(tt)
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermContainerRegistryEnumerator) SupportedType() resource.ResourceTy
 	return azurerm.AzureContainerRegistryResourceType
 }
 
-func (e *AzurermContainerRegistryEnumerator) Enumerate() ([]*resource.Resource, error) {
-	registries, err := e.repository.ListAllContainerRegistries()
+func (e *AzurermContainerRegistryEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	registries, err := e.repository.ListAllContainerRegistries(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,53 @@
+package azurerm
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+type AzurermKubernetesClusterEnumerator struct {
+	repository repository.ContainerServiceRepository
+	factory    resource.ResourceFactory
+}
+
+func NewAzurermKubernetesClusterEnumerator(repo repository.ContainerServiceRepository, factory resource.ResourceFactory) *AzurermKubernetesClusterEnumerator {
+	return &AzurermKubernetesClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *AzurermKubernetesClusterEnumerator) SupportedType() resource.ResourceType {
+	return azurerm.AzureKubernetesClusterResourceType
+}
+
+func (e *AzurermKubernetesClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllManagedClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0)
+	for _, cluster := range clusters {
+		attrs := map[string]interface{}{
+			"name": *cluster.Name,
+		}
+		if cluster.Properties != nil && cluster.Properties.NodeResourceGroup != nil {
+			attrs["node_resource_group"] = *cluster.Properties.NodeResourceGroup
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.ID,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,58 @@
+package azurerm
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+type AzurermKubernetesClusterNodePoolEnumerator struct {
+	repository repository.ContainerServiceRepository
+	factory    resource.ResourceFactory
+}
+
+func NewAzurermKubernetesClusterNodePoolEnumerator(repo repository.ContainerServiceRepository, factory resource.ResourceFactory) *AzurermKubernetesClusterNodePoolEnumerator {
+	return &AzurermKubernetesClusterNodePoolEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *AzurermKubernetesClusterNodePoolEnumerator) SupportedType() resource.ResourceType {
+	return azurerm.AzureKubernetesClusterNodePoolResourceType
+}
+
+func (e *AzurermKubernetesClusterNodePoolEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllManagedClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzureKubernetesClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+	for _, cluster := range clusters {
+		if cluster.Properties == nil {
+			continue
+		}
+
+		// Azure does not tell which pool is the default_node_pool of azurerm_kubernetes_cluster, every
+		// pool is listed and the AzurermKubernetesDefaultNodePool middleware drops the default one
+		for _, pool := range cluster.Properties.AgentPoolProfiles {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*cluster.ID+"/agentPools/"+*pool.Name,
+					map[string]interface{}{
+						"name":                  *pool.Name,
+						"kubernetes_cluster_id": *cluster.ID,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
@@ -0,0 +1,72 @@
+package azurerm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+type AzurermLinuxFunctionAppEnumerator struct {
+	repository repository.AppServiceRepository
+	factory    resource.ResourceFactory
+}
+
+func NewAzurermLinuxFunctionAppEnumerator(repo repository.AppServiceRepository, factory resource.ResourceFactory) *AzurermLinuxFunctionAppEnumerator {
+	return &AzurermLinuxFunctionAppEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *AzurermLinuxFunctionAppEnumerator) SupportedType() resource.ResourceType {
+	return azurerm.AzureLinuxFunctionAppResourceType
+}
+
+func (e *AzurermLinuxFunctionAppEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	sites, err := e.repository.ListAllSites(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0)
+	for _, site := range sites {
+		if !siteKindMatches(site, true) {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*site.ID,
+				map[string]interface{}{
+					"name": *site.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
+
+// siteKindMatches tells whether a Microsoft.Web/sites resource is a linux web app or,
+// when functionApp is set, a linux function app. Kind is a comma separated list
+// such as "app,linux,container" or "functionapp,linux".
+func siteKindMatches(site *repository.Site, functionApp bool) bool {
+	if site.Kind == nil {
+		return false
+	}
+	isLinux, isFunction := false, false
+	for _, kind := range strings.Split(strings.ToLower(*site.Kind), ",") {
+		switch strings.TrimSpace(kind) {
+		case "linux":
+			isLinux = true
+		case "functionapp":
+			isFunction = true
+		}
+	}
+	return isLinux && isFunction == functionApp
+}
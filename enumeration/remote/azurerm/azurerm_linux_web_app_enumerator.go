@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+type AzurermLinuxWebAppEnumerator struct {
+	repository repository.AppServiceRepository
+	factory    resource.ResourceFactory
+}
+
+func NewAzurermLinuxWebAppEnumerator(repo repository.AppServiceRepository, factory resource.ResourceFactory) *AzurermLinuxWebAppEnumerator {
+	return &AzurermLinuxWebAppEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *AzurermLinuxWebAppEnumerator) SupportedType() resource.ResourceType {
+	return azurerm.AzureLinuxWebAppResourceType
+}
+
+func (e *AzurermLinuxWebAppEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	sites, err := e.repository.ListAllSites(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0)
+	for _, site := range sites {
+		if !siteKindMatches(site, false) {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*site.ID,
+				map[string]interface{}{
+					"name": *site.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,9 +25,9 @@ func (e *AzurermPrivateDNSZoneEnumerator) SupportedType() resource.ResourceType
 	return azurerm.AzurePrivateDNSZoneResourceType
 }
 
-func (e *AzurermPrivateDNSZoneEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AzurermPrivateDNSZoneEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 
-	zones, err := e.repository.ListAllPrivateZones()
+	zones, err := e.repository.ListAllPrivateZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,51 @@
+package azurerm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+type AzurermServicePlanEnumerator struct {
+	repository repository.AppServiceRepository
+	factory    resource.ResourceFactory
+}
+
+func NewAzurermServicePlanEnumerator(repo repository.AppServiceRepository, factory resource.ResourceFactory) *AzurermServicePlanEnumerator {
+	return &AzurermServicePlanEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *AzurermServicePlanEnumerator) SupportedType() resource.ResourceType {
+	return azurerm.AzureServicePlanResourceType
+}
+
+func (e *AzurermServicePlanEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	plans, err := e.repository.ListAllServicePlans(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0)
+	for _, plan := range plans {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				// ARM returns serverfarms while Terraform stores serverFarms in service plan IDs
+				strings.Replace(*plan.ID, "/Microsoft.Web/serverfarms/", "/Microsoft.Web/serverFarms/", 1),
+				map[string]interface{}{
+					"name": *plan.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermStorageAccountEnumerator) SupportedType() resource.ResourceType
 	return azurerm.AzureStorageAccountResourceType
 }
 
-func (e *AzurermStorageAccountEnumerator) Enumerate() ([]*resource.Resource, error) {
-	accounts, err := e.repository.ListAllStorageAccount()
+func (e *AzurermStorageAccountEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	accounts, err := e.repository.ListAllStorageAccount(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
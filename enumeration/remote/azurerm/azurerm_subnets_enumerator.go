@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,15 +25,15 @@ func (e *AzurermSubnetEnumerator) SupportedType() resource.ResourceType {
 	return azurerm.AzureSubnetResourceType
 }
 
-func (e *AzurermSubnetEnumerator) Enumerate() ([]*resource.Resource, error) {
-	networks, err := e.repository.ListAllVirtualNetworks()
+func (e *AzurermSubnetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	networks, err := e.repository.ListAllVirtualNetworks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzureVirtualNetworkResourceType)
 	}
 
 	results := make([]*resource.Resource, 0)
 	for _, network := range networks {
-		resources, err := e.repository.ListAllSubnets(network)
+		resources, err := e.repository.ListAllSubnets(ctx, network)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
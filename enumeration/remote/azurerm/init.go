@@ -1,50 +1,81 @@
 package azurerm
 
 import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/traffic"
 )
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, rateLimits *ratelimit.Controller, repositoryCache cache.Cache, capture *traffic.Capture) error {
 
 	provider, err := NewAzureTerraformProvider(version, progress, configDir)
 	if err != nil {
 		return err
 	}
-	err = provider.CheckCredentialsExist()
-	if err != nil {
-		return err
+	provider.SetParallelism(rateLimits.Parallelism())
+	// A replayed scan needs neither credentials nor the provider, only the recorded schema
+	if !capture.Replaying() {
+		err = provider.CheckCredentialsExist()
+		if err != nil {
+			return err
+		}
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
 	}
-	err = provider.Init()
+	tfProvider, err := capture.Provider(provider)
 	if err != nil {
 		return err
 	}
 
+	// Resource ids and URLs depend on the subscription, a replayed scan uses the recorded one
 	providerConfig := provider.GetConfig()
-	cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{})
-	if err != nil {
-		return err
-	}
-	clientOptions := &arm.ClientOptions{}
+	providerConfig.SubscriptionID = capture.Setting("azure.subscription_id", providerConfig.SubscriptionID)
 
-	c := cache.New(100)
+	var cred azcore.TokenCredential = replayCredential{}
+	if !capture.Replaying() {
+		cred, err = azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	clientOptions := &arm.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			PerRetryPolicies: []policy.Policy{apiCallPolicy{}, rateLimitPolicy{rateLimits.Limiter("azure")}},
+		},
+	}
+	// Token requests of the credential do not go through these options, they are never recorded
+	if capture != nil {
+		clientOptions.Transport = &http.Client{Transport: capture.Transport("azure", nil)}
+	}
 
-	storageAccountRepo := repository.NewStorageRepository(cred, clientOptions, providerConfig, c)
-	networkRepo := repository.NewNetworkRepository(cred, clientOptions, providerConfig, c)
-	resourcesRepo := repository.NewResourcesRepository(cred, clientOptions, providerConfig, c)
-	containerRegistryRepo := repository.NewContainerRegistryRepository(cred, clientOptions, providerConfig, c)
-	postgresqlRepo := repository.NewPostgresqlRepository(cred, clientOptions, providerConfig, c)
-	privateDNSRepo := repository.NewPrivateDNSRepository(cred, clientOptions, providerConfig, c)
-	computeRepo := repository.NewComputeRepository(cred, clientOptions, providerConfig, c)
+	storageAccountRepo := repository.NewStorageRepository(cred, clientOptions, providerConfig, repositoryCache)
+	networkRepo := repository.NewNetworkRepository(cred, clientOptions, providerConfig, repositoryCache)
+	resourcesRepo := repository.NewResourcesRepository(cred, clientOptions, providerConfig, repositoryCache)
+	containerRegistryRepo := repository.NewContainerRegistryRepository(cred, clientOptions, providerConfig, repositoryCache)
+	postgresqlRepo := repository.NewPostgresqlRepository(cred, clientOptions, providerConfig, repositoryCache)
+	privateDNSRepo := repository.NewPrivateDNSRepository(cred, clientOptions, providerConfig, repositoryCache)
+	computeRepo := repository.NewComputeRepository(cred, clientOptions, providerConfig, repositoryCache)
+	containerServiceRepo := repository.NewContainerServiceRepository(cred, clientOptions, providerConfig, repositoryCache)
+	appServiceRepo := repository.NewAppServiceRepository(cred, clientOptions, providerConfig, repositoryCache)
 
-	providerLibrary.AddProvider(terraform.AZURE, provider)
+	providerLibrary.AddProvider(terraform.AZURE, tfProvider)
 
 	remoteLibrary.AddEnumerator(NewAzurermStorageAccountEnumerator(storageAccountRepo, factory))
 	remoteLibrary.AddEnumerator(NewAzurermStorageContainerEnumerator(storageAccountRepo, factory))
@@ -74,5 +105,50 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	remoteLibrary.AddEnumerator(NewAzurermImageEnumerator(computeRepo, factory))
 	remoteLibrary.AddEnumerator(NewAzurermSSHPublicKeyEnumerator(computeRepo, factory))
 
+	remoteLibrary.AddEnumerator(NewAzurermKubernetesClusterEnumerator(containerServiceRepo, factory))
+	remoteLibrary.AddEnumerator(NewAzurermKubernetesClusterNodePoolEnumerator(containerServiceRepo, factory))
+
+	// These types were added in azurerm 3.0
+	schema := provider.Schema()
+	remoteLibrary.AddEnumeratorIfSupported(schema, NewAzurermServicePlanEnumerator(appServiceRepo, factory))
+	remoteLibrary.AddEnumeratorIfSupported(schema, NewAzurermLinuxWebAppEnumerator(appServiceRepo, factory))
+	remoteLibrary.AddEnumeratorIfSupported(schema, NewAzurermLinuxFunctionAppEnumerator(appServiceRepo, factory))
+
 	return nil
 }
+
+// apiCallPolicy records every request sent to Azure, retries included, as an API call
+type apiCallPolicy struct{}
+
+func (apiCallPolicy) Do(req *policy.Request) (*http.Response, error) {
+	profile.RecordAPICall(req.Raw().Context(), 0)
+	return req.Next()
+}
+
+// rateLimitPolicy makes every request sent to Azure, retries included, wait for the limiter
+type rateLimitPolicy struct {
+	limiter *ratelimit.Limiter
+}
+
+func (p rateLimitPolicy) Do(req *policy.Request) (*http.Response, error) {
+	if err := p.limiter.Wait(req.Raw().Context()); err != nil {
+		return nil, err
+	}
+	res, err := req.Next()
+	if err != nil {
+		return res, err
+	}
+	if ratelimit.IsThrottlingResponse(res) {
+		p.limiter.Throttled()
+	} else {
+		p.limiter.Succeeded()
+	}
+	return res, nil
+}
+
+// replayCredential stands in for the Azure credential on replay, the recorded responses do not check the token
+type replayCredential struct{}
+
+func (replayCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (*azcore.AccessToken, error) {
+	return &azcore.AccessToken{Token: "replay", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/common"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const appServiceAPIVersion = "2022-03-01"
+
+type ServicePlan struct {
+	ID       *string `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Kind     *string `json:"kind,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+type ServicePlanListResult struct {
+	Value    []*ServicePlan `json:"value,omitempty"`
+	NextLink *string        `json:"nextLink,omitempty"`
+}
+
+// Site is either a web app or a function app, they are told apart using Kind
+// (e.g. "app,linux" or "functionapp,linux,container").
+type Site struct {
+	ID       *string `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Kind     *string `json:"kind,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+type SiteListResult struct {
+	Value    []*Site `json:"value,omitempty"`
+	NextLink *string `json:"nextLink,omitempty"`
+}
+
+type AppServiceRepository interface {
+	ListAllServicePlans(ctx context.Context) ([]*ServicePlan, error)
+	ListAllSites(ctx context.Context) ([]*Site, error)
+}
+
+type servicePlansListPager interface {
+	pager
+	PageResponse() ServicePlanListResult
+}
+
+type servicePlansPagerImpl struct {
+	*armPager
+	current ServicePlanListResult
+}
+
+func (p *servicePlansPagerImpl) PageResponse() ServicePlanListResult {
+	return p.current
+}
+
+type sitesListPager interface {
+	pager
+	PageResponse() SiteListResult
+}
+
+type sitesPagerImpl struct {
+	*armPager
+	current SiteListResult
+}
+
+func (p *sitesPagerImpl) PageResponse() SiteListResult {
+	return p.current
+}
+
+type appServiceClient interface {
+	ListServicePlans() servicePlansListPager
+	ListSites() sitesListPager
+}
+
+type appServiceClientImpl struct {
+	pipeline       runtime.Pipeline
+	host           string
+	subscriptionID string
+}
+
+func (c appServiceClientImpl) endpoint(resourceType string) string {
+	urlPath := strings.ReplaceAll(
+		"/subscriptions/{subscriptionId}/providers/Microsoft.Web/{resourceType}",
+		"{subscriptionId}",
+		url.PathEscape(c.subscriptionID),
+	)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", resourceType)
+	return runtime.JoinPaths(c.host, urlPath)
+}
+
+func (c appServiceClientImpl) ListServicePlans() servicePlansListPager {
+	p := &servicePlansPagerImpl{}
+	p.armPager = newArmPager(c.pipeline, c.endpoint("serverfarms"), appServiceAPIVersion, func(body []byte) (*string, error) {
+		p.current = ServicePlanListResult{}
+		if err := json.Unmarshal(body, &p.current); err != nil {
+			return nil, err
+		}
+		return p.current.NextLink, nil
+	})
+	return p
+}
+
+func (c appServiceClientImpl) ListSites() sitesListPager {
+	p := &sitesPagerImpl{}
+	p.armPager = newArmPager(c.pipeline, c.endpoint("sites"), appServiceAPIVersion, func(body []byte) (*string, error) {
+		p.current = SiteListResult{}
+		if err := json.Unmarshal(body, &p.current); err != nil {
+			return nil, err
+		}
+		return p.current.NextLink, nil
+	})
+	return p
+}
+
+type appServiceRepository struct {
+	client appServiceClient
+	cache  cache.Cache
+}
+
+func NewAppServiceRepository(cred azcore.TokenCredential, options *arm.ClientOptions, config common.AzureProviderConfig, cache cache.Cache) *appServiceRepository {
+	pipeline, host := newArmPipeline(cred, options)
+	return &appServiceRepository{
+		&appServiceClientImpl{pipeline: pipeline, host: host, subscriptionID: config.SubscriptionID},
+		cache,
+	}
+}
+
+func (s *appServiceRepository) ListAllServicePlans(ctx context.Context) ([]*ServicePlan, error) {
+	ctx, done := profile.Method(ctx, "AppServiceRepository.ListAllServicePlans")
+	defer done()
+
+	cacheKey := "appServiceListAllServicePlans"
+	if v := s.cache.Get(cacheKey); v != nil {
+		return v.([]*ServicePlan), nil
+	}
+
+	pager := s.client.ListServicePlans()
+	results := make([]*ServicePlan, 0)
+	for pager.NextPage(ctx) {
+		resp := pager.PageResponse()
+		if err := pager.Err(); err != nil {
+			return nil, err
+		}
+		results = append(results, resp.Value...)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	s.cache.Put(cacheKey, results)
+	return results, nil
+}
+
+func (s *appServiceRepository) ListAllSites(ctx context.Context) ([]*Site, error) {
+	ctx, done := profile.Method(ctx, "AppServiceRepository.ListAllSites")
+	defer done()
+
+	cacheKey := "appServiceListAllSites"
+	if v := s.cache.Get(cacheKey); v != nil {
+		return v.([]*Site), nil
+	}
+
+	pager := s.client.ListSites()
+	results := make([]*Site, 0)
+	for pager.NextPage(ctx) {
+		resp := pager.PageResponse()
+		if err := pager.Err(); err != nil {
+			return nil, err
+		}
+		results = append(results, resp.Value...)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	s.cache.Put(cacheKey, results)
+	return results, nil
+}
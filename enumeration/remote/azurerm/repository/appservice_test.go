@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_AppService_ListAllServicePlans(t *testing.T) {
+	expectedResults := []*ServicePlan{
+		{
+			ID:   to.StringPtr("/subscriptions/2c361f34-30fb-47ae-a227-83a5d3a26c66/resourceGroups/app-resources/providers/Microsoft.Web/serverfarms/plan1"),
+			Name: to.StringPtr("plan1"),
+		},
+		{
+			ID:   to.StringPtr("/subscriptions/2c361f34-30fb-47ae-a227-83a5d3a26c66/resourceGroups/app-resources/providers/Microsoft.Web/serverfarms/plan2"),
+			Name: to.StringPtr("plan2"),
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		mocks    func(*mockServicePlansListPager, *cache.MockCache)
+		expected []*ServicePlan
+		wantErr  string
+	}{
+		{
+			name: "should return service plans",
+			mocks: func(mockPager *mockServicePlansListPager, mockCache *cache.MockCache) {
+				mockPager.On("Err").Return(nil).Times(3)
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(2)
+				mockPager.On("NextPage", mock.Anything).Return(false).Times(1)
+				mockPager.On("PageResponse").Return(ServicePlanListResult{
+					Value: expectedResults[:1],
+				}).Times(1)
+				mockPager.On("PageResponse").Return(ServicePlanListResult{
+					Value: expectedResults[1:],
+				}).Times(1)
+
+				mockCache.On("Get", "appServiceListAllServicePlans").Return(nil).Times(1)
+				mockCache.On("Put", "appServiceListAllServicePlans", expectedResults).Return(false).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should hit cache and return service plans",
+			mocks: func(mockPager *mockServicePlansListPager, mockCache *cache.MockCache) {
+				mockCache.On("Get", "appServiceListAllServicePlans").Return(expectedResults).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(mockPager *mockServicePlansListPager, mockCache *cache.MockCache) {
+				mockPager.On("NextPage", mock.Anything).Return(false).Times(1)
+				mockPager.On("Err").Return(errors.New("remote error")).Times(1)
+
+				mockCache.On("Get", "appServiceListAllServicePlans").Return(nil).Times(1)
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &mockAppServiceClient{}
+			mockPager := &mockServicePlansListPager{}
+			mockCache := &cache.MockCache{}
+
+			fakeClient.On("ListServicePlans").Maybe().Return(mockPager)
+
+			tt.mocks(mockPager, mockCache)
+
+			s := &appServiceRepository{
+				client: fakeClient,
+				cache:  mockCache,
+			}
+			got, err := s.ListAllServicePlans(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			fakeClient.AssertExpectations(t)
+			mockPager.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ListAllServicePlans() got = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_AppService_ListAllSites(t *testing.T) {
+	expectedResults := []*Site{
+		{
+			ID:   to.StringPtr("/subscriptions/2c361f34-30fb-47ae-a227-83a5d3a26c66/resourceGroups/app-resources/providers/Microsoft.Web/sites/webapp1"),
+			Name: to.StringPtr("webapp1"),
+			Kind: to.StringPtr("app,linux"),
+		},
+		{
+			ID:   to.StringPtr("/subscriptions/2c361f34-30fb-47ae-a227-83a5d3a26c66/resourceGroups/app-resources/providers/Microsoft.Web/sites/function1"),
+			Name: to.StringPtr("function1"),
+			Kind: to.StringPtr("functionapp,linux"),
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		mocks    func(*mockSitesListPager, *cache.MockCache)
+		expected []*Site
+		wantErr  string
+	}{
+		{
+			name: "should return sites",
+			mocks: func(mockPager *mockSitesListPager, mockCache *cache.MockCache) {
+				mockPager.On("Err").Return(nil).Times(2)
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(1)
+				mockPager.On("NextPage", mock.Anything).Return(false).Times(1)
+				mockPager.On("PageResponse").Return(SiteListResult{
+					Value: expectedResults,
+				}).Times(1)
+
+				mockCache.On("Get", "appServiceListAllSites").Return(nil).Times(1)
+				mockCache.On("Put", "appServiceListAllSites", expectedResults).Return(false).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should hit cache and return sites",
+			mocks: func(mockPager *mockSitesListPager, mockCache *cache.MockCache) {
+				mockCache.On("Get", "appServiceListAllSites").Return(expectedResults).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(mockPager *mockSitesListPager, mockCache *cache.MockCache) {
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(1)
+				mockPager.On("PageResponse").Return(SiteListResult{}).Times(1)
+				mockPager.On("Err").Return(errors.New("remote error")).Times(1)
+
+				mockCache.On("Get", "appServiceListAllSites").Return(nil).Times(1)
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &mockAppServiceClient{}
+			mockPager := &mockSitesListPager{}
+			mockCache := &cache.MockCache{}
+
+			fakeClient.On("ListSites").Maybe().Return(mockPager)
+
+			tt.mocks(mockPager, mockCache)
+
+			s := &appServiceRepository{
+				client: fakeClient,
+				cache:  mockCache,
+			}
+			got, err := s.ListAllSites(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			fakeClient.AssertExpectations(t)
+			mockPager.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ListAllSites() got = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
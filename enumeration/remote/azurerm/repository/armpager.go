@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const (
+	armClientModule  = "driftctl"
+	armClientVersion = "v0.0.1"
+)
+
+// newArmPipeline builds an authenticated pipeline and returns it along with the ARM host it targets.
+func newArmPipeline(cred azcore.TokenCredential, options *arm.ClientOptions) (runtime.Pipeline, string) {
+	cp := arm.ClientOptions{}
+	if options != nil {
+		cp = *options
+	}
+	if len(cp.Host) == 0 {
+		cp.Host = arm.AzurePublicCloud
+	}
+	return armruntime.NewPipeline(armClientModule, armClientVersion, cred, &cp), string(cp.Host)
+}
+
+// armPager walks a plain ARM list endpoint, following nextLink until exhausted.
+// It is used for resource providers that do not have an SDK module compatible
+// with the azcore version we depend on.
+type armPager struct {
+	pipeline runtime.Pipeline
+	nextLink *string
+	err      error
+	// decode unmarshals a page body and returns the link to the next page
+	decode func(body []byte) (*string, error)
+}
+
+func newArmPager(pipeline runtime.Pipeline, endpoint, apiVersion string, decode func(body []byte) (*string, error)) *armPager {
+	link := fmt.Sprintf("%s?api-version=%s", endpoint, apiVersion)
+	return &armPager{
+		pipeline: pipeline,
+		nextLink: &link,
+		decode:   decode,
+	}
+}
+
+func (p *armPager) Err() error {
+	return p.err
+}
+
+func (p *armPager) NextPage(ctx context.Context) bool {
+	if p.err != nil || p.nextLink == nil || *p.nextLink == "" {
+		return false
+	}
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, *p.nextLink)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := p.pipeline.Do(req)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	body, err := runtime.Payload(resp)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		p.err = runtime.NewResponseError(fmt.Errorf("unexpected status %s: %s", resp.Status, string(body)), resp)
+		return false
+	}
+
+	next, err := p.decode(body)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.nextLink = next
+
+	return true
+}
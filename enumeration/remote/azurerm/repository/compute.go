@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/common"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -11,8 +12,8 @@ import (
 )
 
 type ComputeRepository interface {
-	ListAllImages() ([]*armcompute.Image, error)
-	ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyResource, error)
+	ListAllImages(ctx context.Context) ([]*armcompute.Image, error)
+	ListAllSSHPublicKeys(ctx context.Context) ([]*armcompute.SSHPublicKeyResource, error)
 }
 
 type imagesListPager interface {
@@ -63,7 +64,10 @@ func NewComputeRepository(cred azcore.TokenCredential, options *arm.ClientOption
 	}
 }
 
-func (s *computeRepository) ListAllImages() ([]*armcompute.Image, error) {
+func (s *computeRepository) ListAllImages(ctx context.Context) ([]*armcompute.Image, error) {
+	ctx, done := profile.Method(ctx, "ComputeRepository.ListAllImages")
+	defer done()
+
 	cacheKey := "computeListAllImages"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armcompute.Image), nil
@@ -71,7 +75,7 @@ func (s *computeRepository) ListAllImages() ([]*armcompute.Image, error) {
 
 	pager := s.imagesClient.List(nil)
 	results := make([]*armcompute.Image, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -86,7 +90,10 @@ func (s *computeRepository) ListAllImages() ([]*armcompute.Image, error) {
 	return results, nil
 }
 
-func (s *computeRepository) ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyResource, error) {
+func (s *computeRepository) ListAllSSHPublicKeys(ctx context.Context) ([]*armcompute.SSHPublicKeyResource, error) {
+	ctx, done := profile.Method(ctx, "ComputeRepository.ListAllSSHPublicKeys")
+	defer done()
+
 	cacheKey := "computeListAllSSHPublicKeys"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armcompute.SSHPublicKeyResource), nil
@@ -94,7 +101,7 @@ func (s *computeRepository) ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyRe
 
 	pager := s.sshPublicKeyClient.ListBySubscription(nil)
 	results := make([]*armcompute.SSHPublicKeyResource, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"reflect"
 	"testing"
@@ -125,7 +127,7 @@ func Test_Compute_ListAllImages(t *testing.T) {
 				imagesClient: fakeClient,
 				cache:        mockCache,
 			}
-			got, err := s.ListAllImages()
+			got, err := s.ListAllImages(context.Background())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -256,7 +258,7 @@ func Test_Compute_ListAllSSHPublicKeys(t *testing.T) {
 				sshPublicKeyClient: fakeClient,
 				cache:              mockCache,
 			}
-			got, err := s.ListAllSSHPublicKeys()
+			got, err := s.ListAllSSHPublicKeys(context.Background())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"reflect"
 	"testing"
@@ -125,7 +127,7 @@ func Test_Resources_ListAllContainerRegistries(t *testing.T) {
 				registryClient: fakeClient,
 				cache:          mockCache,
 			}
-			got, err := s.ListAllContainerRegistries()
+			got, err := s.ListAllContainerRegistries(context.Background())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
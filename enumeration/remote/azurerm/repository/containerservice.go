@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/common"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const containerServiceAPIVersion = "2022-09-01"
+
+type ManagedCluster struct {
+	ID         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+	Properties *ManagedClusterProperties `json:"properties,omitempty"`
+}
+
+type ManagedClusterProperties struct {
+	NodeResourceGroup *string                           `json:"nodeResourceGroup,omitempty"`
+	AgentPoolProfiles []*ManagedClusterAgentPoolProfile `json:"agentPoolProfiles,omitempty"`
+}
+
+type ManagedClusterAgentPoolProfile struct {
+	Name *string `json:"name,omitempty"`
+	Mode *string `json:"mode,omitempty"`
+}
+
+type ManagedClusterListResult struct {
+	Value    []*ManagedCluster `json:"value,omitempty"`
+	NextLink *string           `json:"nextLink,omitempty"`
+}
+
+type ContainerServiceRepository interface {
+	ListAllManagedClusters(ctx context.Context) ([]*ManagedCluster, error)
+}
+
+type managedClustersListPager interface {
+	pager
+	PageResponse() ManagedClusterListResult
+}
+
+type managedClustersClient interface {
+	List() managedClustersListPager
+}
+
+type managedClustersPagerImpl struct {
+	*armPager
+	current ManagedClusterListResult
+}
+
+func (p *managedClustersPagerImpl) PageResponse() ManagedClusterListResult {
+	return p.current
+}
+
+type managedClustersClientImpl struct {
+	pipeline       runtime.Pipeline
+	host           string
+	subscriptionID string
+}
+
+func (c managedClustersClientImpl) List() managedClustersListPager {
+	urlPath := strings.ReplaceAll(
+		"/subscriptions/{subscriptionId}/providers/Microsoft.ContainerService/managedClusters",
+		"{subscriptionId}",
+		url.PathEscape(c.subscriptionID),
+	)
+	p := &managedClustersPagerImpl{}
+	p.armPager = newArmPager(c.pipeline, runtime.JoinPaths(c.host, urlPath), containerServiceAPIVersion, func(body []byte) (*string, error) {
+		p.current = ManagedClusterListResult{}
+		if err := json.Unmarshal(body, &p.current); err != nil {
+			return nil, err
+		}
+		return p.current.NextLink, nil
+	})
+	return p
+}
+
+type containerServiceRepository struct {
+	managedClustersClient managedClustersClient
+	cache                 cache.Cache
+}
+
+func NewContainerServiceRepository(cred azcore.TokenCredential, options *arm.ClientOptions, config common.AzureProviderConfig, cache cache.Cache) *containerServiceRepository {
+	pipeline, host := newArmPipeline(cred, options)
+	return &containerServiceRepository{
+		&managedClustersClientImpl{pipeline: pipeline, host: host, subscriptionID: config.SubscriptionID},
+		cache,
+	}
+}
+
+func (s *containerServiceRepository) ListAllManagedClusters(ctx context.Context) ([]*ManagedCluster, error) {
+	ctx, done := profile.Method(ctx, "ContainerServiceRepository.ListAllManagedClusters")
+	defer done()
+
+	cacheKey := "containerServiceListAllManagedClusters"
+	if v := s.cache.Get(cacheKey); v != nil {
+		return v.([]*ManagedCluster), nil
+	}
+
+	pager := s.managedClustersClient.List()
+	results := make([]*ManagedCluster, 0)
+	for pager.NextPage(ctx) {
+		resp := pager.PageResponse()
+		if err := pager.Err(); err != nil {
+			return nil, err
+		}
+		results = append(results, resp.Value...)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	s.cache.Put(cacheKey, results)
+	return results, nil
+}
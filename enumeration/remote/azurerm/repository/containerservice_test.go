@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ContainerService_ListAllManagedClusters(t *testing.T) {
+	expectedResults := []*ManagedCluster{
+		{
+			ID:   to.StringPtr("/subscriptions/2c361f34-30fb-47ae-a227-83a5d3a26c66/resourceGroups/aks-resources/providers/Microsoft.ContainerService/managedClusters/cluster1"),
+			Name: to.StringPtr("cluster1"),
+			Properties: &ManagedClusterProperties{
+				NodeResourceGroup: to.StringPtr("MC_aks-resources_cluster1_westeurope"),
+			},
+		},
+		{
+			ID:   to.StringPtr("/subscriptions/2c361f34-30fb-47ae-a227-83a5d3a26c66/resourceGroups/aks-resources/providers/Microsoft.ContainerService/managedClusters/cluster2"),
+			Name: to.StringPtr("cluster2"),
+		},
+		{
+			ID:   to.StringPtr("/subscriptions/2c361f34-30fb-47ae-a227-83a5d3a26c66/resourceGroups/aks-resources/providers/Microsoft.ContainerService/managedClusters/cluster3"),
+			Name: to.StringPtr("cluster3"),
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		mocks    func(*mockManagedClustersListPager, *cache.MockCache)
+		expected []*ManagedCluster
+		wantErr  string
+	}{
+		{
+			name: "should return managed clusters",
+			mocks: func(mockPager *mockManagedClustersListPager, mockCache *cache.MockCache) {
+				mockPager.On("Err").Return(nil).Times(3)
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(2)
+				mockPager.On("NextPage", mock.Anything).Return(false).Times(1)
+				mockPager.On("PageResponse").Return(ManagedClusterListResult{
+					Value: expectedResults[:2],
+				}).Times(1)
+				mockPager.On("PageResponse").Return(ManagedClusterListResult{
+					Value: expectedResults[2:],
+				}).Times(1)
+
+				mockCache.On("Get", "containerServiceListAllManagedClusters").Return(nil).Times(1)
+				mockCache.On("Put", "containerServiceListAllManagedClusters", expectedResults).Return(false).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should hit cache and return managed clusters",
+			mocks: func(mockPager *mockManagedClustersListPager, mockCache *cache.MockCache) {
+				mockCache.On("Get", "containerServiceListAllManagedClusters").Return(expectedResults).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(mockPager *mockManagedClustersListPager, mockCache *cache.MockCache) {
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(1)
+				mockPager.On("PageResponse").Return(ManagedClusterListResult{
+					Value: []*ManagedCluster{},
+				}).Times(1)
+				mockPager.On("Err").Return(errors.New("remote error")).Times(1)
+
+				mockCache.On("Get", "containerServiceListAllManagedClusters").Return(nil).Times(1)
+			},
+			wantErr: "remote error",
+		},
+		{
+			name: "should return remote error after fetching all pages",
+			mocks: func(mockPager *mockManagedClustersListPager, mockCache *cache.MockCache) {
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(1)
+				mockPager.On("NextPage", mock.Anything).Return(false).Times(1)
+				mockPager.On("PageResponse").Return(ManagedClusterListResult{
+					Value: []*ManagedCluster{},
+				}).Times(1)
+				mockPager.On("Err").Return(nil).Times(1)
+				mockPager.On("Err").Return(errors.New("remote error")).Times(1)
+
+				mockCache.On("Get", "containerServiceListAllManagedClusters").Return(nil).Times(1)
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &mockManagedClustersClient{}
+			mockPager := &mockManagedClustersListPager{}
+			mockCache := &cache.MockCache{}
+
+			fakeClient.On("List").Maybe().Return(mockPager)
+
+			tt.mocks(mockPager, mockCache)
+
+			s := &containerServiceRepository{
+				managedClustersClient: fakeClient,
+				cache:                 mockCache,
+			}
+			got, err := s.ListAllManagedClusters(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			fakeClient.AssertExpectations(t)
+			mockPager.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ListAllManagedClusters() got = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armcompute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockComputeRepository struct {
 	mock.Mock
 }
 
-// ListAllImages provides a mock function with given fields:
-func (_m *MockComputeRepository) ListAllImages() ([]*armcompute.Image, error) {
-	ret := _m.Called()
+// ListAllImages provides a mock function with given fields: ctx
+func (_m *MockComputeRepository) ListAllImages(ctx context.Context) ([]*armcompute.Image, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armcompute.Image
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armcompute.Image, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armcompute.Image, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armcompute.Image); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armcompute.Image); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armcompute.Image)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockComputeRepository) ListAllImages() ([]*armcompute.Image, error) {
 	return r0, r1
 }
 
-// ListAllSSHPublicKeys provides a mock function with given fields:
-func (_m *MockComputeRepository) ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyResource, error) {
-	ret := _m.Called()
+// ListAllSSHPublicKeys provides a mock function with given fields: ctx
+func (_m *MockComputeRepository) ListAllSSHPublicKeys(ctx context.Context) ([]*armcompute.SSHPublicKeyResource, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armcompute.SSHPublicKeyResource
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armcompute.SSHPublicKeyResource, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armcompute.SSHPublicKeyResource, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armcompute.SSHPublicKeyResource); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armcompute.SSHPublicKeyResource); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armcompute.SSHPublicKeyResource)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
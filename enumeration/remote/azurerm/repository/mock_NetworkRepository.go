@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockNetworkRepository struct {
 	mock.Mock
 }
 
-// ListAllFirewalls provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall, error) {
-	ret := _m.Called()
+// ListAllFirewalls provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllFirewalls(ctx context.Context) ([]*armnetwork.AzureFirewall, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.AzureFirewall
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armnetwork.AzureFirewall, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armnetwork.AzureFirewall, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armnetwork.AzureFirewall); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.AzureFirewall); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.AzureFirewall)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockNetworkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall
 	return r0, r1
 }
 
-// ListAllLoadBalancers provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalancer, error) {
-	ret := _m.Called()
+// ListAllLoadBalancers provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllLoadBalancers(ctx context.Context) ([]*armnetwork.LoadBalancer, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.LoadBalancer
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armnetwork.LoadBalancer, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armnetwork.LoadBalancer, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armnetwork.LoadBalancer); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.LoadBalancer); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.LoadBalancer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +66,25 @@ func (_m *MockNetworkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalan
 	return r0, r1
 }
 
-// ListAllPublicIPAddresses provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllPublicIPAddresses() ([]*armnetwork.PublicIPAddress, error) {
-	ret := _m.Called()
+// ListAllPublicIPAddresses provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllPublicIPAddresses(ctx context.Context) ([]*armnetwork.PublicIPAddress, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.PublicIPAddress
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armnetwork.PublicIPAddress, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armnetwork.PublicIPAddress, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armnetwork.PublicIPAddress); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.PublicIPAddress); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.PublicIPAddress)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,25 +92,25 @@ func (_m *MockNetworkRepository) ListAllPublicIPAddresses() ([]*armnetwork.Publi
 	return r0, r1
 }
 
-// ListAllRouteTables provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable, error) {
-	ret := _m.Called()
+// ListAllRouteTables provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllRouteTables(ctx context.Context) ([]*armnetwork.RouteTable, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.RouteTable
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armnetwork.RouteTable, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armnetwork.RouteTable, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armnetwork.RouteTable); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.RouteTable); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.RouteTable)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,25 +118,25 @@ func (_m *MockNetworkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable,
 	return r0, r1
 }
 
-// ListAllSecurityGroups provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkSecurityGroup, error) {
-	ret := _m.Called()
+// ListAllSecurityGroups provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllSecurityGroups(ctx context.Context) ([]*armnetwork.NetworkSecurityGroup, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.NetworkSecurityGroup
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armnetwork.NetworkSecurityGroup, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armnetwork.NetworkSecurityGroup, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armnetwork.NetworkSecurityGroup); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.NetworkSecurityGroup); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.NetworkSecurityGroup)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -142,25 +144,25 @@ func (_m *MockNetworkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkS
 	return r0, r1
 }
 
-// ListAllSubnets provides a mock function with given fields: virtualNetwork
-func (_m *MockNetworkRepository) ListAllSubnets(virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error) {
-	ret := _m.Called(virtualNetwork)
+// ListAllSubnets provides a mock function with given fields: ctx, virtualNetwork
+func (_m *MockNetworkRepository) ListAllSubnets(ctx context.Context, virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error) {
+	ret := _m.Called(ctx, virtualNetwork)
 
 	var r0 []*armnetwork.Subnet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error)); ok {
-		return rf(virtualNetwork)
+	if rf, ok := ret.Get(0).(func(context.Context, *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error)); ok {
+		return rf(ctx, virtualNetwork)
 	}
-	if rf, ok := ret.Get(0).(func(*armnetwork.VirtualNetwork) []*armnetwork.Subnet); ok {
-		r0 = rf(virtualNetwork)
+	if rf, ok := ret.Get(0).(func(context.Context, *armnetwork.VirtualNetwork) []*armnetwork.Subnet); ok {
+		r0 = rf(ctx, virtualNetwork)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.Subnet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armnetwork.VirtualNetwork) error); ok {
-		r1 = rf(virtualNetwork)
+	if rf, ok := ret.Get(1).(func(context.Context, *armnetwork.VirtualNetwork) error); ok {
+		r1 = rf(ctx, virtualNetwork)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,25 +170,25 @@ func (_m *MockNetworkRepository) ListAllSubnets(virtualNetwork *armnetwork.Virtu
 	return r0, r1
 }
 
-// ListAllVirtualNetworks provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllVirtualNetworks() ([]*armnetwork.VirtualNetwork, error) {
-	ret := _m.Called()
+// ListAllVirtualNetworks provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllVirtualNetworks(ctx context.Context) ([]*armnetwork.VirtualNetwork, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.VirtualNetwork
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armnetwork.VirtualNetwork, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armnetwork.VirtualNetwork, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armnetwork.VirtualNetwork); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.VirtualNetwork); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.VirtualNetwork)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -194,25 +196,25 @@ func (_m *MockNetworkRepository) ListAllVirtualNetworks() ([]*armnetwork.Virtual
 	return r0, r1
 }
 
-// ListLoadBalancerRules provides a mock function with given fields: _a0
-func (_m *MockNetworkRepository) ListLoadBalancerRules(_a0 *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error) {
-	ret := _m.Called(_a0)
+// ListLoadBalancerRules provides a mock function with given fields: _a0, _a1
+func (_m *MockNetworkRepository) ListLoadBalancerRules(_a0 context.Context, _a1 *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*armnetwork.LoadBalancingRule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(*armnetwork.LoadBalancer) []*armnetwork.LoadBalancingRule); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *armnetwork.LoadBalancer) []*armnetwork.LoadBalancingRule); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.LoadBalancingRule)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armnetwork.LoadBalancer) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *armnetwork.LoadBalancer) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
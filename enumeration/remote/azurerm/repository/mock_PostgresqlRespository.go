@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armpostgresql "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresql"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockPostgresqlRespository struct {
 	mock.Mock
 }
 
-// ListAllDatabasesByServer provides a mock function with given fields: server
-func (_m *MockPostgresqlRespository) ListAllDatabasesByServer(server *armpostgresql.Server) ([]*armpostgresql.Database, error) {
-	ret := _m.Called(server)
+// ListAllDatabasesByServer provides a mock function with given fields: ctx, server
+func (_m *MockPostgresqlRespository) ListAllDatabasesByServer(ctx context.Context, server *armpostgresql.Server) ([]*armpostgresql.Database, error) {
+	ret := _m.Called(ctx, server)
 
 	var r0 []*armpostgresql.Database
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armpostgresql.Server) ([]*armpostgresql.Database, error)); ok {
-		return rf(server)
+	if rf, ok := ret.Get(0).(func(context.Context, *armpostgresql.Server) ([]*armpostgresql.Database, error)); ok {
+		return rf(ctx, server)
 	}
-	if rf, ok := ret.Get(0).(func(*armpostgresql.Server) []*armpostgresql.Database); ok {
-		r0 = rf(server)
+	if rf, ok := ret.Get(0).(func(context.Context, *armpostgresql.Server) []*armpostgresql.Database); ok {
+		r0 = rf(ctx, server)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armpostgresql.Database)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armpostgresql.Server) error); ok {
-		r1 = rf(server)
+	if rf, ok := ret.Get(1).(func(context.Context, *armpostgresql.Server) error); ok {
+		r1 = rf(ctx, server)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockPostgresqlRespository) ListAllDatabasesByServer(server *armpostgre
 	return r0, r1
 }
 
-// ListAllServers provides a mock function with given fields:
-func (_m *MockPostgresqlRespository) ListAllServers() ([]*armpostgresql.Server, error) {
-	ret := _m.Called()
+// ListAllServers provides a mock function with given fields: ctx
+func (_m *MockPostgresqlRespository) ListAllServers(ctx context.Context) ([]*armpostgresql.Server, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armpostgresql.Server
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armpostgresql.Server, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armpostgresql.Server, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armpostgresql.Server); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armpostgresql.Server); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armpostgresql.Server)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
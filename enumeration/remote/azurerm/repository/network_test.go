@@ -92,7 +92,7 @@ func Test_ListAllVirtualNetwork_MultiplesResults(t *testing.T) {
 		virtualNetworksClient: fakeClient,
 		cache:                 c,
 	}
-	got, err := s.ListAllVirtualNetworks()
+	got, err := s.ListAllVirtualNetworks(context.Background())
 	if err != nil {
 		t.Errorf("ListAllVirtualNetworks() error = %v", err)
 		return
@@ -126,7 +126,7 @@ func Test_ListAllVirtualNetwork_MultiplesResults_WithCache(t *testing.T) {
 		virtualNetworksClient: fakeClient,
 		cache:                 c,
 	}
-	got, err := s.ListAllVirtualNetworks()
+	got, err := s.ListAllVirtualNetworks(context.Background())
 	if err != nil {
 		t.Errorf("ListAllVirtualNetworks() error = %v", err)
 		return
@@ -157,7 +157,7 @@ func Test_ListAllVirtualNetwork_Error_OnPageResponse(t *testing.T) {
 		virtualNetworksClient: fakeClient,
 		cache:                 cache.New(0),
 	}
-	got, err := s.ListAllVirtualNetworks()
+	got, err := s.ListAllVirtualNetworks(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -182,7 +182,7 @@ func Test_ListAllVirtualNetwork_Error(t *testing.T) {
 		virtualNetworksClient: fakeClient,
 		cache:                 cache.New(0),
 	}
-	got, err := s.ListAllVirtualNetworks()
+	got, err := s.ListAllVirtualNetworks(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -247,7 +247,7 @@ func Test_ListAllRouteTables_MultiplesResults(t *testing.T) {
 		routeTableClient: fakeClient,
 		cache:            c,
 	}
-	got, err := s.ListAllRouteTables()
+	got, err := s.ListAllRouteTables(context.Background())
 	if err != nil {
 		t.Errorf("ListAllRouteTables() error = %v", err)
 		return
@@ -281,7 +281,7 @@ func Test_ListAllRouteTables_MultiplesResults_WithCache(t *testing.T) {
 		routeTableClient: fakeClient,
 		cache:            c,
 	}
-	got, err := s.ListAllRouteTables()
+	got, err := s.ListAllRouteTables(context.Background())
 	if err != nil {
 		t.Errorf("ListAllRouteTables() error = %v", err)
 		return
@@ -312,7 +312,7 @@ func Test_ListAllRouteTables_Error_OnPageResponse(t *testing.T) {
 		routeTableClient: fakeClient,
 		cache:            cache.New(0),
 	}
-	got, err := s.ListAllRouteTables()
+	got, err := s.ListAllRouteTables(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -337,7 +337,7 @@ func Test_ListAllRouteTables_Error(t *testing.T) {
 		routeTableClient: fakeClient,
 		cache:            cache.New(0),
 	}
-	got, err := s.ListAllRouteTables()
+	got, err := s.ListAllRouteTables(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -431,7 +431,7 @@ func Test_ListAllSubnets_MultiplesResults(t *testing.T) {
 		subnetsClient: fakeClient,
 		cache:         c,
 	}
-	got, err := s.ListAllSubnets(network)
+	got, err := s.ListAllSubnets(context.Background(), network)
 	if err != nil {
 		t.Errorf("ListAllSubnets() error = %v", err)
 		return
@@ -467,7 +467,7 @@ func Test_ListAllSubnets_MultiplesResults_WithCache(t *testing.T) {
 		subnetsClient: fakeClient,
 		cache:         c,
 	}
-	got, err := s.ListAllSubnets(network)
+	got, err := s.ListAllSubnets(context.Background(), network)
 	if err != nil {
 		t.Errorf("ListAllSubnets() error = %v", err)
 		return
@@ -505,7 +505,7 @@ func Test_ListAllSubnets_Error_OnPageResponse(t *testing.T) {
 		subnetsClient: fakeClient,
 		cache:         cache.New(0),
 	}
-	got, err := s.ListAllSubnets(network)
+	got, err := s.ListAllSubnets(context.Background(), network)
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -537,7 +537,7 @@ func Test_ListAllSubnets_Error(t *testing.T) {
 		subnetsClient: fakeClient,
 		cache:         cache.New(0),
 	}
-	got, err := s.ListAllSubnets(network)
+	got, err := s.ListAllSubnets(context.Background(), network)
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -563,7 +563,7 @@ func Test_ListAllSubnets_ErrorOnInvalidNetworkID(t *testing.T) {
 		subnetsClient: fakeClient,
 		cache:         cache.New(0),
 	}
-	got, err := s.ListAllSubnets(network)
+	got, err := s.ListAllSubnets(context.Background(), network)
 
 	fakeClient.AssertExpectations(t)
 
@@ -626,7 +626,7 @@ func Test_ListAllFirewalls_MultiplesResults(t *testing.T) {
 		firewallsClient: fakeClient,
 		cache:           c,
 	}
-	got, err := s.ListAllFirewalls()
+	got, err := s.ListAllFirewalls(context.Background())
 	if err != nil {
 		t.Errorf("ListAllFirewalls() error = %v", err)
 		return
@@ -659,7 +659,7 @@ func Test_ListAllFirewalls_MultiplesResults_WithCache(t *testing.T) {
 		firewallsClient: fakeClient,
 		cache:           c,
 	}
-	got, err := s.ListAllFirewalls()
+	got, err := s.ListAllFirewalls(context.Background())
 	if err != nil {
 		t.Errorf("ListAllFirewalls() error = %v", err)
 		return
@@ -690,7 +690,7 @@ func Test_ListAllFirewalls_Error_OnPageResponse(t *testing.T) {
 		firewallsClient: fakeClient,
 		cache:           cache.New(0),
 	}
-	got, err := s.ListAllFirewalls()
+	got, err := s.ListAllFirewalls(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -715,7 +715,7 @@ func Test_ListAllFirewalls_Error(t *testing.T) {
 		firewallsClient: fakeClient,
 		cache:           cache.New(0),
 	}
-	got, err := s.ListAllFirewalls()
+	got, err := s.ListAllFirewalls(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -779,7 +779,7 @@ func Test_ListAllPublicIPAddresses_MultiplesResults(t *testing.T) {
 		publicIPAddressesClient: fakeClient,
 		cache:                   c,
 	}
-	got, err := s.ListAllPublicIPAddresses()
+	got, err := s.ListAllPublicIPAddresses(context.Background())
 	if err != nil {
 		t.Errorf("ListAllPublicIPAddresses() error = %v", err)
 		return
@@ -812,7 +812,7 @@ func Test_ListAllPublicIPAddresses_MultiplesResults_WithCache(t *testing.T) {
 		publicIPAddressesClient: fakeClient,
 		cache:                   c,
 	}
-	got, err := s.ListAllPublicIPAddresses()
+	got, err := s.ListAllPublicIPAddresses(context.Background())
 	if err != nil {
 		t.Errorf("ListAllPublicIPAddresses() error = %v", err)
 		return
@@ -843,7 +843,7 @@ func Test_ListAllPublicIPAddresses_Error_OnPageResponse(t *testing.T) {
 		publicIPAddressesClient: fakeClient,
 		cache:                   cache.New(0),
 	}
-	got, err := s.ListAllPublicIPAddresses()
+	got, err := s.ListAllPublicIPAddresses(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -868,7 +868,7 @@ func Test_ListAllPublicIPAddresses_Error(t *testing.T) {
 		publicIPAddressesClient: fakeClient,
 		cache:                   cache.New(0),
 	}
-	got, err := s.ListAllPublicIPAddresses()
+	got, err := s.ListAllPublicIPAddresses(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -953,7 +953,7 @@ func Test_Network_ListAllSecurityGroups(t *testing.T) {
 				networkSecurityGroupsClient: fakeClient,
 				cache:                       mockCache,
 			}
-			got, err := s.ListAllSecurityGroups()
+			got, err := s.ListAllSecurityGroups(context.Background())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -1049,7 +1049,7 @@ func Test_Network_ListAllLoadBalancers(t *testing.T) {
 				loadBalancersClient: fakeClient,
 				cache:               mockCache,
 			}
-			got, err := s.ListAllLoadBalancers()
+			got, err := s.ListAllLoadBalancers(context.Background())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -1154,7 +1154,7 @@ func Test_Network_ListLoadBalancerRules(t *testing.T) {
 				loadBalancerRulesClient: fakeClient,
 				cache:                   mockCache,
 			}
-			got, err := s.ListLoadBalancerRules(tt.loadBalancer)
+			got, err := s.ListLoadBalancerRules(context.Background(), tt.loadBalancer)
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
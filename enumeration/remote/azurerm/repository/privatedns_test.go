@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -107,7 +108,7 @@ func Test_ListAllPrivateZones_MultiplesResults(t *testing.T) {
 		zoneClient: fakeClient,
 		cache:      c,
 	}
-	got, err := s.ListAllPrivateZones()
+	got, err := s.ListAllPrivateZones(context.Background())
 	if err != nil {
 		t.Errorf("ListAllPrivateZones() error = %v", err)
 		return
@@ -144,7 +145,7 @@ func Test_ListAllPrivateZones_MultiplesResults_WithCache(t *testing.T) {
 		zoneClient: fakeClient,
 		cache:      c,
 	}
-	got, err := s.ListAllPrivateZones()
+	got, err := s.ListAllPrivateZones(context.Background())
 	if err != nil {
 		t.Errorf("ListAllPrivateZones() error = %v", err)
 		return
@@ -175,7 +176,7 @@ func Test_ListAllPrivateZones_Error(t *testing.T) {
 		zoneClient: fakeClient,
 		cache:      cache.New(0),
 	}
-	got, err := s.ListAllPrivateZones()
+	got, err := s.ListAllPrivateZones(context.Background())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -289,7 +290,7 @@ func Test_ListAllARecords_MultiplesResults(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllARecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllARecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -337,7 +338,7 @@ func Test_ListAllARecords_MultiplesResults_WithCache(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllARecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllARecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -375,7 +376,7 @@ func Test_ListAllARecords_Error(t *testing.T) {
 		recordClient: fakeClient,
 		cache:        cache.New(0),
 	}
-	got, err := s.ListAllARecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllARecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -496,7 +497,7 @@ func Test_ListAllAAAARecords_MultiplesResults(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllAAAARecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllAAAARecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -544,7 +545,7 @@ func Test_ListAllAAAARecords_MultiplesResults_WithCache(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllAAAARecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllAAAARecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -582,7 +583,7 @@ func Test_ListAllAAAARecords_Error(t *testing.T) {
 		recordClient: fakeClient,
 		cache:        cache.New(0),
 	}
-	got, err := s.ListAllAAAARecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllAAAARecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -703,7 +704,7 @@ func Test_ListAllCNAMERecords_MultiplesResults(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllCNAMERecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllCNAMERecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -753,7 +754,7 @@ func Test_ListAllCNAMERecords_MultiplesResults_WithCache(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllCNAMERecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllCNAMERecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -791,7 +792,7 @@ func Test_ListAllCNAMERecords_Error(t *testing.T) {
 		recordClient: fakeClient,
 		cache:        cache.New(0),
 	}
-	got, err := s.ListAllCNAMERecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllCNAMERecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -912,7 +913,7 @@ func Test_ListAllPTRRecords_MultiplesResults(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllPTRRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllPTRRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -960,7 +961,7 @@ func Test_ListAllPTRRecords_MultiplesResults_WithCache(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllPTRRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllPTRRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -998,7 +999,7 @@ func Test_ListAllPTRRecords_Error(t *testing.T) {
 		recordClient: fakeClient,
 		cache:        cache.New(0),
 	}
-	got, err := s.ListAllPTRRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllPTRRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1118,7 +1119,7 @@ func Test_ListAllMXRecords_MultiplesResults(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllMXRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllMXRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1166,7 +1167,7 @@ func Test_ListAllMXRecords_MultiplesResults_WithCache(t *testing.T) {
 		cache:        c,
 	}
 
-	got, err := s.ListAllMXRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllMXRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1206,7 +1207,7 @@ func Test_ListAllMXRecords_Error(t *testing.T) {
 		cache:        cache.New(0),
 	}
 
-	got, err := s.ListAllMXRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllMXRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1327,7 +1328,7 @@ func Test_ListAllSRVRecords_MultiplesResults(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllSRVRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllSRVRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1375,7 +1376,7 @@ func Test_ListAllSRVRecords_MultiplesResults_WithCache(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllSRVRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllSRVRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1413,7 +1414,7 @@ func Test_ListAllSRVRecords_Error(t *testing.T) {
 		recordClient: fakeClient,
 		cache:        cache.New(0),
 	}
-	got, err := s.ListAllSRVRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllSRVRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1534,7 +1535,7 @@ func Test_ListAllTXTRecords_MultiplesResults(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllTXTRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllTXTRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1582,7 +1583,7 @@ func Test_ListAllTXTRecords_MultiplesResults_WithCache(t *testing.T) {
 		recordClient: fakeRecordSetClient,
 		cache:        c,
 	}
-	got, err := s.ListAllTXTRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllTXTRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
@@ -1620,7 +1621,7 @@ func Test_ListAllTXTRecords_Error(t *testing.T) {
 		recordClient: fakeClient,
 		cache:        cache.New(0),
 	}
-	got, err := s.ListAllTXTRecords(&armprivatedns.PrivateZone{
+	got, err := s.ListAllTXTRecords(context.Background(), &armprivatedns.PrivateZone{
 		TrackedResource: armprivatedns.TrackedResource{
 			Resource: armprivatedns.Resource{
 				ID:   to.StringPtr("/subscriptions/subid/resourceGroups/rgid/providers/Microsoft.Network/privateDnsZones/zone.com"),
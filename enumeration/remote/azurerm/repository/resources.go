@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/common"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -11,7 +12,7 @@ import (
 )
 
 type ResourcesRepository interface {
-	ListAllResourceGroups() ([]*armresources.ResourceGroup, error)
+	ListAllResourceGroups(ctx context.Context) ([]*armresources.ResourceGroup, error)
 }
 
 type resourcesListPager interface {
@@ -43,7 +44,10 @@ func NewResourcesRepository(cred azcore.TokenCredential, options *arm.ClientOpti
 	}
 }
 
-func (s *resourcesRepository) ListAllResourceGroups() ([]*armresources.ResourceGroup, error) {
+func (s *resourcesRepository) ListAllResourceGroups(ctx context.Context) ([]*armresources.ResourceGroup, error) {
+	ctx, done := profile.Method(ctx, "ResourcesRepository.ListAllResourceGroups")
+	defer done()
+
 	cacheKey := "resourcesListAllResourceGroups"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armresources.ResourceGroup), nil
@@ -51,7 +55,7 @@ func (s *resourcesRepository) ListAllResourceGroups() ([]*armresources.ResourceG
 
 	pager := s.client.List(nil)
 	results := make([]*armresources.ResourceGroup, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
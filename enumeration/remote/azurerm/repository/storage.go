@@ -7,6 +7,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/common"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -17,8 +18,8 @@ import (
 )
 
 type StorageRespository interface {
-	ListAllStorageAccount() ([]*armstorage.StorageAccount, error)
-	ListAllStorageContainer(account *armstorage.StorageAccount) ([]string, error)
+	ListAllStorageAccount(ctx context.Context) ([]*armstorage.StorageAccount, error)
+	ListAllStorageContainer(ctx context.Context, account *armstorage.StorageAccount) ([]string, error)
 }
 
 type blobContainerListPager interface {
@@ -70,7 +71,9 @@ func NewStorageRepository(cred azcore.TokenCredential, options *arm.ClientOption
 	}
 }
 
-func (s *storageRepository) ListAllStorageAccount() ([]*armstorage.StorageAccount, error) {
+func (s *storageRepository) ListAllStorageAccount(ctx context.Context) ([]*armstorage.StorageAccount, error) {
+	ctx, done := profile.Method(ctx, "StorageRespository.ListAllStorageAccount")
+	defer done()
 
 	cacheKey := "ListAllStorageAccount"
 	v := s.cache.GetAndLock(cacheKey)
@@ -81,7 +84,7 @@ func (s *storageRepository) ListAllStorageAccount() ([]*armstorage.StorageAccoun
 
 	pager := s.storageAccountsClient.List(nil)
 	results := make([]*armstorage.StorageAccount, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -98,7 +101,9 @@ func (s *storageRepository) ListAllStorageAccount() ([]*armstorage.StorageAccoun
 	return results, nil
 }
 
-func (s *storageRepository) ListAllStorageContainer(account *armstorage.StorageAccount) ([]string, error) {
+func (s *storageRepository) ListAllStorageContainer(ctx context.Context, account *armstorage.StorageAccount) ([]string, error) {
+	ctx, done := profile.Method(ctx, "StorageRespository.ListAllStorageContainer")
+	defer done()
 
 	cacheKey := fmt.Sprintf("ListAllStorageContainer_%s", *account.Name)
 	if v := s.cache.Get(cacheKey); v != nil {
@@ -112,7 +117,7 @@ func (s *storageRepository) ListAllStorageContainer(account *armstorage.StorageA
 
 	pager := s.blobContainerClient.List(res.ResourceGroup, *account.Name, nil)
 	results := make([]string, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			if !shouldIgnoreStorageContainerError(err) {
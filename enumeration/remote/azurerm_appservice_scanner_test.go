@@ -0,0 +1,192 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	error2 "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceazure "github.com/snyk/driftctl/enumeration/resource/azurerm"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAzurermServicePlan(t *testing.T) {
+
+	dummyError := errors.New("this is an error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockAppServiceRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no service plan",
+			mocks: func(repo *repository.MockAppServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllServicePlans", mock.Anything).Return([]*repository.ServicePlan{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "error listing service plans",
+			mocks: func(repo *repository.MockAppServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllServicePlans", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureServicePlanResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureServicePlanResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureServicePlanResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple service plans",
+			mocks: func(repo *repository.MockAppServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllServicePlans", mock.Anything).Return([]*repository.ServicePlan{
+					{
+						ID:   to.StringPtr("/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Web/serverfarms/plan1"),
+						Name: to.StringPtr("plan1"),
+					},
+					{
+						ID:   to.StringPtr("/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Web/serverFarms/plan2"),
+						Name: to.StringPtr("plan2"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Web/serverFarms/plan1", got[0].ResourceId())
+				assert.Equal(t, resourceazure.AzureServicePlanResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Web/serverFarms/plan2", got[1].ResourceId())
+				assert.Equal(t, resourceazure.AzureServicePlanResourceType, got[1].ResourceType())
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockAppServiceRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.AppServiceRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(azurerm.NewAzurermServicePlanEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestAzurermLinuxWebAppAndFunctionApp(t *testing.T) {
+
+	dummyError := errors.New("this is an error")
+
+	sites := []*repository.Site{
+		{ID: to.StringPtr("webapp"), Name: to.StringPtr("webapp"), Kind: to.StringPtr("app,linux")},
+		{ID: to.StringPtr("container-webapp"), Name: to.StringPtr("container-webapp"), Kind: to.StringPtr("app,linux,container")},
+		{ID: to.StringPtr("windows-webapp"), Name: to.StringPtr("windows-webapp"), Kind: to.StringPtr("app")},
+		{ID: to.StringPtr("function"), Name: to.StringPtr("function"), Kind: to.StringPtr("functionapp,linux")},
+		{ID: to.StringPtr("windows-function"), Name: to.StringPtr("windows-function"), Kind: to.StringPtr("functionapp")},
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockAppServiceRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no site",
+			mocks: func(repo *repository.MockAppServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSites", mock.Anything).Return([]*repository.Site{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "error listing sites",
+			mocks: func(repo *repository.MockAppServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSites", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureLinuxWebAppResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureLinuxWebAppResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureLinuxWebAppResourceType))).Return()
+				alerter.On("SendAlert", resourceazure.AzureLinuxFunctionAppResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureLinuxFunctionAppResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureLinuxFunctionAppResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "only linux sites are enumerated",
+			mocks: func(repo *repository.MockAppServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSites", mock.Anything).Return(sites, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+
+				assert.Equal(t, "webapp", got[0].ResourceId())
+				assert.Equal(t, resourceazure.AzureLinuxWebAppResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "container-webapp", got[1].ResourceId())
+				assert.Equal(t, resourceazure.AzureLinuxWebAppResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "function", got[2].ResourceId())
+				assert.Equal(t, resourceazure.AzureLinuxFunctionAppResourceType, got[2].ResourceType())
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockAppServiceRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.AppServiceRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(azurerm.NewAzurermLinuxWebAppEnumerator(repo, factory))
+			remoteLibrary.AddEnumerator(azurerm.NewAzurermLinuxFunctionAppEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -33,12 +35,11 @@ func TestAzurermCompute_Image(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockComputeRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no images",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*armcompute.Image{}, nil)
+				repository.On("ListAllImages", mock.Anything).Return([]*armcompute.Image{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -47,14 +48,17 @@ func TestAzurermCompute_Image(t *testing.T) {
 		{
 			test: "error listing images",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return(nil, dummyError)
+				repository.On("ListAllImages", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureImageResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureImageResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzureImageResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzureImageResourceType),
 		},
 		{
 			test: "multiple images including an invalid ID",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*armcompute.Image{
+				repository.On("ListAllImages", mock.Anything).Return([]*armcompute.Image{
 					{
 						Resource: armcompute.Resource{
 							ID:   to.StringPtr("/subscriptions/4e411884-65b0-4911-bc80-52f9a21942a2/resourceGroups/testgroup/providers/Microsoft.Compute/images/image1"),
@@ -104,11 +108,8 @@ func TestAzurermCompute_Image(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -125,13 +126,12 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockComputeRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no public key",
 			dirName: "azurerm_ssh_public_key_empty",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return([]*armcompute.SSHPublicKeyResource{}, nil)
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return([]*armcompute.SSHPublicKeyResource{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -141,18 +141,18 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			test:    "error listing public keys",
 			dirName: "azurerm_ssh_public_key_empty",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return(nil, dummyError)
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureSSHPublicKeyResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureSSHPublicKeyResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzureSSHPublicKeyResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzureSSHPublicKeyResourceType),
 		},
 		{
 			test:    "multiple public keys",
 			dirName: "azurerm_ssh_public_key_multiple",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return([]*armcompute.SSHPublicKeyResource{
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return([]*armcompute.SSHPublicKeyResource{
 					{
 						Resource: armcompute.Resource{
 							ID:   to.StringPtr("/subscriptions/7bfb2c5c-7308-46ed-8ae4-fffa356eb406/resourceGroups/TESTRESGROUP/providers/Microsoft.Compute/sshPublicKeys/example-key"),
@@ -223,12 +223,8 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/common"
@@ -29,12 +31,11 @@ func TestAzurermContainerRegistry(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockContainerRegistryRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no container registry",
 			mocks: func(repository *repository.MockContainerRegistryRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllContainerRegistries").Return([]*armcontainerregistry.Registry{}, nil)
+				repository.On("ListAllContainerRegistries", mock.Anything).Return([]*armcontainerregistry.Registry{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,14 +44,17 @@ func TestAzurermContainerRegistry(t *testing.T) {
 		{
 			test: "error listing container registry",
 			mocks: func(repository *repository.MockContainerRegistryRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllContainerRegistries").Return(nil, dummyError)
+				repository.On("ListAllContainerRegistries", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureContainerRegistryResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureContainerRegistryResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureContainerRegistryResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureContainerRegistryResourceType),
 		},
 		{
 			test: "multiple container registries",
 			mocks: func(repository *repository.MockContainerRegistryRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllContainerRegistries").Return([]*armcontainerregistry.Registry{
+				repository.On("ListAllContainerRegistries", mock.Anything).Return([]*armcontainerregistry.Registry{
 					{
 						Resource: armcontainerregistry.Resource{
 							ID: to.StringPtr("registry1"),
@@ -94,11 +98,8 @@ func TestAzurermContainerRegistry(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -0,0 +1,204 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	error2 "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceazure "github.com/snyk/driftctl/enumeration/resource/azurerm"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAzurermKubernetesCluster(t *testing.T) {
+
+	dummyError := errors.New("this is an error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockContainerServiceRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no kubernetes cluster",
+			mocks: func(repo *repository.MockContainerServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllManagedClusters", mock.Anything).Return([]*repository.ManagedCluster{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "error listing kubernetes clusters",
+			mocks: func(repo *repository.MockContainerServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllManagedClusters", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureKubernetesClusterResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureKubernetesClusterResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureKubernetesClusterResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple kubernetes clusters",
+			mocks: func(repo *repository.MockContainerServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllManagedClusters", mock.Anything).Return([]*repository.ManagedCluster{
+					{
+						ID:   to.StringPtr("cluster1"),
+						Name: to.StringPtr("cluster1"),
+						Properties: &repository.ManagedClusterProperties{
+							NodeResourceGroup: to.StringPtr("MC_rg_cluster1_westeurope"),
+						},
+					},
+					{
+						ID:   to.StringPtr("cluster2"),
+						Name: to.StringPtr("cluster2"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "cluster1", got[0].ResourceId())
+				assert.Equal(t, resourceazure.AzureKubernetesClusterResourceType, got[0].ResourceType())
+				assert.Equal(t, "MC_rg_cluster1_westeurope", *got[0].Attributes().GetString("node_resource_group"))
+
+				assert.Equal(t, "cluster2", got[1].ResourceId())
+				assert.Equal(t, resourceazure.AzureKubernetesClusterResourceType, got[1].ResourceType())
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockContainerServiceRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ContainerServiceRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(azurerm.NewAzurermKubernetesClusterEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestAzurermKubernetesClusterNodePool(t *testing.T) {
+
+	dummyError := errors.New("this is an error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockContainerServiceRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no node pool",
+			mocks: func(repo *repository.MockContainerServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllManagedClusters", mock.Anything).Return([]*repository.ManagedCluster{
+					{
+						ID:   to.StringPtr("cluster1"),
+						Name: to.StringPtr("cluster1"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "error listing kubernetes clusters",
+			mocks: func(repo *repository.MockContainerServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllManagedClusters", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureKubernetesClusterNodePoolResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureKubernetesClusterNodePoolResourceType, error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureKubernetesClusterNodePoolResourceType, resourceazure.AzureKubernetesClusterResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple node pools",
+			mocks: func(repo *repository.MockContainerServiceRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllManagedClusters", mock.Anything).Return([]*repository.ManagedCluster{
+					{
+						ID:   to.StringPtr("cluster1"),
+						Name: to.StringPtr("cluster1"),
+						Properties: &repository.ManagedClusterProperties{
+							AgentPoolProfiles: []*repository.ManagedClusterAgentPoolProfile{
+								{Name: to.StringPtr("default"), Mode: to.StringPtr("System")},
+								{Name: to.StringPtr("system2"), Mode: to.StringPtr("System")},
+								{Name: to.StringPtr("user"), Mode: to.StringPtr("User")},
+							},
+						},
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+
+				assert.Equal(t, "cluster1/agentPools/default", got[0].ResourceId())
+				assert.Equal(t, resourceazure.AzureKubernetesClusterNodePoolResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "cluster1/agentPools/system2", got[1].ResourceId())
+				assert.Equal(t, resourceazure.AzureKubernetesClusterNodePoolResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "cluster1/agentPools/user", got[2].ResourceId())
+				assert.Equal(t, resourceazure.AzureKubernetesClusterNodePoolResourceType, got[2].ResourceType())
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockContainerServiceRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ContainerServiceRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(azurerm.NewAzurermKubernetesClusterNodePoolEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -33,12 +35,11 @@ func TestAzurermVirtualNetwork(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return([]*armnetwork.VirtualNetwork{}, nil)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return([]*armnetwork.VirtualNetwork{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -47,14 +48,17 @@ func TestAzurermVirtualNetwork(t *testing.T) {
 		{
 			test: "error listing virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(nil, dummyError)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureVirtualNetworkResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureVirtualNetworkResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureVirtualNetworkResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureVirtualNetworkResourceType),
 		},
 		{
 			test: "multiple virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return([]*armnetwork.VirtualNetwork{
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return([]*armnetwork.VirtualNetwork{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("network1"),
@@ -100,11 +104,8 @@ func TestAzurermVirtualNetwork(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -120,12 +121,11 @@ func TestAzurermRouteTables(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -134,14 +134,17 @@ func TestAzurermRouteTables(t *testing.T) {
 		{
 			test: "error listing route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return(nil, dummyError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureRouteTableResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureRouteTableResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureRouteTableResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureRouteTableResourceType),
 		},
 		{
 			test: "multiple route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("route1"),
@@ -187,11 +190,8 @@ func TestAzurermRouteTables(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -207,12 +207,11 @@ func TestAzurermRoutes(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -221,7 +220,7 @@ func TestAzurermRoutes(t *testing.T) {
 		{
 			test: "no routes",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{
 					{
 						Properties: &armnetwork.RouteTablePropertiesFormat{
 							Routes: []*armnetwork.Route{},
@@ -241,14 +240,17 @@ func TestAzurermRoutes(t *testing.T) {
 		{
 			test: "error listing route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return(nil, dummyError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureRouteResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureRouteResourceType, error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureRouteResourceType, resourceazure.AzureRouteTableResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureRouteResourceType, resourceazure.AzureRouteTableResourceType),
 		},
 		{
 			test: "multiple routes",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{
 					{
 						Resource: armnetwork.Resource{
 							Name: to.StringPtr("table1"),
@@ -330,11 +332,8 @@ func TestAzurermRoutes(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -363,14 +362,13 @@ func TestAzurermSubnets(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no subnets",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(networks, nil)
-				repository.On("ListAllSubnets", networks[0]).Return([]*armnetwork.Subnet{}, nil).Times(1)
-				repository.On("ListAllSubnets", networks[1]).Return([]*armnetwork.Subnet{}, nil).Times(1)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(networks, nil)
+				repository.On("ListAllSubnets", mock.Anything, networks[0]).Return([]*armnetwork.Subnet{}, nil).Times(1)
+				repository.On("ListAllSubnets", mock.Anything, networks[1]).Return([]*armnetwork.Subnet{}, nil).Times(1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -379,23 +377,29 @@ func TestAzurermSubnets(t *testing.T) {
 		{
 			test: "error listing virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(nil, dummyError)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureSubnetResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureSubnetResourceType, error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureSubnetResourceType, resourceazure.AzureVirtualNetworkResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureSubnetResourceType, resourceazure.AzureVirtualNetworkResourceType),
 		},
 		{
 			test: "error listing subnets",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(networks, nil)
-				repository.On("ListAllSubnets", networks[0]).Return(nil, dummyError).Times(1)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(networks, nil)
+				repository.On("ListAllSubnets", mock.Anything, networks[0]).Return(nil, dummyError).Times(1)
+				alerter.On("SendAlert", resourceazure.AzureSubnetResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureSubnetResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureSubnetResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureSubnetResourceType),
 		},
 		{
 			test: "multiple subnets",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(networks, nil)
-				repository.On("ListAllSubnets", networks[0]).Return([]*armnetwork.Subnet{
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(networks, nil)
+				repository.On("ListAllSubnets", mock.Anything, networks[0]).Return([]*armnetwork.Subnet{
 					{
 						SubResource: armnetwork.SubResource{
 							ID: to.StringPtr("subnet1"),
@@ -407,7 +411,7 @@ func TestAzurermSubnets(t *testing.T) {
 						},
 					},
 				}, nil).Times(1)
-				repository.On("ListAllSubnets", networks[1]).Return([]*armnetwork.Subnet{
+				repository.On("ListAllSubnets", mock.Anything, networks[1]).Return([]*armnetwork.Subnet{
 					{
 						SubResource: armnetwork.SubResource{
 							ID: to.StringPtr("subnet3"),
@@ -457,11 +461,8 @@ func TestAzurermSubnets(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -477,12 +478,11 @@ func TestAzurermFirewalls(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no firewall",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllFirewalls").Return([]*armnetwork.AzureFirewall{}, nil)
+				repository.On("ListAllFirewalls", mock.Anything).Return([]*armnetwork.AzureFirewall{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -491,14 +491,17 @@ func TestAzurermFirewalls(t *testing.T) {
 		{
 			test: "error listing firewalls",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllFirewalls").Return(nil, dummyError)
+				repository.On("ListAllFirewalls", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureFirewallResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureFirewallResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureFirewallResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureFirewallResourceType),
 		},
 		{
 			test: "multiple firewalls",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllFirewalls").Return([]*armnetwork.AzureFirewall{
+				repository.On("ListAllFirewalls", mock.Anything).Return([]*armnetwork.AzureFirewall{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("firewall1"), // Here we don't care to have a valid ID, it is for testing purpose only
@@ -544,11 +547,8 @@ func TestAzurermFirewalls(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -564,12 +564,11 @@ func TestAzurermPublicIP(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no public IP",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPublicIPAddresses").Return([]*armnetwork.PublicIPAddress{}, nil)
+				repository.On("ListAllPublicIPAddresses", mock.Anything).Return([]*armnetwork.PublicIPAddress{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -578,14 +577,17 @@ func TestAzurermPublicIP(t *testing.T) {
 		{
 			test: "error listing public IPs",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPublicIPAddresses").Return(nil, dummyError)
+				repository.On("ListAllPublicIPAddresses", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePublicIPResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePublicIPResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzurePublicIPResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzurePublicIPResourceType),
 		},
 		{
 			test: "multiple public IP",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPublicIPAddresses").Return([]*armnetwork.PublicIPAddress{
+				repository.On("ListAllPublicIPAddresses", mock.Anything).Return([]*armnetwork.PublicIPAddress{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("ip1"), // Here we don't care to have a valid ID, it is for testing purpose only
@@ -631,11 +633,8 @@ func TestAzurermPublicIP(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -652,13 +651,12 @@ func TestAzurermSecurityGroups(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no security group",
 			dirName: "azurerm_network_security_group_empty",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSecurityGroups").Return([]*armnetwork.NetworkSecurityGroup{}, nil)
+				repository.On("ListAllSecurityGroups", mock.Anything).Return([]*armnetwork.NetworkSecurityGroup{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -668,18 +666,18 @@ func TestAzurermSecurityGroups(t *testing.T) {
 			test:    "error listing security groups",
 			dirName: "azurerm_network_security_group_empty",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSecurityGroups").Return(nil, dummyError)
+				repository.On("ListAllSecurityGroups", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureNetworkSecurityGroupResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureNetworkSecurityGroupResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureNetworkSecurityGroupResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureNetworkSecurityGroupResourceType),
 		},
 		{
 			test:    "multiple security groups",
 			dirName: "azurerm_network_security_group_multiple",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSecurityGroups").Return([]*armnetwork.NetworkSecurityGroup{
+				repository.On("ListAllSecurityGroups", mock.Anything).Return([]*armnetwork.NetworkSecurityGroup{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("/subscriptions/7bfb2c5c-7308-46ed-8ae4-fffa356eb406/resourceGroups/example-resources/providers/Microsoft.Network/networkSecurityGroups/acceptanceTestSecurityGroup1"),
@@ -749,12 +747,8 @@ func TestAzurermSecurityGroups(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -770,12 +764,11 @@ func TestAzurermLoadBalancers(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no load balancer",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -784,14 +777,17 @@ func TestAzurermLoadBalancers(t *testing.T) {
 		{
 			test: "error listing load balancers",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureLoadBalancerResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureLoadBalancerResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureLoadBalancerResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureLoadBalancerResourceType),
 		},
 		{
 			test: "multiple load balancers",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("lb-1"), // Here we don't care to have a valid ID, it is for testing purpose only
@@ -837,11 +833,8 @@ func TestAzurermLoadBalancers(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -858,7 +851,6 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockNetworkRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no load balancer rule",
@@ -871,9 +863,9 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 					},
 				}
 
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
 
-				repository.On("ListLoadBalancerRules", loadbalancer).Return([]*armnetwork.LoadBalancingRule{}, nil)
+				repository.On("ListLoadBalancerRules", mock.Anything, loadbalancer).Return([]*armnetwork.LoadBalancingRule{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -883,12 +875,12 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 			test:    "error listing load balancer rules",
 			dirName: "azurerm_lb_rule_empty",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureLoadBalancerRuleResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureLoadBalancerRuleResourceType, error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureLoadBalancerRuleResourceType, resourceazure.AzureLoadBalancerResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureLoadBalancerRuleResourceType, resourceazure.AzureLoadBalancerResourceType),
 		},
 		{
 			test:    "multiple load balancer rules",
@@ -901,9 +893,9 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 					},
 				}
 
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
 
-				repository.On("ListLoadBalancerRules", loadbalancer).Return([]*armnetwork.LoadBalancingRule{
+				repository.On("ListLoadBalancerRules", mock.Anything, loadbalancer).Return([]*armnetwork.LoadBalancingRule{
 					{
 						SubResource: armnetwork.SubResource{
 							ID: to.StringPtr("/subscriptions/8cb43347-a79f-4bb2-a8b4-c838b41fa5a5/resourceGroups/raphael-dev/providers/Microsoft.Network/loadBalancers/TestLoadBalancer/loadBalancingRules/LBRule"),
@@ -973,12 +965,8 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
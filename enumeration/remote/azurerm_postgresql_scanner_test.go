@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/common"
@@ -29,12 +31,11 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockPostgresqlRespository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no postgres server",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{}, nil)
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,14 +44,17 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 		{
 			test: "error listing postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return(nil, dummyError)
+				repository.On("ListAllServers", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePostgresqlServerResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePostgresqlServerResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlServerResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlServerResourceType),
 		},
 		{
 			test: "multiple postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -100,11 +104,8 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -121,12 +122,11 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockPostgresqlRespository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no postgres database",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{}, nil)
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -135,14 +135,17 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 		{
 			test: "error listing postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return(nil, dummyError)
+				repository.On("ListAllServers", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePostgresqlDatabaseResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePostgresqlDatabaseResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType, resourceazure.AzurePostgresqlServerResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType, resourceazure.AzurePostgresqlServerResourceType),
 		},
 		{
 			test: "error listing postgres databases",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -153,14 +156,17 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 					},
 				}, nil).Once()
 
-				repository.On("ListAllDatabasesByServer", mock.IsType(&armpostgresql.Server{})).Return(nil, dummyError).Once()
+				repository.On("ListAllDatabasesByServer", mock.Anything, mock.IsType(&armpostgresql.Server{})).Return(nil, dummyError).Once()
+				alerter.On("SendAlert", resourceazure.AzurePostgresqlDatabaseResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePostgresqlDatabaseResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType),
 		},
 		{
 			test: "multiple postgres databases",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -171,7 +177,7 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 					},
 				}, nil).Once()
 
-				repository.On("ListAllDatabasesByServer", mock.IsType(&armpostgresql.Server{})).Return([]*armpostgresql.Database{
+				repository.On("ListAllDatabasesByServer", mock.Anything, mock.IsType(&armpostgresql.Server{})).Return([]*armpostgresql.Database{
 					{
 						ProxyResource: armpostgresql.ProxyResource{
 							Resource: armpostgresql.Resource{
@@ -221,11 +227,8 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
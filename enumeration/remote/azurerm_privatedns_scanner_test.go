@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -35,13 +37,12 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private zone",
 			dirName: "azurerm_private_dns_private_zone_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -51,18 +52,18 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			test:    "error listing private zones",
 			dirName: "azurerm_private_dns_private_zone_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSZoneResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSZoneResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "multiple private zones",
 			dirName: "azurerm_private_dns_private_zone_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -148,12 +149,8 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -170,13 +167,12 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private a record",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -186,18 +182,18 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSARecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSARecordResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSARecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSARecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "error listing private a records",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -207,18 +203,18 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllARecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllARecords", mock.Anything, mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSARecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSARecordResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSARecordResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSARecordResourceType),
 		},
 		{
 			test:    "multiple private a records",
 			dirName: "azurerm_private_dns_a_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -229,7 +225,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllARecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllARecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -315,12 +311,8 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -337,13 +329,12 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private aaaa record",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -353,18 +344,18 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSAAAARecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSAAAARecordResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSAAAARecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSAAAARecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "error listing private aaaa records",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -374,18 +365,18 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllAAAARecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllAAAARecords", mock.Anything, mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSAAAARecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSAAAARecordResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSAAAARecordResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSAAAARecordResourceType),
 		},
 		{
 			test:    "multiple private aaaaa records",
 			dirName: "azurerm_private_dns_aaaaa_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -396,7 +387,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllAAAARecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllAAAARecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -483,12 +474,8 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -505,13 +492,12 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private cname record",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -521,18 +507,18 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSCNameRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSCNameRecordResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSCNameRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSCNameRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "error listing private cname records",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -542,18 +528,18 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllCNAMERecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllCNAMERecords", mock.Anything, mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSCNameRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSCNameRecordResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSCNameRecordResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSCNameRecordResourceType),
 		},
 		{
 			test:    "multiple private cname records",
 			dirName: "azurerm_private_dns_cname_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -564,7 +550,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllCNAMERecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllCNAMERecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -639,12 +625,8 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -661,13 +643,12 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private ptr record",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -677,18 +658,18 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSPTRRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSPTRRecordResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSPTRRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSPTRRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "error listing private ptr records",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -698,18 +679,18 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllPTRRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllPTRRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSPTRRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSPTRRecordResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSPTRRecordResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSPTRRecordResourceType),
 		},
 		{
 			test:    "multiple private ptra records",
 			dirName: "azurerm_private_dns_ptr_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -720,7 +701,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllPTRRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllPTRRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -806,12 +787,8 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -828,13 +805,12 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private mx record",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -844,18 +820,18 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSMXRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSMXRecordResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSMXRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSMXRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "error listing private mx records",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -865,18 +841,18 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllMXRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllMXRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSMXRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSMXRecordResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSMXRecordResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSMXRecordResourceType),
 		},
 		{
 			test:    "multiple private mx records",
 			dirName: "azurerm_private_dns_mx_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -887,7 +863,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllMXRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllMXRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -974,12 +950,8 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -996,13 +968,12 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private srv record",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1012,18 +983,18 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSSRVRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSSRVRecordResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSSRVRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSSRVRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "error listing private srv records",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1033,18 +1004,18 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllSRVRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllSRVRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSSRVRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSSRVRecordResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSSRVRecordResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSSRVRecordResourceType),
 		},
 		{
 			test:    "multiple private srv records",
 			dirName: "azurerm_private_dns_srv_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1055,7 +1026,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllSRVRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllSRVRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -1141,12 +1112,8 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1163,13 +1130,12 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 		dirName        string
 		mocks          func(*repository.MockPrivateDNSRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test:    "no private txt record",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1179,18 +1145,18 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSTXTRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSTXTRecordResourceType, remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSTXTRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSTXTRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
 		{
 			test:    "error listing private txt records",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1200,18 +1166,18 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllTXTRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllTXTRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzurePrivateDNSTXTRecordResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzurePrivateDNSTXTRecordResourceType, remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSTXTRecordResourceType))).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSTXTRecordResourceType),
 		},
 		{
 			test:    "multiple private txt records",
 			dirName: "azurerm_private_dns_txt_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1222,7 +1188,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllTXTRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllTXTRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -1308,12 +1274,8 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
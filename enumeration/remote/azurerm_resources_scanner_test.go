@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/common"
@@ -29,12 +31,11 @@ func TestAzurermResourceGroups(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockResourcesRepository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no resource group",
 			mocks: func(repository *repository.MockResourcesRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllResourceGroups").Return([]*armresources.ResourceGroup{}, nil)
+				repository.On("ListAllResourceGroups", mock.Anything).Return([]*armresources.ResourceGroup{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,14 +44,17 @@ func TestAzurermResourceGroups(t *testing.T) {
 		{
 			test: "error listing resource groups",
 			mocks: func(repository *repository.MockResourcesRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllResourceGroups").Return(nil, dummyError)
+				repository.On("ListAllResourceGroups", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureResourceGroupResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureResourceGroupResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureResourceGroupResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureResourceGroupResourceType),
 		},
 		{
 			test: "multiple resource groups",
 			mocks: func(repository *repository.MockResourcesRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllResourceGroups").Return([]*armresources.ResourceGroup{
+				repository.On("ListAllResourceGroups", mock.Anything).Return([]*armresources.ResourceGroup{
 					{
 						ID:   to.StringPtr("group1"),
 						Name: to.StringPtr("group1"),
@@ -92,11 +96,8 @@ func TestAzurermResourceGroups(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -1,9 +1,11 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/common"
@@ -28,12 +30,11 @@ func TestAzurermStorageAccount(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockStorageRespository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{}, nil)
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -42,14 +43,17 @@ func TestAzurermStorageAccount(t *testing.T) {
 		{
 			test: "error listing storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return(nil, dummyError)
+				repository.On("ListAllStorageAccount", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureStorageAccountResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureStorageAccountResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureStorageAccountResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureStorageAccountResourceType),
 		},
 		{
 			test: "multiple storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{
 					{
 						TrackedResource: armstorage.TrackedResource{
 							Resource: armstorage.Resource{
@@ -97,11 +101,8 @@ func TestAzurermStorageAccount(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -118,12 +119,11 @@ func TestAzurermStorageContainer(t *testing.T) {
 		test           string
 		mocks          func(*repository.MockStorageRespository, *mocks.AlerterInterface)
 		assertExpected func(t *testing.T, got []*resource.Resource)
-		wantErr        error
 	}{
 		{
 			test: "no storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{}, nil)
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -146,12 +146,12 @@ func TestAzurermStorageContainer(t *testing.T) {
 						},
 					},
 				}
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{
 					account1,
 					account2,
 				}, nil)
-				repository.On("ListAllStorageContainer", account1).Return([]string{}, nil)
-				repository.On("ListAllStorageContainer", account2).Return([]string{}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account1).Return([]string{}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account2).Return([]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -160,9 +160,12 @@ func TestAzurermStorageContainer(t *testing.T) {
 		{
 			test: "error listing storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return(nil, dummyError)
+				repository.On("ListAllStorageAccount", mock.Anything).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureStorageContainerResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureStorageContainerResourceType, error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureStorageContainerResourceType, resourceazure.AzureStorageAccountResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureStorageContainerResourceType, resourceazure.AzureStorageAccountResourceType),
 		},
 		{
 			test: "error listing storage container",
@@ -174,10 +177,13 @@ func TestAzurermStorageContainer(t *testing.T) {
 						},
 					},
 				}
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{account}, nil)
-				repository.On("ListAllStorageContainer", account).Return(nil, dummyError)
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{account}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account).Return(nil, dummyError)
+				alerter.On("SendAlert", resourceazure.AzureStorageContainerResourceType, alerts.NewIncompleteEnumerationAlert(resourceazure.AzureStorageContainerResourceType, error2.NewResourceListingError(dummyError, resourceazure.AzureStorageContainerResourceType))).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
 			},
-			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureStorageContainerResourceType),
 		},
 		{
 			test: "multiple storage containers",
@@ -196,12 +202,12 @@ func TestAzurermStorageContainer(t *testing.T) {
 						},
 					},
 				}
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{
 					account1,
 					account2,
 				}, nil)
-				repository.On("ListAllStorageContainer", account1).Return([]string{"https://testeliedriftctl1.blob.core.windows.net/container1", "https://testeliedriftctl1.blob.core.windows.net/container2"}, nil)
-				repository.On("ListAllStorageContainer", account2).Return([]string{"https://testeliedriftctl2.blob.core.windows.net/container3", "https://testeliedriftctl2.blob.core.windows.net/container4"}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account1).Return([]string{"https://testeliedriftctl1.blob.core.windows.net/container1", "https://testeliedriftctl1.blob.core.windows.net/container2"}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account2).Return([]string{"https://testeliedriftctl2.blob.core.windows.net/container3", "https://testeliedriftctl2.blob.core.windows.net/container4"}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 4)
@@ -237,11 +243,8 @@ func TestAzurermStorageContainer(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			alerter.AssertExpectations(tt)
@@ -11,6 +11,7 @@ type Cache interface {
 	GetAndLock(string) interface{}
 	Unlock(string)
 	Len() int
+	Clear()
 }
 
 type LRUCache struct {
@@ -88,6 +89,15 @@ func (c *LRUCache) Len() int {
 	return c.l.Len()
 }
 
+// Clear removes every key, so that the next listings are not served from the cache
+func (c *LRUCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.l.Init()
+	c.m = make(map[string]*list.Element, c.cap)
+}
+
 func (c *LRUCache) GetAndLock(s string) interface{} {
 	lock, _ := c.lockMap.LoadOrStore(s, &sync.Mutex{})
 	lock.(*sync.Mutex).Lock()
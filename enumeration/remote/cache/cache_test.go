@@ -44,6 +44,19 @@ func TestCache(t *testing.T) {
 		assert.Equal(t, 1, cache.Len())
 	})
 
+	t.Run("should remove every key on clear", func(t *testing.T) {
+		cache := New(5)
+		assert.Equal(t, false, cache.Put("s3", []string{}))
+		assert.Equal(t, false, cache.Put("ec2", []string{}))
+
+		cache.Clear()
+		assert.Equal(t, nil, cache.Get("s3"))
+		assert.Equal(t, 0, cache.Len())
+
+		assert.Equal(t, false, cache.Put("s3", []string{"test"}))
+		assert.Equal(t, []string{"test"}, cache.Get("s3"))
+	})
+
 	t.Run("should delete the least used keys", func(t *testing.T) {
 		keys := []struct {
 			key   string
@@ -9,6 +9,11 @@ type MockCache struct {
 	mock.Mock
 }
 
+// Clear provides a mock function with given fields:
+func (_m *MockCache) Clear() {
+	_m.Called()
+}
+
 // Get provides a mock function with given fields: _a0
 func (_m *MockCache) Get(_a0 string) interface{} {
 	ret := _m.Called(_a0)
@@ -0,0 +1,208 @@
+package remote
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/diagnostic"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+)
+
+// EnumeratorConfig holds what the scan command would otherwise read from its flags
+type EnumeratorConfig struct {
+	// To is the remote to use, e.g. aws+tf
+	To string
+	// ProviderVersion is the terraform provider version, the remote default one is used when empty
+	ProviderVersion string
+	// ConfigDir is where terraform providers are downloaded, it defaults to the user home directory
+	ConfigDir string
+	// ProgressCounter is optional, it is incremented for every resource read by the terraform provider
+	ProgressCounter enumeration.ProgressCounter
+	// EnumeratorTimeout is the deadline of every enumerator, zero means no deadline
+	EnumeratorTimeout time.Duration
+	// RateLimits bounds the concurrency and the rate of API requests, zero values keep the defaults
+	RateLimits ratelimit.Config
+}
+
+type activateFunc func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error
+
+// CloudEnumerator implements enumeration.Enumerator on top of the remotes used by the scan command.
+// The remote is activated once, Close releases its terraform provider. Repositories cache is cleared
+// before every call so that it never returns stale results.
+type CloudEnumerator struct {
+	alerter           *forwardAlerter
+	providerLibrary   *terraform.ProviderLibrary
+	remoteLibrary     *common.RemoteLibrary
+	repositoryCache   cache.Cache
+	enumeratorTimeout time.Duration
+	parallelism       int64
+	lock              sync.Mutex
+}
+
+func NewCloudEnumerator(config EnumeratorConfig) (*CloudEnumerator, error) {
+	rateLimits := ratelimit.NewController(config.RateLimits)
+	activate, err := newActivateFunc(config, rateLimits)
+	if err != nil {
+		return nil, err
+	}
+	return newCloudEnumerator(activate, config.EnumeratorTimeout, rateLimits.Parallelism())
+}
+
+func newCloudEnumerator(activate activateFunc, enumeratorTimeout time.Duration, parallelism int64) (*CloudEnumerator, error) {
+	e := &CloudEnumerator{
+		alerter:           &forwardAlerter{},
+		providerLibrary:   terraform.NewProviderLibrary(),
+		remoteLibrary:     common.NewRemoteLibrary(),
+		repositoryCache:   cache.New(100),
+		enumeratorTimeout: enumeratorTimeout,
+		parallelism:       parallelism,
+	}
+	if err := activate(e.alerter, e.providerLibrary, e.remoteLibrary, e.repositoryCache); err != nil {
+		e.providerLibrary.Cleanup()
+		return nil, err
+	}
+	return e, nil
+}
+
+// newActivateFunc returns a function that activates the configured remote
+func newActivateFunc(config EnumeratorConfig, rateLimits *ratelimit.Controller) (activateFunc, error) {
+	if !IsSupported(config.To) {
+		return nil, errors.Errorf("unsupported remote '%s'", config.To)
+	}
+	if config.ConfigDir == "" {
+		dir, err := homedir.Dir()
+		if err != nil {
+			dir = os.TempDir()
+		}
+		config.ConfigDir = dir
+	}
+	if config.ProgressCounter == nil {
+		config.ProgressCounter = noopProgressCounter{}
+	}
+
+	return func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error {
+		return Activate(
+			config.To,
+			config.ProviderVersion,
+			alerter,
+			providerLibrary,
+			remoteLibrary,
+			config.ProgressCounter,
+			terraform.NewTerraformResourceFactory(),
+			config.ConfigDir,
+			rateLimits,
+			repositoryCache,
+			nil,
+		)
+	}, nil
+}
+
+// Close stops the terraform provider of the remote, the enumerator must not be used afterwards
+func (e *CloudEnumerator) Close() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.providerLibrary.Cleanup()
+}
+
+func (e *CloudEnumerator) Enumerate(ctx context.Context, input *enumeration.EnumerateInput) (*enumeration.EnumerateOutput, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if input == nil {
+		input = &enumeration.EnumerateInput{}
+	}
+
+	e.repositoryCache.Clear()
+	alerter := alerter.NewAlerter()
+	e.alerter.setTarget(alerter)
+	defer e.alerter.setTarget(nil)
+
+	filter := newResourceTypesFilter(input.ResourceTypes)
+	scanner := NewScanner(e.remoteLibrary, alerter, filter)
+	scanner.SetEnumeratorTimeout(e.enumeratorTimeout)
+	scanner.SetParallelism(e.parallelism)
+	resources, err := scanner.Resources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &enumeration.EnumerateOutput{
+		Resources:   make(map[string][]*resource.Resource),
+		Timings:     scanner.Timings(),
+		Diagnostics: diagnostic.FromAlerts(alerter.Retrieve()),
+	}
+	for _, ty := range input.ResourceTypes {
+		output.Resources[ty] = nil
+	}
+	for _, enumerator := range e.remoteLibrary.Enumerators() {
+		if !filter.IsTypeIgnored(enumerator.SupportedType()) {
+			output.Resources[enumerator.SupportedType().String()] = nil
+		}
+	}
+	for _, res := range resources {
+		output.Resources[res.ResourceType()] = append(output.Resources[res.ResourceType()], res)
+	}
+
+	return output, nil
+}
+
+// resourceTypesFilter only keeps the given resource types, or every type when none is given
+type resourceTypesFilter struct {
+	types map[string]struct{}
+}
+
+func newResourceTypesFilter(types []string) *resourceTypesFilter {
+	filter := &resourceTypesFilter{types: make(map[string]struct{}, len(types))}
+	for _, ty := range types {
+		filter.types[ty] = struct{}{}
+	}
+	return filter
+}
+
+func (f *resourceTypesFilter) IsTypeIgnored(ty resource.ResourceType) bool {
+	if len(f.types) == 0 {
+		return false
+	}
+	_, exist := f.types[ty.String()]
+	return !exist
+}
+
+func (f *resourceTypesFilter) IsResourceIgnored(res *resource.Resource) bool {
+	return f.IsTypeIgnored(resource.ResourceType(res.ResourceType()))
+}
+
+// forwardAlerter sends alerts to the alerter of the running call, enumerators keep the alerter they
+// were given at activation
+type forwardAlerter struct {
+	lock   sync.Mutex
+	target alerter.AlerterInterface
+}
+
+func (a *forwardAlerter) setTarget(target alerter.AlerterInterface) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.target = target
+}
+
+func (a *forwardAlerter) SendAlert(key string, alert alerter.Alert) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.target != nil {
+		a.target.SendAlert(key, alert)
+	}
+}
+
+type noopProgressCounter struct{}
+
+func (noopProgressCounter) Inc() {}
@@ -0,0 +1,181 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewCloudEnumerator_UnsupportedRemote(t *testing.T) {
+	enumerator, err := NewCloudEnumerator(EnumeratorConfig{To: "foobar"})
+	assert.Nil(t, enumerator)
+	assert.EqualError(t, err, "unsupported remote 'foobar'")
+}
+
+func TestCloudEnumerator_Enumerate(t *testing.T) {
+	bucket := &resource.Resource{Id: "bucket", Type: "aws_s3_bucket"}
+	user := &resource.Resource{Id: "user", Type: "aws_iam_user"}
+
+	tests := []struct {
+		name                string
+		input               *enumeration.EnumerateInput
+		expectedResources   map[string][]*resource.Resource
+		expectedTimings     []string
+		expectedDiagnostics int
+	}{
+		{
+			name:  "all resource types",
+			input: &enumeration.EnumerateInput{},
+			expectedResources: map[string][]*resource.Resource{
+				"aws_s3_bucket": {bucket},
+				"aws_iam_user":  {user},
+				"aws_iam_role":  nil,
+			},
+			expectedTimings:     []string{"aws_s3_bucket", "aws_iam_user", "aws_iam_role"},
+			expectedDiagnostics: 1,
+		},
+		{
+			name:  "nil input",
+			input: nil,
+			expectedResources: map[string][]*resource.Resource{
+				"aws_s3_bucket": {bucket},
+				"aws_iam_user":  {user},
+				"aws_iam_role":  nil,
+			},
+			expectedTimings:     []string{"aws_s3_bucket", "aws_iam_user", "aws_iam_role"},
+			expectedDiagnostics: 1,
+		},
+		{
+			name: "filtered resource types",
+			input: &enumeration.EnumerateInput{
+				ResourceTypes: []string{"aws_s3_bucket", "aws_sqs_queue"},
+			},
+			expectedResources: map[string][]*resource.Resource{
+				"aws_s3_bucket": {bucket},
+				"aws_sqs_queue": nil,
+			},
+			expectedTimings:     []string{"aws_s3_bucket"},
+			expectedDiagnostics: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := newCloudEnumerator(func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error {
+				bucketEnumerator := &common.MockEnumerator{}
+				bucketEnumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+				bucketEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{bucket}, nil)
+				remoteLibrary.AddEnumerator(bucketEnumerator)
+
+				userEnumerator := &common.MockEnumerator{}
+				userEnumerator.On("SupportedType").Return(resource.ResourceType("aws_iam_user"))
+				userEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{user}, nil)
+				remoteLibrary.AddEnumerator(userEnumerator)
+
+				roleEnumerator := &common.MockEnumerator{}
+				roleEnumerator.On("SupportedType").Return(resource.ResourceType("aws_iam_role"))
+				roleEnumerator.On("Enumerate", mock.Anything).Return(nil, remoteerror.NewResourceListingError(awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, ""), "aws_iam_role"))
+				remoteLibrary.AddEnumerator(roleEnumerator)
+
+				return nil
+			}, 0, ratelimit.DefaultParallelism)
+			assert.NoError(t, err)
+
+			got, err := e.Enumerate(context.Background(), tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResources, got.Resources)
+
+			assert.Len(t, got.Timings, len(tt.expectedTimings))
+			for _, ty := range tt.expectedTimings {
+				assert.Contains(t, got.Timings, ty)
+			}
+
+			assert.Len(t, got.Diagnostics, tt.expectedDiagnostics)
+		})
+	}
+}
+
+func TestCloudEnumerator_ActivateError(t *testing.T) {
+	provider := &terraform.MockTerraformProvider{}
+	provider.On("Cleanup").Return().Once()
+
+	e, err := newCloudEnumerator(func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error {
+		providerLibrary.AddProvider(terraform.AWS, provider)
+		return errors.New("unable to init provider")
+	}, 0, ratelimit.DefaultParallelism)
+	assert.Nil(t, e)
+	assert.EqualError(t, err, "unable to init provider")
+
+	provider.AssertExpectations(t)
+}
+
+func TestCloudEnumerator_Enumerate_ActivatedOnce(t *testing.T) {
+	provider := &terraform.MockTerraformProvider{}
+	activations := 0
+	var activationCache cache.Cache
+
+	bucketEnumerator := &common.MockEnumerator{}
+	bucketEnumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+	bucketEnumerator.On("Enumerate", mock.Anything).Run(func(_ mock.Arguments) {
+		// Listings cached by a previous call must not be returned
+		assert.Nil(t, activationCache.Get("s3ListAllBuckets"))
+		activationCache.Put("s3ListAllBuckets", []string{"bucket"})
+	}).Return([]*resource.Resource{}, nil).Twice()
+
+	e, err := newCloudEnumerator(func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error {
+		activations++
+		activationCache = repositoryCache
+		providerLibrary.AddProvider(terraform.AWS, provider)
+		remoteLibrary.AddEnumerator(bucketEnumerator)
+		return nil
+	}, 0, ratelimit.DefaultParallelism)
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err := e.Enumerate(context.Background(), &enumeration.EnumerateInput{})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, activations)
+
+	provider.On("Cleanup").Return().Once()
+	e.Close()
+
+	bucketEnumerator.AssertExpectations(t)
+	provider.AssertExpectations(t)
+}
+
+func TestCloudEnumerator_Enumerate_FreshAlerterForEachCall(t *testing.T) {
+	e, err := newCloudEnumerator(func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error {
+		// Like S3 enumerators, this one sends alerts through the alerter given at activation
+		bucketEnumerator := &common.MockEnumerator{}
+		bucketEnumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+		bucketEnumerator.On("Enumerate", mock.Anything).Run(func(_ mock.Arguments) {
+			alerter.SendAlert("aws_s3_bucket", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerror.NewResourceListingErrorWithType(errors.New("AccessDenied"), "aws_s3_bucket", "aws_s3_bucket"), alerts.EnumerationPhase))
+		}).Return([]*resource.Resource{}, nil)
+		remoteLibrary.AddEnumerator(bucketEnumerator)
+		return nil
+	}, 0, ratelimit.DefaultParallelism)
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		got, err := e.Enumerate(context.Background(), &enumeration.EnumerateInput{})
+		assert.NoError(t, err)
+		if assert.Len(t, got.Diagnostics, 1) {
+			assert.Equal(t, "ACCESS_DENIED", got.Diagnostics[0].Code())
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/flatmap"
+	tfterraform "github.com/hashicorp/terraform/terraform"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/diagnostic"
+	"github.com/snyk/driftctl/enumeration/parallel"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+)
+
+// CloudRefresher implements enumeration.Refresher, it reads resources details using the terraform provider of a remote.
+// The provider is started on first use and must be released with Close.
+type CloudRefresher struct {
+	activate        activateFunc
+	providerName    string
+	providerLibrary *terraform.ProviderLibrary
+	deserializer    *resource.Deserializer
+	parallelism     int64
+	lock            sync.Mutex
+}
+
+func NewCloudRefresher(config EnumeratorConfig) (*CloudRefresher, error) {
+	rateLimits := ratelimit.NewController(config.RateLimits)
+	activate, err := newActivateFunc(config, rateLimits)
+	if err != nil {
+		return nil, err
+	}
+	return &CloudRefresher{
+		activate:     activate,
+		providerName: common.RemoteParameter(config.To).GetProviderAddress().Type,
+		deserializer: resource.NewDeserializer(terraform.NewTerraformResourceFactory()),
+		parallelism:  rateLimits.Parallelism(),
+	}, nil
+}
+
+func (r *CloudRefresher) provider() (terraform.TerraformProvider, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.providerLibrary == nil {
+		providerLibrary := terraform.NewProviderLibrary()
+		// Enumerators are not used here, alerts they could send are dropped
+		if err := r.activate(alerter.NewAlerter(), providerLibrary, common.NewRemoteLibrary(), cache.New(100)); err != nil {
+			providerLibrary.Cleanup()
+			return nil, err
+		}
+		r.providerLibrary = providerLibrary
+	}
+
+	provider := r.providerLibrary.Provider(r.providerName)
+	if provider == nil {
+		return nil, errors.Errorf("provider %s was not initialized", r.providerName)
+	}
+	return provider, nil
+}
+
+// Refresh reads every given resource, resources that no longer exist are not part of the output
+func (r *CloudRefresher) Refresh(ctx context.Context, input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error) {
+	provider, err := r.provider()
+	if err != nil {
+		return nil, err
+	}
+
+	alerter := alerter.NewAlerter()
+	runner := parallel.NewParallelRunner(ctx, r.parallelism)
+
+	for _, resources := range input.Resources {
+		for _, res := range resources {
+			res := res
+			runner.Run(func() (interface{}, error) {
+				attributes := map[string]interface{}{}
+				if res.Attributes() != nil {
+					attributes = *res.Attributes()
+				}
+				ctyVal, err := provider.ReadResource(ctx, terraform.ReadResourceArgs{
+					Ty:         resource.ResourceType(res.ResourceType()),
+					ID:         res.ResourceId(),
+					Attributes: flatmap.Flatten(attributes),
+				})
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil, ctx.Err()
+					}
+					logrus.WithFields(logrus.Fields{
+						"id":   res.ResourceId(),
+						"type": res.ResourceType(),
+					}).Debugf("Unable to read resource: %s", err)
+					alerter.SendAlert(fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()), alerts.NewResourceReadingAlert(res, err))
+					return nil, nil
+				}
+				return r.deserializer.DeserializeOne(res.ResourceType(), *ctyVal)
+			})
+		}
+	}
+
+	output := &enumeration.RefreshOutput{
+		Resources: make(map[string][]*resource.Resource, len(input.Resources)),
+	}
+	for ty := range input.Resources {
+		output.Resources[ty] = nil
+	}
+
+loop:
+	for {
+		select {
+		case value, ok := <-runner.Read():
+			if !ok {
+				break loop
+			}
+			if res, isResource := value.(*resource.Resource); isResource && res != nil {
+				output.Resources[res.ResourceType()] = append(output.Resources[res.ResourceType()], res)
+			}
+		case <-runner.DoneChan():
+			break loop
+		}
+	}
+	if err := runner.Err(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	output.Diagnostics = diagnostic.FromAlerts(alerter.Retrieve())
+	return output, nil
+}
+
+func (r *CloudRefresher) GetSchema() (*enumeration.GetSchemasOutput, error) {
+	provider, err := r.provider()
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := provider.Schema()
+	schema := &tfterraform.ProviderSchema{
+		ResourceTypes:              make(map[string]*configschema.Block, len(schemas)),
+		ResourceTypeSchemaVersions: make(map[string]uint64, len(schemas)),
+	}
+	for ty, sch := range schemas {
+		schema.ResourceTypes[ty] = sch.Block
+		schema.ResourceTypeSchemaVersions[ty] = uint64(sch.Version)
+	}
+
+	return &enumeration.GetSchemasOutput{Schema: schema}, nil
+}
+
+// Close stops the terraform provider
+func (r *CloudRefresher) Close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.providerLibrary != nil {
+		r.providerLibrary.Cleanup()
+		r.providerLibrary = nil
+	}
+}
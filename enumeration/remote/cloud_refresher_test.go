@@ -0,0 +1,134 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	tfproviders "github.com/hashicorp/terraform/providers"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func newTestCloudRefresher(provider terraform.TerraformProvider) *CloudRefresher {
+	return &CloudRefresher{
+		activate: func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error {
+			providerLibrary.AddProvider(terraform.AWS, provider)
+			return nil
+		},
+		providerName: terraform.AWS,
+		deserializer: resource.NewDeserializer(terraform.NewTerraformResourceFactory()),
+		parallelism:  ratelimit.DefaultParallelism,
+	}
+}
+
+func TestNewCloudRefresher_UnsupportedRemote(t *testing.T) {
+	refresher, err := NewCloudRefresher(EnumeratorConfig{To: "foobar"})
+	assert.Nil(t, refresher)
+	assert.EqualError(t, err, "unsupported remote 'foobar'")
+}
+
+func TestCloudRefresher_Refresh(t *testing.T) {
+	provider := &terraform.MockTerraformProvider{}
+	provider.On("ReadResource", mock.Anything, terraform.ReadResourceArgs{
+		Ty:         "aws_s3_bucket",
+		ID:         "existing",
+		Attributes: map[string]string{"region": "us-east-1"},
+	}).Return(func() *cty.Value {
+		val := cty.ObjectVal(map[string]cty.Value{
+			"id":     cty.StringVal("existing"),
+			"region": cty.StringVal("us-east-1"),
+			"acl":    cty.StringVal("private"),
+		})
+		return &val
+	}(), nil)
+	provider.On("ReadResource", mock.Anything, mock.MatchedBy(func(args terraform.ReadResourceArgs) bool {
+		return args.ID == "deleted"
+	})).Return(func() *cty.Value {
+		val := cty.NullVal(cty.DynamicPseudoType)
+		return &val
+	}(), nil)
+	provider.On("ReadResource", mock.Anything, mock.MatchedBy(func(args terraform.ReadResourceArgs) bool {
+		return args.ID == "failing"
+	})).Return(nil, errors.New("boom"))
+	provider.On("Cleanup").Return()
+
+	refresher := newTestCloudRefresher(provider)
+	defer refresher.Close()
+
+	got, err := refresher.Refresh(context.Background(), &enumeration.RefreshInput{
+		Resources: map[string][]*resource.Resource{
+			"aws_s3_bucket": {
+				{Id: "existing", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"region": "us-east-1"}},
+				{Id: "deleted", Type: "aws_s3_bucket"},
+			},
+			"aws_iam_user": {
+				{Id: "failing", Type: "aws_iam_user"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]*resource.Resource{
+		"aws_s3_bucket": {
+			{
+				Id:   "existing",
+				Type: "aws_s3_bucket",
+				Attrs: &resource.Attributes{
+					"id":     "existing",
+					"region": "us-east-1",
+					"acl":    "private",
+				},
+			},
+		},
+		"aws_iam_user": nil,
+	}, got.Resources)
+	if assert.Len(t, got.Diagnostics, 1) {
+		assert.Equal(t, "An error occured reading aws_iam_user.failing: boom", got.Diagnostics[0].Message())
+		assert.Equal(t, "aws_iam_user", got.Diagnostics[0].ResourceType())
+	}
+}
+
+func TestCloudRefresher_GetSchema(t *testing.T) {
+	block := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id": {Type: cty.String, Computed: true},
+		},
+	}
+
+	provider := &terraform.MockTerraformProvider{}
+	provider.On("Schema").Return(map[string]tfproviders.Schema{
+		"aws_s3_bucket": {Version: 1, Block: block},
+	})
+	provider.On("Cleanup").Return()
+
+	refresher := newTestCloudRefresher(provider)
+	got, err := refresher.GetSchema()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*configschema.Block{"aws_s3_bucket": block}, got.Schema.ResourceTypes)
+	assert.Equal(t, map[string]uint64{"aws_s3_bucket": 1}, got.Schema.ResourceTypeSchemaVersions)
+
+	refresher.Close()
+	provider.AssertCalled(t, "Cleanup")
+}
+
+func TestCloudRefresher_ActivateError(t *testing.T) {
+	refresher := &CloudRefresher{
+		activate: func(alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, repositoryCache cache.Cache) error {
+			return errors.New("unable to init provider")
+		},
+		providerName: terraform.AWS,
+	}
+
+	got, err := refresher.Refresh(context.Background(), &enumeration.RefreshInput{})
+	assert.Nil(t, got)
+	assert.EqualError(t, err, "unable to init provider")
+}
@@ -0,0 +1,58 @@
+package cloudflare
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/cloudflare"
+)
+
+type CloudflareFirewallRuleEnumerator struct {
+	repository repository.CloudflareRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudflareFirewallRuleEnumerator(repo repository.CloudflareRepository, factory resource.ResourceFactory) *CloudflareFirewallRuleEnumerator {
+	return &CloudflareFirewallRuleEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudflareFirewallRuleEnumerator) SupportedType() resource.ResourceType {
+	return cloudflare.CloudflareFirewallRuleResourceType
+}
+
+func (e *CloudflareFirewallRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	zones, err := e.repository.ListAllZones(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), cloudflare.CloudflareZoneResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, zone := range zones {
+		rules, err := e.repository.ListFirewallRulesForZone(ctx, zone.ID)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, rule := range rules {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					rule.ID,
+					map[string]interface{}{
+						"zone_id":     zone.ID,
+						"description": rule.Description,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
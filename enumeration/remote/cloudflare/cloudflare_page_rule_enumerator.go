@@ -0,0 +1,63 @@
+package cloudflare
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/cloudflare"
+)
+
+type CloudflarePageRuleEnumerator struct {
+	repository repository.CloudflareRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudflarePageRuleEnumerator(repo repository.CloudflareRepository, factory resource.ResourceFactory) *CloudflarePageRuleEnumerator {
+	return &CloudflarePageRuleEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudflarePageRuleEnumerator) SupportedType() resource.ResourceType {
+	return cloudflare.CloudflarePageRuleResourceType
+}
+
+func (e *CloudflarePageRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	zones, err := e.repository.ListAllZones(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), cloudflare.CloudflareZoneResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, zone := range zones {
+		rules, err := e.repository.ListPageRulesForZone(ctx, zone.ID)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, rule := range rules {
+			attrs := map[string]interface{}{
+				"zone_id": zone.ID,
+				"status":  rule.Status,
+			}
+			// Page rules only support a single url target
+			if len(rule.Targets) > 0 {
+				attrs["target"] = rule.Targets[0].Constraint.Value
+			}
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					rule.ID,
+					attrs,
+				),
+			)
+		}
+	}
+
+	return results, err
+}
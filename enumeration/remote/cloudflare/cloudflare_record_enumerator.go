@@ -0,0 +1,59 @@
+package cloudflare
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/cloudflare"
+)
+
+type CloudflareRecordEnumerator struct {
+	repository repository.CloudflareRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudflareRecordEnumerator(repo repository.CloudflareRepository, factory resource.ResourceFactory) *CloudflareRecordEnumerator {
+	return &CloudflareRecordEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudflareRecordEnumerator) SupportedType() resource.ResourceType {
+	return cloudflare.CloudflareRecordResourceType
+}
+
+func (e *CloudflareRecordEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	zones, err := e.repository.ListAllZones(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), cloudflare.CloudflareZoneResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, zone := range zones {
+		records, err := e.repository.ListRecordsForZone(ctx, zone.ID)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, record := range records {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					record.ID,
+					map[string]interface{}{
+						"zone_id": zone.ID,
+						"name":    record.Name,
+						"type":    record.Type,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
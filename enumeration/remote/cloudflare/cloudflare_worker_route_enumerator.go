@@ -0,0 +1,58 @@
+package cloudflare
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/cloudflare"
+)
+
+type CloudflareWorkerRouteEnumerator struct {
+	repository repository.CloudflareRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudflareWorkerRouteEnumerator(repo repository.CloudflareRepository, factory resource.ResourceFactory) *CloudflareWorkerRouteEnumerator {
+	return &CloudflareWorkerRouteEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudflareWorkerRouteEnumerator) SupportedType() resource.ResourceType {
+	return cloudflare.CloudflareWorkerRouteResourceType
+}
+
+func (e *CloudflareWorkerRouteEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	zones, err := e.repository.ListAllZones(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), cloudflare.CloudflareZoneResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, zone := range zones {
+		routes, err := e.repository.ListWorkerRoutesForZone(ctx, zone.ID)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, route := range routes {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					route.ID,
+					map[string]interface{}{
+						"zone_id": zone.ID,
+						"pattern": route.Pattern,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
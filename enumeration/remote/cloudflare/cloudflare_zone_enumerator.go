@@ -0,0 +1,50 @@
+package cloudflare
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/cloudflare"
+)
+
+type CloudflareZoneEnumerator struct {
+	repository repository.CloudflareRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudflareZoneEnumerator(repo repository.CloudflareRepository, factory resource.ResourceFactory) *CloudflareZoneEnumerator {
+	return &CloudflareZoneEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudflareZoneEnumerator) SupportedType() resource.ResourceType {
+	return cloudflare.CloudflareZoneResourceType
+}
+
+func (e *CloudflareZoneEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	zones, err := e.repository.ListAllZones(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(zones))
+
+	for _, zone := range zones {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				zone.ID,
+				map[string]interface{}{
+					"zone": zone.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
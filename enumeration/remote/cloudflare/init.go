@@ -0,0 +1,65 @@
+package cloudflare
+
+import (
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/traffic"
+)
+
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, rateLimits *ratelimit.Controller, repositoryCache cache.Cache, capture *traffic.Capture) error {
+
+	provider, err := NewCloudflareTerraformProvider(version, progress, configDir)
+	if err != nil {
+		return err
+	}
+	provider.SetParallelism(rateLimits.Parallelism())
+	// A replayed scan needs neither credentials nor the provider, only the recorded schema
+	if !capture.Replaying() {
+		err = provider.CheckCredentialsExist()
+		if err != nil {
+			return err
+		}
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
+	}
+	tfProvider, err := capture.Provider(provider)
+	if err != nil {
+		return err
+	}
+
+	// Account scoped URLs depend on the account id, a replayed scan uses the recorded one
+	config := provider.GetConfig()
+	config.AccountID = capture.Setting("cloudflare.account_id", config.AccountID)
+	if capture.Replaying() {
+		config.APIToken = "replay"
+	}
+
+	client, err := config.client(cloudflare.HTTPClient(&http.Client{Transport: profile.NewTransport(ratelimit.NewTransport(rateLimits.Limiter("cloudflare"), capture.Transport("cloudflare", nil)))}))
+	if err != nil {
+		return err
+	}
+
+	repo := repository.NewCloudflareRepository(client, repositoryCache)
+
+	providerLibrary.AddProvider(terraform.CLOUDFLARE, tfProvider)
+
+	remoteLibrary.AddEnumerator(NewCloudflareZoneEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewCloudflareRecordEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewCloudflarePageRuleEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewCloudflareFirewallRuleEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewCloudflareWorkerRouteEnumerator(repo, factory))
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package cloudflare
+
+import (
+	"os"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/terraform"
+	tf "github.com/snyk/driftctl/enumeration/terraform"
+)
+
+type CloudflareTerraformProvider struct {
+	*terraform.TerraformProvider
+	name    string
+	version string
+}
+
+type cloudflareConfig struct {
+	APIToken  string
+	APIKey    string
+	Email     string
+	AccountID string
+}
+
+func NewCloudflareTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*CloudflareTerraformProvider, error) {
+	// Later major versions of the provider only speak the plugin protocol v6
+	if version == "" {
+		version = "3.35.0"
+	}
+	p := &CloudflareTerraformProvider{
+		version: version,
+		name:    tf.CLOUDFLARE,
+	}
+	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
+		Key:       p.name,
+		Version:   version,
+		Namespace: "cloudflare",
+		ConfigDir: configDir,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
+		Name: p.name,
+		GetProviderConfig: func(_ string) interface{} {
+			return p.GetConfig().providerConfig()
+		},
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+	p.TerraformProvider = tfProvider
+	return p, err
+}
+
+// GetConfig uses the same environment variables as the terraform provider
+func (p CloudflareTerraformProvider) GetConfig() cloudflareConfig {
+	return cloudflareConfig{
+		APIToken:  os.Getenv("CLOUDFLARE_API_TOKEN"),
+		APIKey:    os.Getenv("CLOUDFLARE_API_KEY"),
+		Email:     os.Getenv("CLOUDFLARE_EMAIL"),
+		AccountID: os.Getenv("CLOUDFLARE_ACCOUNT_ID"),
+	}
+}
+
+func (p *CloudflareTerraformProvider) Name() string {
+	return p.name
+}
+
+func (p *CloudflareTerraformProvider) Version() string {
+	return p.version
+}
+
+func (p *CloudflareTerraformProvider) CheckCredentialsExist() error {
+	c := p.GetConfig()
+	if c.APIToken == "" && (c.APIKey == "" || c.Email == "") {
+		return errors.New("Could not find Cloudflare credentials.\n" +
+			"Please set the CLOUDFLARE_API_TOKEN environment variable, " +
+			"or both CLOUDFLARE_API_KEY and CLOUDFLARE_EMAIL to use a global API key.")
+	}
+	return nil
+}
+
+// providerConfig only sets the attributes we have a value for, the provider
+// would otherwise reject empty credentials
+func (c cloudflareConfig) providerConfig() map[string]interface{} {
+	config := map[string]interface{}{}
+	if c.APIToken != "" {
+		config["api_token"] = c.APIToken
+	} else {
+		config["api_key"] = c.APIKey
+		config["email"] = c.Email
+	}
+	if c.AccountID != "" {
+		config["account_id"] = c.AccountID
+	}
+	return config
+}
+
+func (c cloudflareConfig) client(opts ...cloudflare.Option) (*cloudflare.API, error) {
+	if c.AccountID != "" {
+		opts = append(opts, cloudflare.UsingAccount(c.AccountID))
+	}
+	if c.APIToken != "" {
+		return cloudflare.NewWithAPIToken(c.APIToken, opts...)
+	}
+	return cloudflare.New(c.APIKey, c.Email, opts...)
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// The firewall rules endpoint is the only one for which the client does not
+// fetch every page by itself
+const firewallRulesPageSize = 100
+
+type CloudflareRepository interface {
+	ListAllZones(ctx context.Context) ([]cloudflare.Zone, error)
+	ListRecordsForZone(ctx context.Context, zoneID string) ([]cloudflare.DNSRecord, error)
+	ListPageRulesForZone(ctx context.Context, zoneID string) ([]cloudflare.PageRule, error)
+	ListFirewallRulesForZone(ctx context.Context, zoneID string) ([]cloudflare.FirewallRule, error)
+	ListWorkerRoutesForZone(ctx context.Context, zoneID string) ([]cloudflare.WorkerRoute, error)
+}
+
+type cloudflareRepository struct {
+	client *cloudflare.API
+	cache  cache.Cache
+}
+
+func NewCloudflareRepository(client *cloudflare.API, c cache.Cache) *cloudflareRepository {
+	return &cloudflareRepository{
+		client,
+		c,
+	}
+}
+
+func (r *cloudflareRepository) ListAllZones(ctx context.Context) ([]cloudflare.Zone, error) {
+	ctx, done := profile.Method(ctx, "CloudflareRepository.ListAllZones")
+	defer done()
+
+	cacheKey := "cloudflareListAllZones"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]cloudflare.Zone), nil
+	}
+
+	zones, err := r.client.ListZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, zones)
+	return zones, nil
+}
+
+func (r *cloudflareRepository) ListRecordsForZone(ctx context.Context, zoneID string) ([]cloudflare.DNSRecord, error) {
+	ctx, done := profile.Method(ctx, "CloudflareRepository.ListRecordsForZone")
+	defer done()
+
+	cacheKey := fmt.Sprintf("cloudflareListRecordsForZone_%s", zoneID)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]cloudflare.DNSRecord), nil
+	}
+
+	records, err := r.client.DNSRecords(ctx, zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, records)
+	return records, nil
+}
+
+func (r *cloudflareRepository) ListPageRulesForZone(ctx context.Context, zoneID string) ([]cloudflare.PageRule, error) {
+	ctx, done := profile.Method(ctx, "CloudflareRepository.ListPageRulesForZone")
+	defer done()
+
+	cacheKey := fmt.Sprintf("cloudflareListPageRulesForZone_%s", zoneID)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]cloudflare.PageRule), nil
+	}
+
+	rules, err := r.client.ListPageRules(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, rules)
+	return rules, nil
+}
+
+func (r *cloudflareRepository) ListFirewallRulesForZone(ctx context.Context, zoneID string) ([]cloudflare.FirewallRule, error) {
+	ctx, done := profile.Method(ctx, "CloudflareRepository.ListFirewallRulesForZone")
+	defer done()
+
+	cacheKey := fmt.Sprintf("cloudflareListFirewallRulesForZone_%s", zoneID)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]cloudflare.FirewallRule), nil
+	}
+
+	var results []cloudflare.FirewallRule
+	for page := 1; ; page++ {
+		rules, err := r.client.FirewallRules(ctx, zoneID, cloudflare.PaginationOptions{
+			Page:    page,
+			PerPage: firewallRulesPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, rules...)
+		if len(rules) < firewallRulesPageSize {
+			break
+		}
+	}
+
+	r.cache.Put(cacheKey, results)
+	return results, nil
+}
+
+func (r *cloudflareRepository) ListWorkerRoutesForZone(ctx context.Context, zoneID string) ([]cloudflare.WorkerRoute, error) {
+	ctx, done := profile.Method(ctx, "CloudflareRepository.ListWorkerRoutesForZone")
+	defer done()
+
+	cacheKey := fmt.Sprintf("cloudflareListWorkerRoutesForZone_%s", zoneID)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]cloudflare.WorkerRoute), nil
+	}
+
+	res, err := r.client.ListWorkerRoutes(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, res.Routes)
+	return res.Routes, nil
+}
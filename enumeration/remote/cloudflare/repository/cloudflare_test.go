@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/jarcoal/httpmock"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBaseURL = "https://api.cloudflare.com/client/v4"
+
+func listResponse(result string, page, totalPages int) string {
+	return fmt.Sprintf(
+		`{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": %d, "per_page": 50, "total_pages": %d}}`,
+		result,
+		page,
+		totalPages,
+	)
+}
+
+func newTestRepository(t *testing.T, c cache.Cache) *cloudflareRepository {
+	httpClient := &http.Client{}
+	httpmock.ActivateNonDefault(httpClient)
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	api, err := cloudflare.NewWithAPIToken("token", cloudflare.HTTPClient(httpClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewCloudflareRepository(api, c)
+}
+
+func TestCloudflareRepository_ListAllZones(t *testing.T) {
+	r := newTestRepository(t, cache.New(1))
+	httpmock.RegisterResponder(http.MethodGet, testBaseURL+"/zones", httpmock.NewStringResponder(200, listResponse(
+		`[{"id": "zone-1", "name": "example.com"}, {"id": "zone-2", "name": "example.org"}]`, 1, 1,
+	)))
+
+	zones, err := r.ListAllZones(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"zone-1", "zone-2"}, []string{zones[0].ID, zones[1].ID})
+
+	// Second call should hit the cache
+	_, err = r.ListAllZones(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestCloudflareRepository_ListRecordsForZone(t *testing.T) {
+	r := newTestRepository(t, cache.New(0))
+	httpmock.RegisterResponder(http.MethodGet, testBaseURL+"/zones/zone-1/dns_records", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("page") == "2" {
+			return httpmock.NewStringResponse(200, listResponse(`[{"id": "record-2", "name": "www.example.com", "type": "CNAME"}]`, 2, 2)), nil
+		}
+		return httpmock.NewStringResponse(200, listResponse(`[{"id": "record-1", "name": "example.com", "type": "A"}]`, 1, 2)), nil
+	})
+
+	records, err := r.ListRecordsForZone(context.Background(), "zone-1")
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "record-1", records[0].ID)
+	assert.Equal(t, "record-2", records[1].ID)
+}
+
+func TestCloudflareRepository_ListPageRulesForZone(t *testing.T) {
+	r := newTestRepository(t, cache.New(0))
+	httpmock.RegisterResponder(http.MethodGet, testBaseURL+"/zones/zone-1/pagerules", httpmock.NewStringResponder(200, listResponse(
+		`[{"id": "rule-1", "status": "active", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/*"}}]}]`, 1, 1,
+	)))
+
+	rules, err := r.ListPageRulesForZone(context.Background(), "zone-1")
+	assert.Nil(t, err)
+	assert.Len(t, rules, 1)
+	assert.Equal(t, "example.com/*", rules[0].Targets[0].Constraint.Value)
+}
+
+func TestCloudflareRepository_ListFirewallRulesForZone(t *testing.T) {
+	r := newTestRepository(t, cache.New(0))
+	httpmock.RegisterResponder(http.MethodGet, testBaseURL+"/zones/zone-1/firewall/rules", func(req *http.Request) (*http.Response, error) {
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		count := firewallRulesPageSize
+		if page == 2 {
+			count = 1
+		}
+		rules := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			rules = append(rules, fmt.Sprintf(`{"id": "rule-%d-%d"}`, page, i))
+		}
+		return httpmock.NewStringResponse(200, listResponse("["+strings.Join(rules, ",")+"]", page, 2)), nil
+	})
+
+	rules, err := r.ListFirewallRulesForZone(context.Background(), "zone-1")
+	assert.Nil(t, err)
+	assert.Len(t, rules, firewallRulesPageSize+1)
+	assert.Equal(t, "rule-2-0", rules[firewallRulesPageSize].ID)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestCloudflareRepository_ListWorkerRoutesForZone(t *testing.T) {
+	r := newTestRepository(t, cache.New(0))
+	httpmock.RegisterResponder(http.MethodGet, testBaseURL+"/zones/zone-1/workers/routes", httpmock.NewStringResponder(200,
+		`{"success": true, "errors": [], "messages": [], "result": [{"id": "route-1", "pattern": "example.com/api/*", "script": "api"}]}`,
+	))
+
+	routes, err := r.ListWorkerRoutesForZone(context.Background(), "zone-1")
+	assert.Nil(t, err)
+	assert.Len(t, routes, 1)
+	assert.Equal(t, "example.com/api/*", routes[0].Pattern)
+}
+
+func TestCloudflareRepository_AccessDenied(t *testing.T) {
+	r := newTestRepository(t, cache.New(0))
+	httpmock.RegisterResponder(http.MethodGet, testBaseURL+"/zones/zone-1/pagerules", httpmock.NewStringResponder(403,
+		`{"success": false, "errors": [{"code": 10000, "message": "Authentication error"}], "messages": [], "result": null}`,
+	))
+
+	_, err := r.ListPageRulesForZone(context.Background(), "zone-1")
+	assert.IsType(t, &cloudflare.AuthenticationError{}, err)
+}
@@ -0,0 +1,173 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/jarcoal/httpmock"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	remotecloudflare "github.com/snyk/driftctl/enumeration/remote/cloudflare"
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourcecloudflare "github.com/snyk/driftctl/enumeration/resource/cloudflare"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const cloudflareTestBaseURL = "https://api.cloudflare.com/client/v4"
+
+func cloudflareListResponse(result string) string {
+	return `{"success": true, "errors": [], "messages": [], "result": ` + result + `, "result_info": {"page": 1, "per_page": 50, "total_pages": 1}}`
+}
+
+func newCloudflareTestRepository(t *testing.T) repository.CloudflareRepository {
+	httpClient := &http.Client{}
+	httpmock.ActivateNonDefault(httpClient)
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	httpmock.RegisterResponder(http.MethodGet, cloudflareTestBaseURL+"/zones", httpmock.NewStringResponder(200, cloudflareListResponse(
+		`[{"id": "zone-1", "name": "example.com"}]`,
+	)))
+	httpmock.RegisterResponder(http.MethodGet, cloudflareTestBaseURL+"/zones/zone-1/dns_records", httpmock.NewStringResponder(200, cloudflareListResponse(
+		`[{"id": "record-1", "name": "example.com", "type": "A", "content": "192.0.2.1"}, {"id": "record-2", "name": "www.example.com", "type": "CNAME", "content": "example.com"}]`,
+	)))
+	httpmock.RegisterResponder(http.MethodGet, cloudflareTestBaseURL+"/zones/zone-1/pagerules", httpmock.NewStringResponder(200, cloudflareListResponse(
+		`[{"id": "page-rule-1", "status": "active", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/*"}}]}]`,
+	)))
+	httpmock.RegisterResponder(http.MethodGet, cloudflareTestBaseURL+"/zones/zone-1/firewall/rules", httpmock.NewStringResponder(200, cloudflareListResponse(
+		`[{"id": "firewall-rule-1", "description": "block bots", "action": "block"}]`,
+	)))
+	httpmock.RegisterResponder(http.MethodGet, cloudflareTestBaseURL+"/zones/zone-1/workers/routes", httpmock.NewStringResponder(200, cloudflareListResponse(
+		`[{"id": "route-1", "pattern": "example.com/api/*", "script": "api"}]`,
+	)))
+
+	api, err := cloudflare.NewWithAPIToken("token", cloudflare.HTTPClient(httpClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repository.NewCloudflareRepository(api, cache.New(0))
+}
+
+func TestScanCloudflare(t *testing.T) {
+	cases := []struct {
+		test         string
+		resourceType string
+		enumerator   func(repository.CloudflareRepository, resource.ResourceFactory) common.Enumerator
+		expected     map[string]map[string]interface{}
+	}{
+		{
+			test:         "zones",
+			resourceType: resourcecloudflare.CloudflareZoneResourceType,
+			enumerator: func(repo repository.CloudflareRepository, factory resource.ResourceFactory) common.Enumerator {
+				return remotecloudflare.NewCloudflareZoneEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"zone-1": {"zone": "example.com"},
+			},
+		},
+		{
+			test:         "records",
+			resourceType: resourcecloudflare.CloudflareRecordResourceType,
+			enumerator: func(repo repository.CloudflareRepository, factory resource.ResourceFactory) common.Enumerator {
+				return remotecloudflare.NewCloudflareRecordEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"record-1": {"zone_id": "zone-1", "name": "example.com", "type": "A"},
+				"record-2": {"zone_id": "zone-1", "name": "www.example.com", "type": "CNAME"},
+			},
+		},
+		{
+			test:         "page rules",
+			resourceType: resourcecloudflare.CloudflarePageRuleResourceType,
+			enumerator: func(repo repository.CloudflareRepository, factory resource.ResourceFactory) common.Enumerator {
+				return remotecloudflare.NewCloudflarePageRuleEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"page-rule-1": {"zone_id": "zone-1", "status": "active", "target": "example.com/*"},
+			},
+		},
+		{
+			test:         "firewall rules",
+			resourceType: resourcecloudflare.CloudflareFirewallRuleResourceType,
+			enumerator: func(repo repository.CloudflareRepository, factory resource.ResourceFactory) common.Enumerator {
+				return remotecloudflare.NewCloudflareFirewallRuleEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"firewall-rule-1": {"zone_id": "zone-1", "description": "block bots"},
+			},
+		},
+		{
+			test:         "worker routes",
+			resourceType: resourcecloudflare.CloudflareWorkerRouteResourceType,
+			enumerator: func(repo repository.CloudflareRepository, factory resource.ResourceFactory) common.Enumerator {
+				return remotecloudflare.NewCloudflareWorkerRouteEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"route-1": {"zone_id": "zone-1", "pattern": "example.com/api/*"},
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+			alerter := &mocks.AlerterInterface{}
+
+			remoteLibrary.AddEnumerator(c.enumerator(newCloudflareTestRepository(tt), factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+
+			assert.Len(tt, got, len(c.expected))
+			for _, res := range got {
+				assert.Equal(tt, c.resourceType, res.ResourceType())
+				expectedAttrs, exist := c.expected[res.ResourceId()]
+				if assert.True(tt, exist, "unexpected resource %s", res.ResourceId()) {
+					assert.Equal(tt, resource.Attributes(expectedAttrs), *res.Attributes())
+				}
+			}
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestScanCloudflare_AccessDenied(t *testing.T) {
+	repo := newCloudflareTestRepository(t)
+	httpmock.RegisterResponder(http.MethodGet, cloudflareTestBaseURL+"/zones/zone-1/firewall/rules", httpmock.NewStringResponder(403,
+		`{"success": false, "errors": [{"code": 10000, "message": "Authentication error"}], "messages": [], "result": null}`,
+	))
+
+	factory := terraform.NewTerraformResourceFactory()
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(remotecloudflare.NewCloudflareFirewallRuleEnumerator(repo, factory))
+
+	alerter := &mocks.AlerterInterface{}
+	alerter.On("SendAlert", resourcecloudflare.CloudflareFirewallRuleResourceType, mock.MatchedBy(func(alert *alerts.RemoteAccessDeniedAlert) bool {
+		return strings.Contains(alert.Message(), "Authentication error") &&
+			strings.Contains(alert.GetProviderMessage(), "Cloudflare API token")
+	})).Return()
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter, testFilter)
+	got, err := s.Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, got, 0)
+	alerter.AssertExpectations(t)
+}
@@ -1,12 +1,16 @@
 package common
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform/providers"
+	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/resource"
 )
 
 type Enumerator interface {
 	SupportedType() resource.ResourceType
-	Enumerate() ([]*resource.Resource, error)
+	Enumerate(ctx context.Context) ([]*resource.Resource, error)
 }
 
 type RemoteLibrary struct {
@@ -23,6 +27,18 @@ func (r *RemoteLibrary) AddEnumerator(enumerator Enumerator) {
 	r.enumerators = append(r.enumerators, enumerator)
 }
 
+// AddEnumeratorIfSupported adds the enumerator only when the provider schema has its type. Resources of
+// a type added by a newer provider version can neither be read nor compared with IaC.
+func (r *RemoteLibrary) AddEnumeratorIfSupported(schema map[string]providers.Schema, enumerator Enumerator) {
+	if _, exist := schema[string(enumerator.SupportedType())]; !exist {
+		logrus.WithFields(logrus.Fields{
+			"type": enumerator.SupportedType(),
+		}).Debug("Skipping enumeration, type is not supported by this provider version")
+		return
+	}
+	r.AddEnumerator(enumerator)
+}
+
 func (r *RemoteLibrary) Enumerators() []Enumerator {
 	return r.enumerators
 }
@@ -8,23 +8,36 @@ import (
 type RemoteParameter string
 
 const (
-	RemoteAWSTerraform    = "aws+tf"
-	RemoteGithubTerraform = "github+tf"
-	RemoteGoogleTerraform = "gcp+tf"
-	RemoteAzureTerraform  = "azure+tf"
+	RemoteAWSTerraform        = "aws+tf"
+	RemoteGithubTerraform     = "github+tf"
+	RemoteGoogleTerraform     = "gcp+tf"
+	RemoteAzureTerraform      = "azure+tf"
+	RemoteKubernetesTerraform = "k8s+tf"
+	RemoteCloudflareTerraform = "cloudflare+tf"
 )
 
 var remoteParameterMapping = map[RemoteParameter]string{
-	RemoteAWSTerraform:    tf.AWS,
-	RemoteGithubTerraform: tf.GITHUB,
-	RemoteGoogleTerraform: tf.GOOGLE,
-	RemoteAzureTerraform:  tf.AZURE,
+	RemoteAWSTerraform:        tf.AWS,
+	RemoteGithubTerraform:     tf.GITHUB,
+	RemoteGoogleTerraform:     tf.GOOGLE,
+	RemoteAzureTerraform:      tf.AZURE,
+	RemoteKubernetesTerraform: tf.KUBERNETES,
+	RemoteCloudflareTerraform: tf.CLOUDFLARE,
+}
+
+// Providers that are not published under the hashicorp namespace of the registry
+var remoteParameterNamespaceMapping = map[RemoteParameter]string{
+	RemoteCloudflareTerraform: "cloudflare",
 }
 
 func (p RemoteParameter) GetProviderAddress() *lock.ProviderAddress {
+	namespace, ok := remoteParameterNamespaceMapping[p]
+	if !ok {
+		namespace = "hashicorp"
+	}
 	return &lock.ProviderAddress{
 		Hostname:  "registry.terraform.io",
-		Namespace: "hashicorp",
+		Namespace: namespace,
 		Type:      remoteParameterMapping[p],
 	}
 }
@@ -1,6 +1,8 @@
 package github
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/resource/github"
@@ -22,8 +24,8 @@ func (g *GithubMembershipEnumerator) SupportedType() resource.ResourceType {
 	return github.GithubMembershipResourceType
 }
 
-func (g *GithubMembershipEnumerator) Enumerate() ([]*resource.Resource, error) {
-	ids, err := g.Membership.ListMembership()
+func (g *GithubMembershipEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ids, err := g.Membership.ListMembership(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
 	}
@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/github"
+)
+
+type GithubRepositoryCollaboratorEnumerator struct {
+	repository GithubRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGithubRepositoryCollaboratorEnumerator(repo GithubRepository, factory resource.ResourceFactory) *GithubRepositoryCollaboratorEnumerator {
+	return &GithubRepositoryCollaboratorEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (g *GithubRepositoryCollaboratorEnumerator) SupportedType() resource.ResourceType {
+	return github.GithubRepositoryCollaboratorResourceType
+}
+
+func (g *GithubRepositoryCollaboratorEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ids, err := g.repository.ListRepositoryCollaborators(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(ids))
+
+	for _, id := range ids {
+		results = append(
+			results,
+			g.factory.CreateAbstractResource(
+				string(g.SupportedType()),
+				id,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
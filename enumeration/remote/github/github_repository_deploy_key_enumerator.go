@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/github"
+)
+
+type GithubRepositoryDeployKeyEnumerator struct {
+	repository GithubRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGithubRepositoryDeployKeyEnumerator(repo GithubRepository, factory resource.ResourceFactory) *GithubRepositoryDeployKeyEnumerator {
+	return &GithubRepositoryDeployKeyEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (g *GithubRepositoryDeployKeyEnumerator) SupportedType() resource.ResourceType {
+	return github.GithubRepositoryDeployKeyResourceType
+}
+
+func (g *GithubRepositoryDeployKeyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ids, err := g.repository.ListRepositoryDeployKeys(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(ids))
+
+	for _, id := range ids {
+		results = append(
+			results,
+			g.factory.CreateAbstractResource(
+				string(g.SupportedType()),
+				id,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
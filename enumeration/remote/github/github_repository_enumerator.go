@@ -1,6 +1,8 @@
 package github
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/resource/github"
@@ -22,8 +24,8 @@ func (g *GithubRepositoryEnumerator) SupportedType() resource.ResourceType {
 	return github.GithubRepositoryResourceType
 }
 
-func (g *GithubRepositoryEnumerator) Enumerate() ([]*resource.Resource, error) {
-	ids, err := g.repository.ListRepositories()
+func (g *GithubRepositoryEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ids, err := g.repository.ListRepositories(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
 	}
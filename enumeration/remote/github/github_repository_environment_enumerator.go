@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/github"
+)
+
+type GithubRepositoryEnvironmentEnumerator struct {
+	repository GithubRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGithubRepositoryEnvironmentEnumerator(repo GithubRepository, factory resource.ResourceFactory) *GithubRepositoryEnvironmentEnumerator {
+	return &GithubRepositoryEnvironmentEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (g *GithubRepositoryEnvironmentEnumerator) SupportedType() resource.ResourceType {
+	return github.GithubRepositoryEnvironmentResourceType
+}
+
+func (g *GithubRepositoryEnvironmentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ids, err := g.repository.ListRepositoryEnvironments(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(ids))
+
+	for _, id := range ids {
+		results = append(
+			results,
+			g.factory.CreateAbstractResource(
+				string(g.SupportedType()),
+				id,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
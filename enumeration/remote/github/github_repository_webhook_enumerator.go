@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/github"
+)
+
+type GithubRepositoryWebhookEnumerator struct {
+	repository GithubRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGithubRepositoryWebhookEnumerator(repo GithubRepository, factory resource.ResourceFactory) *GithubRepositoryWebhookEnumerator {
+	return &GithubRepositoryWebhookEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (g *GithubRepositoryWebhookEnumerator) SupportedType() resource.ResourceType {
+	return github.GithubRepositoryWebhookResourceType
+}
+
+func (g *GithubRepositoryWebhookEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ids, err := g.repository.ListRepositoryWebhooks(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(ids))
+
+	for _, id := range ids {
+		results = append(
+			results,
+			g.factory.CreateAbstractResource(
+				string(g.SupportedType()),
+				id,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package github
 
 import (
+	"context"
+
 	"fmt"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 
@@ -24,8 +26,8 @@ func (g *GithubTeamEnumerator) SupportedType() resource.ResourceType {
 	return github.GithubTeamResourceType
 }
 
-func (g *GithubTeamEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resourceList, err := g.repository.ListTeams()
+func (g *GithubTeamEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resourceList, err := g.repository.ListTeams(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package github
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/resource/github"
@@ -22,8 +24,8 @@ func (g *GithubTeamMembershipEnumerator) SupportedType() resource.ResourceType {
 	return github.GithubTeamMembershipResourceType
 }
 
-func (g *GithubTeamMembershipEnumerator) Enumerate() ([]*resource.Resource, error) {
-	ids, err := g.repository.ListTeamMemberships()
+func (g *GithubTeamMembershipEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ids, err := g.repository.ListTeamMemberships(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(g.SupportedType()))
 	}
@@ -3,10 +3,12 @@ package github
 import (
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/traffic"
 )
 
 /**
@@ -14,21 +16,32 @@ import (
  * Required to use Scanner
  */
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, rateLimits *ratelimit.Controller, repositoryCache cache.Cache, capture *traffic.Capture) error {
 
 	provider, err := NewGithubTerraformProvider(version, progress, configDir)
 	if err != nil {
 		return err
 	}
-	err = provider.Init()
+	provider.SetParallelism(rateLimits.Parallelism())
+	// A replayed scan only needs the recorded schema, the provider is not started
+	if !capture.Replaying() {
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
+	}
+	tfProvider, err := capture.Provider(provider)
 	if err != nil {
 		return err
 	}
 
-	repositoryCache := cache.New(100)
+	// Queries depend on the owner, a replayed scan uses the recorded one
+	config := provider.GetConfig()
+	config.Owner = capture.Setting("github.owner", config.Owner)
+	config.Organization = capture.Setting("github.organization", config.Organization)
 
-	repository := NewGithubRepository(provider.GetConfig(), repositoryCache)
-	providerLibrary.AddProvider(terraform.GITHUB, provider)
+	repository := NewGithubRepository(config, repositoryCache, rateLimits.Limiter("github"), capture)
+	providerLibrary.AddProvider(terraform.GITHUB, tfProvider)
 
 	remoteLibrary.AddEnumerator(NewGithubTeamEnumerator(repository, factory))
 
@@ -40,5 +53,22 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 
 	remoteLibrary.AddEnumerator(NewGithubBranchProtectionEnumerator(repository, factory))
 
+	remoteLibrary.AddEnumerator(NewGithubActionsSecretEnumerator(repository, factory))
+
+	remoteLibrary.AddEnumerator(NewGithubActionsOrganizationSecretEnumerator(repository, factory))
+
+	// Environments and rulesets are not supported by the default provider version
+	schema := tfProvider.Schema()
+
+	remoteLibrary.AddEnumeratorIfSupported(schema, NewGithubRepositoryEnvironmentEnumerator(repository, factory))
+
+	remoteLibrary.AddEnumerator(NewGithubRepositoryDeployKeyEnumerator(repository, factory))
+
+	remoteLibrary.AddEnumerator(NewGithubRepositoryWebhookEnumerator(repository, factory))
+
+	remoteLibrary.AddEnumerator(NewGithubRepositoryCollaboratorEnumerator(repository, factory))
+
+	remoteLibrary.AddEnumeratorIfSupported(schema, NewGithubRepositoryRulesetEnumerator(repository, factory))
+
 	return nil
 }
@@ -3,18 +3,31 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/traffic"
 
 	"github.com/shurcooL/githubv4"
 	"golang.org/x/oauth2"
 )
 
 type GithubRepository interface {
-	ListRepositories() ([]string, error)
-	ListTeams() ([]Team, error)
-	ListMembership() ([]string, error)
-	ListTeamMemberships() ([]string, error)
-	ListBranchProtection() ([]string, error)
+	ListRepositories(ctx context.Context) ([]string, error)
+	ListTeams(ctx context.Context) ([]Team, error)
+	ListMembership(ctx context.Context) ([]string, error)
+	ListTeamMemberships(ctx context.Context) ([]string, error)
+	ListBranchProtection(ctx context.Context) ([]string, error)
+	ListActionsSecrets(ctx context.Context) ([]string, error)
+	ListActionsOrganizationSecrets(ctx context.Context) ([]string, error)
+	ListRepositoryEnvironments(ctx context.Context) ([]string, error)
+	ListRepositoryDeployKeys(ctx context.Context) ([]string, error)
+	ListRepositoryWebhooks(ctx context.Context) ([]string, error)
+	ListRepositoryCollaborators(ctx context.Context) ([]string, error)
+	ListRepositoryRulesets(ctx context.Context) ([]string, error)
 }
 
 type GithubGraphQLClient interface {
@@ -22,36 +35,40 @@ type GithubGraphQLClient interface {
 }
 
 type githubRepository struct {
-	client GithubGraphQLClient
-	ctx    context.Context
-	config githubConfig
-	cache  cache.Cache
+	client     GithubGraphQLClient
+	restClient GithubRESTClient
+	config     githubConfig
+	cache      cache.Cache
 }
 
-func NewGithubRepository(config githubConfig, c cache.Cache) *githubRepository {
-	ctx := context.Background()
+func NewGithubRepository(config githubConfig, c cache.Cache, limiter *ratelimit.Limiter, capture *traffic.Capture) *githubRepository {
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: config.Token},
 	)
-	oauthClient := oauth2.NewClient(ctx, ts)
+	oauthClient := oauth2.NewClient(context.Background(), ts)
+	// The capture replaces the oauth transport when replaying, so no token is needed
+	oauthClient.Transport = profile.NewTransport(ratelimit.NewTransport(limiter, capture.Transport("github", oauthClient.Transport)))
 
 	repo := &githubRepository{
-		client: githubv4.NewClient(oauthClient),
-		ctx:    context.Background(),
-		config: config,
-		cache:  c,
+		client:     githubv4.NewClient(oauthClient),
+		restClient: newGithubRESTClient(oauthClient),
+		config:     config,
+		cache:      c,
 	}
 
 	return repo
 }
 
-func (r *githubRepository) ListRepositories() ([]string, error) {
+func (r *githubRepository) ListRepositories(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListRepositories")
+	defer done()
+
 	if v := r.cache.Get("githubListRepositories"); v != nil {
 		return v.([]string), nil
 	}
 
 	if r.config.Organization != "" {
-		results, err := r.listRepoForOrg()
+		results, err := r.listRepoForOrg(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +76,7 @@ func (r *githubRepository) ListRepositories() ([]string, error) {
 		return results, nil
 	}
 
-	results, err := r.listRepoForOwner()
+	results, err := r.listRepoForOwner(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +100,7 @@ type listRepoForOrgQuery struct {
 	} `graphql:"organization(login: $org)"`
 }
 
-func (r *githubRepository) listRepoForOrg() ([]string, error) {
+func (r *githubRepository) listRepoForOrg(ctx context.Context) ([]string, error) {
 	query := listRepoForOrgQuery{}
 	variables := map[string]interface{}{
 		"org":    (githubv4.String)(r.config.Organization),
@@ -91,7 +108,7 @@ func (r *githubRepository) listRepoForOrg() ([]string, error) {
 	}
 	var results []string
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -120,14 +137,14 @@ type listRepoForOwnerQuery struct {
 	}
 }
 
-func (r githubRepository) listRepoForOwner() ([]string, error) {
+func (r githubRepository) listRepoForOwner(ctx context.Context) ([]string, error) {
 	query := listRepoForOwnerQuery{}
 	variables := map[string]interface{}{
 		"cursor": (*githubv4.String)(nil),
 	}
 	var results []string
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -162,7 +179,10 @@ type Team struct {
 	Slug       string
 }
 
-func (r githubRepository) ListTeams() ([]Team, error) {
+func (r githubRepository) ListTeams(ctx context.Context) ([]Team, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListTeams")
+	defer done()
+
 	if v := r.cache.Get("githubListTeams"); v != nil {
 		return v.([]Team), nil
 	}
@@ -178,7 +198,7 @@ func (r githubRepository) ListTeams() ([]Team, error) {
 		"login":  (githubv4.String)(r.config.Organization),
 	}
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -212,7 +232,10 @@ type listMembership struct {
 	} `graphql:"organization(login: $login)"`
 }
 
-func (r *githubRepository) ListMembership() ([]string, error) {
+func (r *githubRepository) ListMembership(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListMembership")
+	defer done()
+
 	if v := r.cache.Get("githubListMembership"); v != nil {
 		return v.([]string), nil
 	}
@@ -228,7 +251,7 @@ func (r *githubRepository) ListMembership() ([]string, error) {
 		"login":  (githubv4.String)(r.config.Organization),
 	}
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -261,12 +284,15 @@ type listTeamMembershipsQuery struct {
 	} `graphql:"organization(login: $login)"`
 }
 
-func (r githubRepository) ListTeamMemberships() ([]string, error) {
+func (r githubRepository) ListTeamMemberships(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListTeamMemberships")
+	defer done()
+
 	if v := r.cache.Get("githubListTeamMemberships"); v != nil {
 		return v.([]string), nil
 	}
 
-	teamList, err := r.ListTeams()
+	teamList, err := r.ListTeams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +311,7 @@ func (r githubRepository) ListTeamMemberships() ([]string, error) {
 		variables["slug"] = (githubv4.String)(team.Slug)
 		variables["cursor"] = (*githubv4.String)(nil)
 		for {
-			err := r.client.Query(r.ctx, &query, variables)
+			err := r.client.Query(ctx, &query, variables)
 			if err != nil {
 				return nil, err
 			}
@@ -317,12 +343,15 @@ type listBranchProtectionQuery struct {
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
-func (r *githubRepository) ListBranchProtection() ([]string, error) {
+func (r *githubRepository) ListBranchProtection(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListBranchProtection")
+	defer done()
+
 	if v := r.cache.Get("githubListBranchProtection"); v != nil {
 		return v.([]string), nil
 	}
 
-	repoList, err := r.ListRepositories()
+	repoList, err := r.ListRepositories(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +368,7 @@ func (r *githubRepository) ListBranchProtection() ([]string, error) {
 		variables["name"] = (githubv4.String)(repo)
 		variables["cursor"] = (*githubv4.String)(nil)
 		for {
-			err := r.client.Query(r.ctx, &query, variables)
+			err := r.client.Query(ctx, &query, variables)
 			if err != nil {
 				return nil, err
 			}
@@ -359,3 +388,287 @@ func (r *githubRepository) ListBranchProtection() ([]string, error) {
 	r.cache.Put("githubListBranchProtection", results)
 	return results, nil
 }
+
+type restSecretList struct {
+	Secrets []struct {
+		Name string `json:"name"`
+	} `json:"secrets"`
+}
+
+func (r *githubRepository) listRESTSecretNames(ctx context.Context, path string) ([]string, error) {
+	results := make([]string, 0)
+	for next := path; next != ""; {
+		page := restSecretList{}
+		n, err := r.restClient.Get(ctx, next, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range page.Secrets {
+			results = append(results, secret.Name)
+		}
+		next = n
+	}
+	return results, nil
+}
+
+func (r *githubRepository) listRESTIds(ctx context.Context, path string) ([]string, error) {
+	results := make([]string, 0)
+	for next := path; next != ""; {
+		var page []struct {
+			Id int64 `json:"id"`
+		}
+		n, err := r.restClient.Get(ctx, next, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page {
+			results = append(results, strconv.FormatInt(item.Id, 10))
+		}
+		next = n
+	}
+	return results, nil
+}
+
+func (r *githubRepository) repositoryPath(repo, suffix string) string {
+	return fmt.Sprintf("/repos/%s/%s/%s", url.PathEscape(r.config.getDefaultOwner()), url.PathEscape(repo), suffix)
+}
+
+// ListActionsSecrets only returns secret names, values are never exposed by the API
+func (r *githubRepository) ListActionsSecrets(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListActionsSecrets")
+	defer done()
+
+	if v := r.cache.Get("githubListActionsSecrets"); v != nil {
+		return v.([]string), nil
+	}
+
+	repoList, err := r.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0)
+	for _, repo := range repoList {
+		names, err := r.listRESTSecretNames(ctx, r.repositoryPath(repo, "actions/secrets?per_page=100"))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			results = append(results, fmt.Sprintf("%s:%s", repo, name))
+		}
+	}
+
+	r.cache.Put("githubListActionsSecrets", results)
+	return results, nil
+}
+
+func (r *githubRepository) ListActionsOrganizationSecrets(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListActionsOrganizationSecrets")
+	defer done()
+
+	if v := r.cache.Get("githubListActionsOrganizationSecrets"); v != nil {
+		return v.([]string), nil
+	}
+
+	results := make([]string, 0)
+	if r.config.Organization == "" {
+		r.cache.Put("githubListActionsOrganizationSecrets", results)
+		return results, nil
+	}
+
+	results, err := r.listRESTSecretNames(ctx, fmt.Sprintf("/orgs/%s/actions/secrets?per_page=100", url.PathEscape(r.config.Organization)))
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("githubListActionsOrganizationSecrets", results)
+	return results, nil
+}
+
+type listRepositoryEnvironmentsQuery struct {
+	Repository struct {
+		Environments struct {
+			Nodes []struct {
+				Name string
+			}
+			PageInfo pageInfo
+		} `graphql:"environments(first: 100, after: $cursor)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (r *githubRepository) ListRepositoryEnvironments(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListRepositoryEnvironments")
+	defer done()
+
+	if v := r.cache.Get("githubListRepositoryEnvironments"); v != nil {
+		return v.([]string), nil
+	}
+
+	repoList, err := r.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0)
+	query := listRepositoryEnvironmentsQuery{}
+	variables := map[string]interface{}{
+		"owner": (githubv4.String)(r.config.getDefaultOwner()),
+	}
+
+	for _, repo := range repoList {
+		variables["name"] = (githubv4.String)(repo)
+		variables["cursor"] = (*githubv4.String)(nil)
+		for {
+			err := r.client.Query(ctx, &query, variables)
+			if err != nil {
+				return nil, err
+			}
+			for _, env := range query.Repository.Environments.Nodes {
+				results = append(results, fmt.Sprintf("%s:%s", repo, env.Name))
+			}
+			if !query.Repository.Environments.PageInfo.HasNextPage {
+				break
+			}
+			variables["cursor"] = githubv4.NewString(query.Repository.Environments.PageInfo.EndCursor)
+		}
+	}
+
+	r.cache.Put("githubListRepositoryEnvironments", results)
+	return results, nil
+}
+
+// ListRepositoryDeployKeys uses the REST API since GraphQL does not expose the numeric key id used by terraform
+func (r *githubRepository) ListRepositoryDeployKeys(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListRepositoryDeployKeys")
+	defer done()
+
+	if v := r.cache.Get("githubListRepositoryDeployKeys"); v != nil {
+		return v.([]string), nil
+	}
+
+	repoList, err := r.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0)
+	for _, repo := range repoList {
+		ids, err := r.listRESTIds(ctx, r.repositoryPath(repo, "keys?per_page=100"))
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			results = append(results, fmt.Sprintf("%s:%s", repo, id))
+		}
+	}
+
+	r.cache.Put("githubListRepositoryDeployKeys", results)
+	return results, nil
+}
+
+func (r *githubRepository) ListRepositoryWebhooks(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListRepositoryWebhooks")
+	defer done()
+
+	if v := r.cache.Get("githubListRepositoryWebhooks"); v != nil {
+		return v.([]string), nil
+	}
+
+	repoList, err := r.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0)
+	for _, repo := range repoList {
+		ids, err := r.listRESTIds(ctx, r.repositoryPath(repo, "hooks?per_page=100"))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, ids...)
+	}
+
+	r.cache.Put("githubListRepositoryWebhooks", results)
+	return results, nil
+}
+
+type listRepositoryCollaboratorsQuery struct {
+	Repository struct {
+		Collaborators struct {
+			Nodes []struct {
+				Login string
+			}
+			PageInfo pageInfo
+		} `graphql:"collaborators(first: 100, after: $cursor, affiliation: DIRECT)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// ListRepositoryCollaborators only returns direct collaborators, people having access through
+// an organization membership or a team are managed by other resources
+func (r *githubRepository) ListRepositoryCollaborators(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListRepositoryCollaborators")
+	defer done()
+
+	if v := r.cache.Get("githubListRepositoryCollaborators"); v != nil {
+		return v.([]string), nil
+	}
+
+	repoList, err := r.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0)
+	query := listRepositoryCollaboratorsQuery{}
+	variables := map[string]interface{}{
+		"owner": (githubv4.String)(r.config.getDefaultOwner()),
+	}
+
+	for _, repo := range repoList {
+		variables["name"] = (githubv4.String)(repo)
+		variables["cursor"] = (*githubv4.String)(nil)
+		for {
+			err := r.client.Query(ctx, &query, variables)
+			if err != nil {
+				return nil, err
+			}
+			for _, collaborator := range query.Repository.Collaborators.Nodes {
+				results = append(results, fmt.Sprintf("%s:%s", repo, collaborator.Login))
+			}
+			if !query.Repository.Collaborators.PageInfo.HasNextPage {
+				break
+			}
+			variables["cursor"] = githubv4.NewString(query.Repository.Collaborators.PageInfo.EndCursor)
+		}
+	}
+
+	r.cache.Put("githubListRepositoryCollaborators", results)
+	return results, nil
+}
+
+// ListRepositoryRulesets does not return rulesets inherited from the organization
+func (r *githubRepository) ListRepositoryRulesets(ctx context.Context) ([]string, error) {
+	ctx, done := profile.Method(ctx, "GithubRepository.ListRepositoryRulesets")
+	defer done()
+
+	if v := r.cache.Get("githubListRepositoryRulesets"); v != nil {
+		return v.([]string), nil
+	}
+
+	repoList, err := r.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0)
+	for _, repo := range repoList {
+		ids, err := r.listRESTIds(ctx, r.repositoryPath(repo, "rulesets?per_page=100&includes_parents=false"))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, ids...)
+	}
+
+	r.cache.Put("githubListRepositoryRulesets", results)
+	return results, nil
+}
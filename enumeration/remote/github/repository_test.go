@@ -3,8 +3,10 @@ package github
 import (
 	"context"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"net/http"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/pkg/errors"
 	"github.com/shurcooL/githubv4"
 	"github.com/snyk/driftctl/mocks"
@@ -23,7 +25,7 @@ func TestListRepositoriesForUser_WithError(t *testing.T) {
 		cache:  cache.New(1),
 	}
 
-	_, err := r.ListRepositories()
+	_, err := r.ListRepositories(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -81,12 +83,11 @@ func TestListRepositoriesForUser(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{},
 		cache:  store,
 	}
 
-	repos, err := r.ListRepositories()
+	repos, err := r.ListRepositories(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -99,7 +100,7 @@ func TestListRepositoriesForUser(t *testing.T) {
 	}, repos)
 
 	// Check that results were cached
-	cachedData, err := r.ListRepositories()
+	cachedData, err := r.ListRepositories(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, repos, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListRepositories"))
@@ -118,7 +119,7 @@ func TestListRepositoriesForOrganization_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListRepositories()
+	_, err := r.ListRepositories(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -182,14 +183,13 @@ func TestListRepositoriesForOrganization(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	repos, err := r.ListRepositories()
+	repos, err := r.ListRepositories(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -202,7 +202,7 @@ func TestListRepositoriesForOrganization(t *testing.T) {
 	}, repos)
 
 	// Check that results were cached
-	cachedData, err := r.ListRepositories()
+	cachedData, err := r.ListRepositories(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, repos, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListRepositories"))
@@ -221,14 +221,14 @@ func TestListTeams_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListTeams()
+	_, err := r.ListTeams(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
 func TestListTeams_WithoutOrganization(t *testing.T) {
 	r := githubRepository{cache: cache.New(1)}
 
-	teams, err := r.ListTeams()
+	teams, err := r.ListTeams(context.Background())
 	assert.Nil(t, err)
 	assert.Equal(t, []Team{}, teams)
 }
@@ -299,14 +299,13 @@ func TestListTeams(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	teams, err := r.ListTeams()
+	teams, err := r.ListTeams(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -319,7 +318,7 @@ func TestListTeams(t *testing.T) {
 	}, teams)
 
 	// Check that results were cached
-	cachedData, err := r.ListTeams()
+	cachedData, err := r.ListTeams(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, teams, cachedData)
 	assert.IsType(t, []Team{}, store.Get("githubListTeams"))
@@ -338,7 +337,7 @@ func TestListTeamMemberships_WithTeamListingError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListTeamMemberships()
+	_, err := r.ListTeamMemberships(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -382,14 +381,14 @@ func TestListTeamMemberships_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListTeamMemberships()
+	_, err := r.ListTeamMemberships(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
 func TestListTeamMemberships_WithoutOrganization(t *testing.T) {
 	r := githubRepository{cache: cache.New(1)}
 
-	teams, err := r.ListTeamMemberships()
+	teams, err := r.ListTeamMemberships(context.Background())
 	assert.Nil(t, err)
 	assert.Equal(t, []string{}, teams)
 }
@@ -514,14 +513,13 @@ func TestListTeamMemberships(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	memberships, err := r.ListTeamMemberships()
+	memberships, err := r.ListTeamMemberships(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -536,7 +534,7 @@ func TestListTeamMemberships(t *testing.T) {
 	}, memberships)
 
 	// Check that results were cached
-	cachedData, err := r.ListTeamMemberships()
+	cachedData, err := r.ListTeamMemberships(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, memberships, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListTeamMemberships"))
@@ -555,14 +553,14 @@ func TestListMembership_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListMembership()
+	_, err := r.ListMembership(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
 func TestListMembership_WithoutOrganization(t *testing.T) {
 	r := githubRepository{cache: cache.New(1)}
 
-	teams, err := r.ListMembership()
+	teams, err := r.ListMembership(context.Background())
 	assert.Nil(t, err)
 	assert.Equal(t, []string{}, teams)
 }
@@ -627,14 +625,13 @@ func TestListMembership(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	teams, err := r.ListMembership()
+	teams, err := r.ListMembership(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -647,7 +644,7 @@ func TestListMembership(t *testing.T) {
 	}, teams)
 
 	// Check that results were cached
-	cachedData, err := r.ListMembership()
+	cachedData, err := r.ListMembership(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, teams, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListMembership"))
@@ -692,7 +689,7 @@ func TestListBranchProtection_WithRepoListingError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListBranchProtection()
+	_, err := r.ListBranchProtection(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -736,7 +733,7 @@ func TestListBranchProtection_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListBranchProtection()
+	_, err := r.ListBranchProtection(context.Background())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -889,14 +886,13 @@ func TestListBranchProtection(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "my-organization",
 		},
 		cache: store,
 	}
 
-	teams, err := r.ListBranchProtection()
+	teams, err := r.ListBranchProtection(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -913,8 +909,262 @@ func TestListBranchProtection(t *testing.T) {
 	}, teams)
 
 	// Check that results were cached
-	cachedData, err := r.ListBranchProtection()
+	cachedData, err := r.ListBranchProtection(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, teams, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListBranchProtection"))
 }
+
+func newRESTTestRepository(config githubConfig, graphQLClient GithubGraphQLClient) *githubRepository {
+	store := cache.New(10)
+	store.Put("githubListRepositories", []string{"repo1", "repo2"})
+	return &githubRepository{
+		client:     graphQLClient,
+		restClient: newGithubRESTClient(&http.Client{}),
+		config:     config,
+		cache:      store,
+	}
+}
+
+func TestListActionsSecrets(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.github.com/repos/my-organization/repo1/actions/secrets",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"total_count": 2, "secrets": [{"name": "SECRET_1"}]}`)
+			resp.Header.Set("Link", `<https://api.github.com/repositories/1/actions/secrets?page=2>; rel="next"`)
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", "https://api.github.com/repositories/1/actions/secrets",
+		httpmock.NewStringResponder(200, `{"total_count": 2, "secrets": [{"name": "SECRET_2"}]}`))
+	httpmock.RegisterResponder("GET", "https://api.github.com/repos/my-organization/repo2/actions/secrets",
+		httpmock.NewStringResponder(200, `{"total_count": 0, "secrets": []}`))
+
+	r := newRESTTestRepository(githubConfig{Organization: "my-organization"}, nil)
+
+	secrets, err := r.ListActionsSecrets(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"repo1:SECRET_1", "repo1:SECRET_2"}, secrets)
+
+	// Check that results were cached
+	cachedData, err := r.ListActionsSecrets(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, secrets, cachedData)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestListActionsSecrets_WithError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.github.com/repos/my-user/repo1/actions/secrets",
+		httpmock.NewStringResponder(403, `{"message": "Resource not accessible by integration"}`))
+
+	r := newRESTTestRepository(githubConfig{Owner: "my-user"}, nil)
+
+	_, err := r.ListActionsSecrets(context.Background())
+	assert.Equal(t, &RESTError{StatusCode: 403, Message: "Resource not accessible by integration"}, err)
+}
+
+func TestListActionsOrganizationSecrets_WithoutOrganization(t *testing.T) {
+	r := newRESTTestRepository(githubConfig{Owner: "my-user"}, nil)
+	secrets, err := r.ListActionsOrganizationSecrets(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, secrets)
+}
+
+func TestListActionsOrganizationSecrets(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.github.com/orgs/my-organization/actions/secrets",
+		httpmock.NewStringResponder(200, `{"total_count": 2, "secrets": [{"name": "ORG_SECRET_1", "visibility": "all"}, {"name": "ORG_SECRET_2", "visibility": "private"}]}`))
+
+	r := newRESTTestRepository(githubConfig{Organization: "my-organization"}, nil)
+
+	secrets, err := r.ListActionsOrganizationSecrets(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ORG_SECRET_1", "ORG_SECRET_2"}, secrets)
+}
+
+func TestListRepositoryIdsFromREST(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		list     func(r *githubRepository, ctx context.Context) ([]string, error)
+		expected []string
+	}{
+		{
+			name:     "deploy keys",
+			endpoint: "keys",
+			list:     (*githubRepository).ListRepositoryDeployKeys,
+			expected: []string{"repo1:1", "repo1:2", "repo2:3"},
+		},
+		{
+			name:     "webhooks",
+			endpoint: "hooks",
+			list:     (*githubRepository).ListRepositoryWebhooks,
+			expected: []string{"1", "2", "3"},
+		},
+		{
+			name:     "rulesets",
+			endpoint: "rulesets",
+			list:     (*githubRepository).ListRepositoryRulesets,
+			expected: []string{"1", "2", "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", "https://api.github.com/repos/my-organization/repo1/"+tt.endpoint,
+				httpmock.NewStringResponder(200, `[{"id": 1}, {"id": 2}]`))
+			httpmock.RegisterResponder("GET", "https://api.github.com/repos/my-organization/repo2/"+tt.endpoint,
+				httpmock.NewStringResponder(200, `[{"id": 3}]`))
+
+			r := newRESTTestRepository(githubConfig{Organization: "my-organization"}, nil)
+
+			got, err := tt.list(r, context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestListRepositoryEnvironments(t *testing.T) {
+	mockedClient := mocks.GithubGraphQLClient{}
+
+	mockedClient.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query interface{}) bool {
+			q, ok := query.(*listRepositoryEnvironmentsQuery)
+			if !ok {
+				return false
+			}
+			q.Repository.Environments.Nodes = []struct {
+				Name string
+			}{
+				{
+					Name: "production",
+				},
+			}
+			q.Repository.Environments.PageInfo = pageInfo{
+				EndCursor:   "next",
+				HasNextPage: true,
+			}
+			return true
+		}),
+		map[string]interface{}{
+			"owner":  (githubv4.String)("my-organization"),
+			"name":   (githubv4.String)("repo1"),
+			"cursor": (*githubv4.String)(nil),
+		}).Return(nil).Once()
+
+	mockedClient.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query interface{}) bool {
+			q, ok := query.(*listRepositoryEnvironmentsQuery)
+			if !ok {
+				return false
+			}
+			q.Repository.Environments.Nodes = []struct {
+				Name string
+			}{
+				{
+					Name: "staging",
+				},
+			}
+			q.Repository.Environments.PageInfo = pageInfo{
+				HasNextPage: false,
+			}
+			return true
+		}),
+		map[string]interface{}{
+			"owner":  (githubv4.String)("my-organization"),
+			"name":   (githubv4.String)("repo1"),
+			"cursor": githubv4.NewString("next"),
+		}).Return(nil).Once()
+
+	mockedClient.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query interface{}) bool {
+			q, ok := query.(*listRepositoryEnvironmentsQuery)
+			if !ok {
+				return false
+			}
+			q.Repository.Environments.Nodes = []struct {
+				Name string
+			}{}
+			q.Repository.Environments.PageInfo = pageInfo{
+				HasNextPage: false,
+			}
+			return true
+		}),
+		map[string]interface{}{
+			"owner":  (githubv4.String)("my-organization"),
+			"name":   (githubv4.String)("repo2"),
+			"cursor": (*githubv4.String)(nil),
+		}).Return(nil).Once()
+
+	r := newRESTTestRepository(githubConfig{Organization: "my-organization"}, &mockedClient)
+
+	environments, err := r.ListRepositoryEnvironments(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"repo1:production", "repo1:staging"}, environments)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestListRepositoryCollaborators(t *testing.T) {
+	mockedClient := mocks.GithubGraphQLClient{}
+
+	mockedClient.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query interface{}) bool {
+			q, ok := query.(*listRepositoryCollaboratorsQuery)
+			if !ok {
+				return false
+			}
+			q.Repository.Collaborators.Nodes = []struct {
+				Login string
+			}{
+				{
+					Login: "user1",
+				},
+				{
+					Login: "user2",
+				},
+			}
+			q.Repository.Collaborators.PageInfo = pageInfo{
+				HasNextPage: false,
+			}
+			return true
+		}),
+		mock.Anything).Return(nil).Twice()
+
+	r := newRESTTestRepository(githubConfig{Owner: "my-user"}, &mockedClient)
+
+	collaborators, err := r.ListRepositoryCollaborators(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"repo1:user1", "repo1:user2", "repo2:user1", "repo2:user2"}, collaborators)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestListRepositoryCollaborators_WithError(t *testing.T) {
+	mockedClient := mocks.GithubGraphQLClient{}
+	expectedError := errors.New("test error from graphql")
+	mockedClient.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(expectedError)
+
+	r := newRESTTestRepository(githubConfig{Owner: "my-user"}, &mockedClient)
+
+	_, err := r.ListRepositoryCollaborators(context.Background())
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,95 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const githubRESTBaseURL = "https://api.github.com"
+
+// GithubRESTClient is used for data that is not exposed by the GraphQL API
+// (e.g. actions secrets, webhooks or deploy keys ids)
+type GithubRESTClient interface {
+	// Get decodes the JSON body of the given path (or absolute url) into v
+	// and returns the url of the next page, or an empty string on the last page
+	Get(ctx context.Context, path string, v interface{}) (string, error)
+}
+
+// RESTError is returned when the REST API answers with a non 200 status code
+type RESTError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *RESTError) Error() string {
+	return fmt.Sprintf("github API responded with status %d: %s", e.StatusCode, e.Message)
+}
+
+type githubRESTClient struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+func newGithubRESTClient(httpClient *http.Client) *githubRESTClient {
+	return &githubRESTClient{
+		httpClient: httpClient,
+		baseURL:    githubRESTBaseURL,
+	}
+}
+
+func (c *githubRESTClient) Get(ctx context.Context, path string, v interface{}) (string, error) {
+	url := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		url = c.baseURL + path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body := struct {
+			Message string `json:"message"`
+		}{}
+		raw, _ := io.ReadAll(resp.Body)
+		if err := json.Unmarshal(raw, &body); err != nil || body.Message == "" {
+			body.Message = strings.TrimSpace(string(raw))
+		}
+		return "", &RESTError{StatusCode: resp.StatusCode, Message: body.Message}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return "", err
+	}
+
+	return nextPageURL(resp.Header.Get("Link")), nil
+}
+
+// nextPageURL extracts the rel="next" url from a Link header, e.g.
+// <https://api.github.com/repositories/1/hooks?page=2>; rel="next", <https://api.github.com/repositories/1/hooks?page=5>; rel="last"
+func nextPageURL(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(strings.TrimSpace(part), ";")
+		if len(segments) < 2 {
+			continue
+		}
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(segments[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGithubRESTClient_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	tests := []struct {
+		name      string
+		responder httpmock.Responder
+		wantNext  string
+		wantBody  map[string]interface{}
+		wantErr   error
+	}{
+		{
+			name:      "last page",
+			responder: httpmock.NewStringResponder(200, `{"total_count": 1}`),
+			wantBody:  map[string]interface{}{"total_count": float64(1)},
+		},
+		{
+			name: "with next page",
+			responder: func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(200, `{"total_count": 2}`)
+				resp.Header.Set("Link", `<https://api.github.com/repositories/1/hooks?page=2>; rel="next", <https://api.github.com/repositories/1/hooks?page=5>; rel="last"`)
+				return resp, nil
+			},
+			wantNext: "https://api.github.com/repositories/1/hooks?page=2",
+			wantBody: map[string]interface{}{"total_count": float64(2)},
+		},
+		{
+			name:      "forbidden",
+			responder: httpmock.NewStringResponder(403, `{"message": "Resource not accessible by integration"}`),
+			wantErr:   &RESTError{StatusCode: 403, Message: "Resource not accessible by integration"},
+		},
+		{
+			name:      "server error without json body",
+			responder: httpmock.NewStringResponder(502, "Bad Gateway"),
+			wantErr:   &RESTError{StatusCode: 502, Message: "Bad Gateway"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.RegisterResponder("GET", "https://api.github.com/repos/owner/repo/hooks", tt.responder)
+
+			client := newGithubRESTClient(&http.Client{})
+			var body map[string]interface{}
+			next, err := client.Get(context.TODO(), "/repos/owner/repo/hooks", &body)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantNext, next)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantBody, body)
+			}
+		})
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	assert.Equal(t, "", nextPageURL(""))
+	assert.Equal(t, "", nextPageURL(`<https://api.github.com/repositories/1/hooks?page=1>; rel="prev"`))
+	assert.Equal(t,
+		"https://api.github.com/repositories/1/hooks?page=3",
+		nextPageURL(`<https://api.github.com/repositories/1/hooks?page=1>; rel="prev", <https://api.github.com/repositories/1/hooks?page=3>; rel="next"`),
+	)
+}
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -29,24 +30,22 @@ func TestScanGithubBranchProtection(t *testing.T) {
 		dirName        string
 		mocks          func(*github.MockGithubRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no branch protection",
 			dirName: "github_branch_protection_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListBranchProtection").Return([]string{}, nil)
+				client.On("ListBranchProtection", mock.Anything).Return([]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple branch protections",
 			dirName: "github_branch_protection_multiples",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListBranchProtection").Return([]string{
+				client.On("ListBranchProtection", mock.Anything).Return([]string{
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzI=", // "repo0:main"
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0Nzg=", // "repo0:toto"
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzQ=", // "repo1:main"
@@ -64,20 +63,18 @@ func TestScanGithubBranchProtection(t *testing.T) {
 				assert.Equal(t, "MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0Nzc=", got[5].ResourceId())
 				assert.Equal(t, githubres.GithubBranchProtectionResourceType, got[5].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list branch protections",
 			dirName: "github_branch_protection_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListBranchProtection").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListBranchProtection", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubBranchProtectionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubBranchProtectionResourceType, githubres.GithubBranchProtectionResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -110,7 +107,7 @@ func TestScanGithubBranchProtection(t *testing.T) {
 					t.Fatal(err)
 				}
 				provider.ShouldUpdate()
-				repo = github.NewGithubRepository(realProvider.GetConfig(), cache.New(0))
+				repo = github.NewGithubRepository(realProvider.GetConfig(), cache.New(0), nil, nil)
 			}
 
 			remoteLibrary.AddEnumerator(github.NewGithubBranchProtectionEnumerator(repo, factory))
@@ -119,11 +116,8 @@ func TestScanGithubBranchProtection(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			mockedRepo.AssertExpectations(tt)
@@ -0,0 +1,154 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/github"
+	"github.com/snyk/driftctl/enumeration/resource"
+	githubres "github.com/snyk/driftctl/enumeration/resource/github"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScanGithubRepositoryResources(t *testing.T) {
+	factory := terraform.NewTerraformResourceFactory()
+
+	enumerators := []struct {
+		resourceType string
+		method       string
+		ids          []string
+		new          func(github.GithubRepository) common.Enumerator
+	}{
+		{
+			resourceType: githubres.GithubActionsSecretResourceType,
+			method:       "ListActionsSecrets",
+			ids:          []string{"repo1:SECRET_1", "repo2:SECRET_2"},
+			new: func(repo github.GithubRepository) common.Enumerator {
+				return github.NewGithubActionsSecretEnumerator(repo, factory)
+			},
+		},
+		{
+			resourceType: githubres.GithubActionsOrganizationSecretResourceType,
+			method:       "ListActionsOrganizationSecrets",
+			ids:          []string{"ORG_SECRET_1", "ORG_SECRET_2"},
+			new: func(repo github.GithubRepository) common.Enumerator {
+				return github.NewGithubActionsOrganizationSecretEnumerator(repo, factory)
+			},
+		},
+		{
+			resourceType: githubres.GithubRepositoryEnvironmentResourceType,
+			method:       "ListRepositoryEnvironments",
+			ids:          []string{"repo1:production", "repo1:staging"},
+			new: func(repo github.GithubRepository) common.Enumerator {
+				return github.NewGithubRepositoryEnvironmentEnumerator(repo, factory)
+			},
+		},
+		{
+			resourceType: githubres.GithubRepositoryDeployKeyResourceType,
+			method:       "ListRepositoryDeployKeys",
+			ids:          []string{"repo1:65432", "repo2:65433"},
+			new: func(repo github.GithubRepository) common.Enumerator {
+				return github.NewGithubRepositoryDeployKeyEnumerator(repo, factory)
+			},
+		},
+		{
+			resourceType: githubres.GithubRepositoryWebhookResourceType,
+			method:       "ListRepositoryWebhooks",
+			ids:          []string{"387464012", "387464013"},
+			new: func(repo github.GithubRepository) common.Enumerator {
+				return github.NewGithubRepositoryWebhookEnumerator(repo, factory)
+			},
+		},
+		{
+			resourceType: githubres.GithubRepositoryCollaboratorResourceType,
+			method:       "ListRepositoryCollaborators",
+			ids:          []string{"repo1:user1", "repo2:user2"},
+			new: func(repo github.GithubRepository) common.Enumerator {
+				return github.NewGithubRepositoryCollaboratorEnumerator(repo, factory)
+			},
+		},
+		{
+			resourceType: githubres.GithubRepositoryRulesetResourceType,
+			method:       "ListRepositoryRulesets",
+			ids:          []string{"1234", "5678"},
+			new: func(repo github.GithubRepository) common.Enumerator {
+				return github.NewGithubRepositoryRulesetEnumerator(repo, factory)
+			},
+		},
+	}
+
+	for _, e := range enumerators {
+		accessDenied := errors.New("Your token has not been granted the required scopes to execute this query.")
+		cases := []struct {
+			test           string
+			mocks          func(*github.MockGithubRepository, *mocks.AlerterInterface)
+			assertExpected func(*testing.T, []*resource.Resource)
+		}{
+			{
+				test: "empty",
+				mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
+					client.On(e.method, mock.Anything).Return([]string{}, nil)
+				},
+				assertExpected: func(t *testing.T, got []*resource.Resource) {
+					assert.Len(t, got, 0)
+				},
+			},
+			{
+				test: "multiple",
+				mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
+					client.On(e.method, mock.Anything).Return(e.ids, nil)
+				},
+				assertExpected: func(t *testing.T, got []*resource.Resource) {
+					assert.Len(t, got, len(e.ids))
+					for i, res := range got {
+						assert.Equal(t, e.ids[i], res.ResourceId())
+						assert.Equal(t, e.resourceType, res.ResourceType())
+					}
+				},
+			},
+			{
+				test: "access denied",
+				mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
+					client.On(e.method, mock.Anything).Return(nil, accessDenied)
+
+					alerter.On("SendAlert", e.resourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(accessDenied, e.resourceType, e.resourceType), alerts.EnumerationPhase)).Return()
+				},
+				assertExpected: func(t *testing.T, got []*resource.Resource) {
+					assert.Len(t, got, 0)
+				},
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(e.resourceType+" "+c.test, func(tt *testing.T) {
+				remoteLibrary := common.NewRemoteLibrary()
+
+				alerter := &mocks.AlerterInterface{}
+				mockedRepo := github.MockGithubRepository{}
+				c.mocks(&mockedRepo, alerter)
+
+				remoteLibrary.AddEnumerator(e.new(&mockedRepo))
+
+				testFilter := &enumeration.MockFilter{}
+				testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+				s := NewScanner(remoteLibrary, alerter, testFilter)
+				got, err := s.Resources(context.Background())
+				assert.Nil(tt, err)
+
+				c.assertExpected(tt, got)
+				mockedRepo.AssertExpectations(tt)
+				alerter.AssertExpectations(tt)
+			})
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -29,24 +30,22 @@ func TestScanGithubRepository(t *testing.T) {
 		dirName        string
 		mocks          func(*github.MockGithubRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no github repos",
 			dirName: "github_repository_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return([]string{}, nil)
+				client.On("ListRepositories", mock.Anything).Return([]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple github repos Table",
 			dirName: "github_repository_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return([]string{
+				client.On("ListRepositories", mock.Anything).Return([]string{
 					"driftctl",
 					"driftctl-demos",
 				}, nil)
@@ -60,20 +59,18 @@ func TestScanGithubRepository(t *testing.T) {
 				assert.Equal(t, "driftctl-demos", got[1].ResourceId())
 				assert.Equal(t, githubres.GithubRepositoryResourceType, got[1].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list repositories",
 			dirName: "github_repository_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListRepositories", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubRepositoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubRepositoryResourceType, githubres.GithubRepositoryResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -106,7 +103,7 @@ func TestScanGithubRepository(t *testing.T) {
 					t.Fatal(err)
 				}
 				provider.ShouldUpdate()
-				repo = github.NewGithubRepository(realProvider.GetConfig(), cache.New(0))
+				repo = github.NewGithubRepository(realProvider.GetConfig(), cache.New(0), nil, nil)
 			}
 
 			remoteLibrary.AddEnumerator(github.NewGithubRepositoryEnumerator(repo, factory))
@@ -115,11 +112,8 @@ func TestScanGithubRepository(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			mockedRepo.AssertExpectations(tt)
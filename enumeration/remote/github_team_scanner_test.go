@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -29,24 +30,22 @@ func TestScanGithubTeam(t *testing.T) {
 		dirName        string
 		mocks          func(*github.MockGithubRepository, *mocks.AlerterInterface)
 		assertExpected func(*testing.T, []*resource.Resource)
-		err            error
 	}{
 		{
 			test:    "no github teams",
 			dirName: "github_teams_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return([]github.Team{}, nil)
+				client.On("ListTeams", mock.Anything).Return([]github.Team{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 		{
 			test:    "Multiple github teams with parent",
 			dirName: "github_teams_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return([]github.Team{
+				client.On("ListTeams", mock.Anything).Return([]github.Team{
 					{DatabaseId: 4556811}, // github_team.team1
 					{DatabaseId: 4556812}, // github_team.team2
 					{DatabaseId: 4556814}, // github_team.with_parent
@@ -64,20 +63,18 @@ func TestScanGithubTeam(t *testing.T) {
 				assert.Equal(t, "4556814", got[2].ResourceId())
 				assert.Equal(t, githubres.GithubTeamResourceType, got[2].ResourceType())
 			},
-			err: nil,
 		},
 		{
 			test:    "cannot list teams",
 			dirName: "github_teams_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListTeams", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubTeamResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubTeamResourceType, githubres.GithubTeamResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			err: nil,
 		},
 	}
 
@@ -110,7 +107,7 @@ func TestScanGithubTeam(t *testing.T) {
 					t.Fatal(err)
 				}
 				provider.ShouldUpdate()
-				repo = github.NewGithubRepository(realProvider.GetConfig(), cache.New(0))
+				repo = github.NewGithubRepository(realProvider.GetConfig(), cache.New(0), nil, nil)
 			}
 
 			remoteLibrary.AddEnumerator(github.NewGithubTeamEnumerator(repo, factory))
@@ -119,11 +116,8 @@ func TestScanGithubTeam(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			c.assertExpected(tt, got)
 			mockedRepo.AssertExpectations(tt)
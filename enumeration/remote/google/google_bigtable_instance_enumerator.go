@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
@@ -24,8 +26,8 @@ func (e *GoogleBigTableInstanceEnumerator) SupportedType() resource.ResourceType
 	return google.GoogleBigTableInstanceResourceType
 }
 
-func (e *GoogleBigTableInstanceEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllBigtableInstances()
+func (e *GoogleBigTableInstanceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllBigtableInstances(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
@@ -24,8 +26,8 @@ func (e *GoogleCloudFunctionsFunctionEnumerator) SupportedType() resource.Resour
 	return google.GoogleCloudFunctionsFunctionResourceType
 }
 
-func (e *GoogleCloudFunctionsFunctionEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllFunctions()
+func (e *GoogleCloudFunctionsFunctionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllFunctions(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeAddressEnumerator) SupportedType() resource.ResourceType {
 	return google.GoogleComputeAddressResourceType
 }
 
-func (e *GoogleComputeAddressEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllAddresses()
+func (e *GoogleComputeAddressEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllAddresses(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"strings"
@@ -26,8 +28,8 @@ func (e *GoogleComputeFirewallEnumerator) SupportedType() resource.ResourceType
 	return google.GoogleComputeFirewallResourceType
 }
 
-func (e *GoogleComputeFirewallEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllFirewalls()
+func (e *GoogleComputeFirewallEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllFirewalls(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
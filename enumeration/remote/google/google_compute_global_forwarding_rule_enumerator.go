@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeGlobalForwardingRuleEnumerator) SupportedType() resource.R
 	return google.GoogleComputeGlobalForwardingRuleResourceType
 }
 
-func (e *GoogleComputeGlobalForwardingRuleEnumerator) Enumerate() ([]*resource.Resource, error) {
-	globalForwardingRules, err := e.repository.SearchAllGlobalForwardingRules()
+func (e *GoogleComputeGlobalForwardingRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	globalForwardingRules, err := e.repository.SearchAllGlobalForwardingRules(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
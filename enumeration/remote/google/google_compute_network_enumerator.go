@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeNetworkEnumerator) SupportedType() resource.ResourceType {
 	return google.GoogleComputeNetworkResourceType
 }
 
-func (e *GoogleComputeNetworkEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllNetworks()
+func (e *GoogleComputeNetworkEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllNetworks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
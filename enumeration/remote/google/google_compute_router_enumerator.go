@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeRouterEnumerator) SupportedType() resource.ResourceType {
 	return google.GoogleComputeRouterResourceType
 }
 
-func (e *GoogleComputeRouterEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllRouters()
+func (e *GoogleComputeRouterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllRouters(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,49 @@
+package google
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+type GoogleContainerClusterEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleContainerClusterEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleContainerClusterEnumerator {
+	return &GoogleContainerClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleContainerClusterEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleContainerClusterResourceType
+}
+
+func (e *GoogleContainerClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllContainerClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				trimContainerResourceName(res.GetName()),
+				map[string]interface{}{
+					"name": res.GetDisplayName(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
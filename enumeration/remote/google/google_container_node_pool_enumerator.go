@@ -0,0 +1,49 @@
+package google
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+type GoogleContainerNodePoolEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleContainerNodePoolEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleContainerNodePoolEnumerator {
+	return &GoogleContainerNodePoolEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleContainerNodePoolEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleContainerNodePoolResourceType
+}
+
+func (e *GoogleContainerNodePoolEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllContainerNodePools(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				trimContainerResourceName(res.GetName()),
+				map[string]interface{}{
+					"name": res.GetDisplayName(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	"fmt"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
@@ -25,10 +27,10 @@ func (e *GoogleProjectIamMemberEnumerator) SupportedType() resource.ResourceType
 	return google.GoogleProjectIamMemberResourceType
 }
 
-func (e *GoogleProjectIamMemberEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *GoogleProjectIamMemberEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0)
 
-	bindingsByProject, err := e.repository.ListProjectsBindings()
+	bindingsByProject, err := e.repository.ListProjectsBindings(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,49 @@
+package google
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+type GooglePubsubSubscriptionEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGooglePubsubSubscriptionEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GooglePubsubSubscriptionEnumerator {
+	return &GooglePubsubSubscriptionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GooglePubsubSubscriptionEnumerator) SupportedType() resource.ResourceType {
+	return google.GooglePubsubSubscriptionResourceType
+}
+
+func (e *GooglePubsubSubscriptionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllPubsubSubscriptions(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				trimResourceName(res.GetName()),
+				map[string]interface{}{
+					"name": res.GetDisplayName(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,57 @@
+package google
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+type GoogleServiceAccountEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleServiceAccountEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleServiceAccountEnumerator {
+	return &GoogleServiceAccountEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleServiceAccountEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleServiceAccountResourceType
+}
+
+func (e *GoogleServiceAccountEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllServiceAccounts(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		// Asset names reference the account unique ID, Terraform uses the IAM name based on the email
+		fields := res.GetResource().GetData().GetFields()
+		name, exist := fields["name"]
+		if !exist || name.GetStringValue() == "" {
+			logrus.WithField("name", res.GetName()).Warn("Unable to retrieve resource name")
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				name.GetStringValue(),
+				map[string]interface{}{
+					"email": fields["email"].GetStringValue(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
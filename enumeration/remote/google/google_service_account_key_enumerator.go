@@ -0,0 +1,58 @@
+package google
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+type GoogleServiceAccountKeyEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleServiceAccountKeyEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleServiceAccountKeyEnumerator {
+	return &GoogleServiceAccountKeyEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleServiceAccountKeyEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleServiceAccountKeyResourceType
+}
+
+func (e *GoogleServiceAccountKeyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllServiceAccountKeys(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		fields := res.GetResource().GetData().GetFields()
+		// System managed keys are rotated by Google and cannot be managed with Terraform
+		if keyType, exist := fields["keyType"]; exist && keyType.GetStringValue() == "SYSTEM_MANAGED" {
+			continue
+		}
+		name, exist := fields["name"]
+		if !exist || name.GetStringValue() == "" {
+			logrus.WithField("name", res.GetName()).Warn("Unable to retrieve resource name")
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				name.GetStringValue(),
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
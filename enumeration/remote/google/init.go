@@ -6,6 +6,8 @@ import (
 	"github.com/snyk/driftctl/enumeration"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
@@ -15,9 +17,13 @@ import (
 	"cloud.google.com/go/storage"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, rateLimits *ratelimit.Controller, repositoryCache cache.Cache) error {
 
 	provider, err := NewGCPTerraformProvider(version, progress, configDir)
 	if err != nil {
@@ -29,15 +35,19 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 		return err
 	}
 
+	provider.SetParallelism(rateLimits.Parallelism())
 	err = provider.Init()
 	if err != nil {
 		return err
 	}
 
-	repositoryCache := cache.New(100)
-
 	ctx := context.Background()
-	assetClient, err := asset.NewClient(ctx)
+	// Storage and Cloud Resource Manager use HTTP clients built with their own credentials, only
+	// Cloud Asset API calls are recorded
+	assetClient, err := asset.NewClient(ctx, option.WithGRPCDialOption(grpc.WithChainUnaryInterceptor(
+		recordAPICall,
+		limitRequests(rateLimits.Limiter("cloudasset")),
+	)))
 	if err != nil {
 		return err
 	}
@@ -96,6 +106,36 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	remoteLibrary.AddEnumerator(NewGoogleComputeInstanceGroupManagerEnumerator(assetRepository, factory))
 	remoteLibrary.AddEnumerator(NewGoogleComputeGlobalForwardingRuleEnumerator(assetRepository, factory))
 	remoteLibrary.AddEnumerator(NewGoogleComputeSslCertificateEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGoogleContainerClusterEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGoogleContainerNodePoolEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGooglePubsubTopicEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGooglePubsubSubscriptionEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGoogleServiceAccountEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGoogleServiceAccountKeyEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGoogleKMSKeyRingEnumerator(assetRepository, factory))
+	remoteLibrary.AddEnumerator(NewGoogleKMSCryptoKeyEnumerator(assetRepository, factory))
 
 	return nil
 }
+
+func recordAPICall(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	profile.RecordAPICall(ctx, 0)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// limitRequests makes every gRPC call wait for the limiter and slows down when the API answers
+// with an exhausted quota
+func limitRequests(limiter *ratelimit.Limiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if status.Code(err) == codes.ResourceExhausted {
+			limiter.Throttled()
+		} else if err == nil {
+			limiter.Succeeded()
+		}
+		return err
+	}
+}
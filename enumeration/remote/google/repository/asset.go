@@ -6,6 +6,7 @@ import (
 
 	asset "cloud.google.com/go/asset/apiv1"
 	assetpb "cloud.google.com/go/asset/apiv1/assetpb"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/google/config"
 	"google.golang.org/api/iterator"
@@ -38,34 +39,50 @@ const (
 	instanceGroupManagerAssetType        = "compute.googleapis.com/InstanceGroupManager"
 	computeGlobalForwardingRuleAssetType = "compute.googleapis.com/GlobalForwardingRule"
 	computeSslCertificateAssetType       = "compute.googleapis.com/SslCertificate"
+	containerClusterAssetType            = "container.googleapis.com/Cluster"
+	containerNodePoolAssetType           = "container.googleapis.com/NodePool"
+	pubsubTopicAssetType                 = "pubsub.googleapis.com/Topic"
+	pubsubSubscriptionAssetType          = "pubsub.googleapis.com/Subscription"
+	iamServiceAccountAssetType           = "iam.googleapis.com/ServiceAccount"
+	iamServiceAccountKeyAssetType        = "iam.googleapis.com/ServiceAccountKey"
+	kmsKeyRingAssetType                  = "cloudkms.googleapis.com/KeyRing"
+	kmsCryptoKeyAssetType                = "cloudkms.googleapis.com/CryptoKey"
 )
 
 type AssetRepository interface {
-	SearchAllBuckets() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllFirewalls() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllRouters() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllInstances() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllNetworks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllDisks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllImages() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllDNSManagedZones() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllInstanceGroups() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllDatasets() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllTables() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllAddresses() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllGlobalAddresses() ([]*assetpb.Asset, error)
-	SearchAllFunctions() ([]*assetpb.Asset, error)
-	SearchAllSubnetworks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllBigtableInstances() ([]*assetpb.Asset, error)
-	SearchAllBigtableTables() ([]*assetpb.Asset, error)
-	SearchAllSQLDatabaseInstances() ([]*assetpb.Asset, error)
-	SearchAllHealthChecks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllCloudRunServices() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllNodeGroups() ([]*assetpb.Asset, error)
-	SearchAllForwardingRules() ([]*assetpb.Asset, error)
-	SearchAllInstanceGroupManagers() ([]*assetpb.Asset, error)
-	SearchAllGlobalForwardingRules() ([]*assetpb.Asset, error)
-	SearchAllSslCertificates() ([]*assetpb.Asset, error)
+	SearchAllBuckets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllFirewalls(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllRouters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllInstances(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllNetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllDisks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllImages(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllDNSManagedZones(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllInstanceGroups(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllDatasets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllTables(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllAddresses(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllGlobalAddresses(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllFunctions(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllSubnetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllBigtableInstances(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllBigtableTables(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllSQLDatabaseInstances(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllHealthChecks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllCloudRunServices(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllNodeGroups(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllForwardingRules(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllInstanceGroupManagers(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllGlobalForwardingRules(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllSslCertificates(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllContainerClusters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllContainerNodePools(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllPubsubTopics(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllPubsubSubscriptions(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllServiceAccounts(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllServiceAccountKeys(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllKMSKeyRings(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllKMSCryptoKeys(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
 }
 
 type assetRepository struct {
@@ -82,7 +99,7 @@ func NewAssetRepository(client *asset.Client, config config.GCPTerraformConfig,
 	}
 }
 
-func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
+func (s assetRepository) listAllResources(ctx context.Context, ty string) ([]*assetpb.Asset, error) {
 	req := &assetpb.ListAssetsRequest{
 		Parent:      fmt.Sprintf("projects/%s", s.config.Project),
 		ContentType: assetpb.ContentType_RESOURCE,
@@ -97,6 +114,8 @@ func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 			instanceGroupManagerAssetType,
 			computeGlobalForwardingRuleAssetType,
 			computeSslCertificateAssetType,
+			iamServiceAccountAssetType,
+			iamServiceAccountKeyAssetType,
 		},
 	}
 	var results []*assetpb.Asset
@@ -109,7 +128,7 @@ func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 	}
 
 	if results == nil {
-		it := s.client.ListAssets(context.Background(), req)
+		it := s.client.ListAssets(ctx, req)
 		for {
 			resource, err := it.Next()
 			if err == iterator.Done {
@@ -133,7 +152,7 @@ func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 	return filteredResults, nil
 }
 
-func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearchResult, error) {
+func (s assetRepository) searchAllResources(ctx context.Context, ty string) ([]*assetpb.ResourceSearchResult, error) {
 	req := &assetpb.SearchAllResourcesRequest{
 		Scope: fmt.Sprintf("projects/%s", s.config.Project),
 		AssetTypes: []string{
@@ -152,6 +171,12 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 			computeImageAssetType,
 			healthCheckAssetType,
 			cloudRunServiceAssetType,
+			containerClusterAssetType,
+			containerNodePoolAssetType,
+			pubsubTopicAssetType,
+			pubsubSubscriptionAssetType,
+			kmsKeyRingAssetType,
+			kmsCryptoKeyAssetType,
 		},
 	}
 	var results []*assetpb.ResourceSearchResult
@@ -164,7 +189,7 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 	}
 
 	if results == nil {
-		it := s.client.SearchAllResources(context.Background(), req)
+		it := s.client.SearchAllResources(ctx, req)
 		for {
 			resource, err := it.Next()
 			if err == iterator.Done {
@@ -188,102 +213,233 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 	return filteredResults, nil
 }
 
-func (s assetRepository) SearchAllBuckets() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(storageBucketAssetType)
+func (s assetRepository) SearchAllBuckets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllBuckets")
+	defer done()
+
+	return s.searchAllResources(ctx, storageBucketAssetType)
 }
 
-func (s assetRepository) SearchAllFirewalls() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeFirewallAssetType)
+func (s assetRepository) SearchAllFirewalls(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllFirewalls")
+	defer done()
+
+	return s.searchAllResources(ctx, computeFirewallAssetType)
 }
 
-func (s assetRepository) SearchAllRouters() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeRouterAssetType)
+func (s assetRepository) SearchAllRouters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllRouters")
+	defer done()
+
+	return s.searchAllResources(ctx, computeRouterAssetType)
 }
 
-func (s assetRepository) SearchAllInstances() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeInstanceAssetType)
+func (s assetRepository) SearchAllInstances(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllInstances")
+	defer done()
+
+	return s.searchAllResources(ctx, computeInstanceAssetType)
 }
 
-func (s assetRepository) SearchAllNetworks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeNetworkAssetType)
+func (s assetRepository) SearchAllNetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllNetworks")
+	defer done()
+
+	return s.searchAllResources(ctx, computeNetworkAssetType)
 }
 
-func (s assetRepository) SearchAllDNSManagedZones() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(dnsManagedZoneAssetType)
+func (s assetRepository) SearchAllDNSManagedZones(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllDNSManagedZones")
+	defer done()
+
+	return s.searchAllResources(ctx, dnsManagedZoneAssetType)
 }
 
-func (s assetRepository) SearchAllInstanceGroups() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeInstanceGroupAssetType)
+func (s assetRepository) SearchAllInstanceGroups(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllInstanceGroups")
+	defer done()
+
+	return s.searchAllResources(ctx, computeInstanceGroupAssetType)
 }
 
-func (s assetRepository) SearchAllDatasets() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(bigqueryDatasetAssetType)
+func (s assetRepository) SearchAllDatasets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllDatasets")
+	defer done()
+
+	return s.searchAllResources(ctx, bigqueryDatasetAssetType)
 }
 
-func (s assetRepository) SearchAllTables() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(bigqueryTableAssetType)
+func (s assetRepository) SearchAllTables(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllTables")
+	defer done()
+
+	return s.searchAllResources(ctx, bigqueryTableAssetType)
 }
 
-func (s assetRepository) SearchAllAddresses() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeAddressAssetType)
+func (s assetRepository) SearchAllAddresses(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllAddresses")
+	defer done()
+
+	return s.searchAllResources(ctx, computeAddressAssetType)
 }
 
-func (s assetRepository) SearchAllGlobalAddresses() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeGlobalAddressAssetType)
+func (s assetRepository) SearchAllGlobalAddresses(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllGlobalAddresses")
+	defer done()
+
+	return s.listAllResources(ctx, computeGlobalAddressAssetType)
 }
 
-func (s assetRepository) SearchAllFunctions() ([]*assetpb.Asset, error) {
-	return s.listAllResources(cloudFunctionsFunction)
+func (s assetRepository) SearchAllFunctions(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllFunctions")
+	defer done()
+
+	return s.listAllResources(ctx, cloudFunctionsFunction)
 }
 
-func (s assetRepository) SearchAllSubnetworks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeSubnetworkAssetType)
+func (s assetRepository) SearchAllSubnetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllSubnetworks")
+	defer done()
+
+	return s.searchAllResources(ctx, computeSubnetworkAssetType)
 }
 
-func (s assetRepository) SearchAllDisks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeDiskAssetType)
+func (s assetRepository) SearchAllDisks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllDisks")
+	defer done()
+
+	return s.searchAllResources(ctx, computeDiskAssetType)
 }
 
-func (s assetRepository) SearchAllImages() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeImageAssetType)
+func (s assetRepository) SearchAllImages(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllImages")
+	defer done()
+
+	return s.searchAllResources(ctx, computeImageAssetType)
 }
 
-func (s assetRepository) SearchAllBigtableInstances() ([]*assetpb.Asset, error) {
-	return s.listAllResources(bigtableInstanceAssetType)
+func (s assetRepository) SearchAllBigtableInstances(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllBigtableInstances")
+	defer done()
+
+	return s.listAllResources(ctx, bigtableInstanceAssetType)
 }
 
-func (s assetRepository) SearchAllBigtableTables() ([]*assetpb.Asset, error) {
-	return s.listAllResources(bigtableTableAssetType)
+func (s assetRepository) SearchAllBigtableTables(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllBigtableTables")
+	defer done()
+
+	return s.listAllResources(ctx, bigtableTableAssetType)
 }
 
-func (s assetRepository) SearchAllSQLDatabaseInstances() ([]*assetpb.Asset, error) {
-	return s.listAllResources(sqlDatabaseInstanceAssetType)
+func (s assetRepository) SearchAllSQLDatabaseInstances(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllSQLDatabaseInstances")
+	defer done()
+
+	return s.listAllResources(ctx, sqlDatabaseInstanceAssetType)
 }
 
-func (s assetRepository) SearchAllHealthChecks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(healthCheckAssetType)
+func (s assetRepository) SearchAllHealthChecks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllHealthChecks")
+	defer done()
+
+	return s.searchAllResources(ctx, healthCheckAssetType)
 }
 
-func (s assetRepository) SearchAllCloudRunServices() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(cloudRunServiceAssetType)
+func (s assetRepository) SearchAllCloudRunServices(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllCloudRunServices")
+	defer done()
+
+	return s.searchAllResources(ctx, cloudRunServiceAssetType)
 }
 
-func (s assetRepository) SearchAllNodeGroups() ([]*assetpb.Asset, error) {
-	return s.listAllResources(nodeGroupAssetType)
+func (s assetRepository) SearchAllNodeGroups(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllNodeGroups")
+	defer done()
+
+	return s.listAllResources(ctx, nodeGroupAssetType)
 }
 
-func (s assetRepository) SearchAllForwardingRules() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeForwardingRuleAssetType)
+func (s assetRepository) SearchAllForwardingRules(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllForwardingRules")
+	defer done()
+
+	return s.listAllResources(ctx, computeForwardingRuleAssetType)
 }
 
-func (s assetRepository) SearchAllInstanceGroupManagers() ([]*assetpb.Asset, error) {
-	return s.listAllResources(instanceGroupManagerAssetType)
+func (s assetRepository) SearchAllInstanceGroupManagers(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllInstanceGroupManagers")
+	defer done()
+
+	return s.listAllResources(ctx, instanceGroupManagerAssetType)
 }
 
-func (s assetRepository) SearchAllGlobalForwardingRules() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeGlobalForwardingRuleAssetType)
+func (s assetRepository) SearchAllGlobalForwardingRules(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllGlobalForwardingRules")
+	defer done()
+
+	return s.listAllResources(ctx, computeGlobalForwardingRuleAssetType)
 }
 
-func (s assetRepository) SearchAllSslCertificates() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeSslCertificateAssetType)
+func (s assetRepository) SearchAllSslCertificates(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllSslCertificates")
+	defer done()
+
+	return s.listAllResources(ctx, computeSslCertificateAssetType)
+}
+
+func (s assetRepository) SearchAllContainerClusters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllContainerClusters")
+	defer done()
+
+	return s.searchAllResources(ctx, containerClusterAssetType)
+}
+
+func (s assetRepository) SearchAllContainerNodePools(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllContainerNodePools")
+	defer done()
+
+	return s.searchAllResources(ctx, containerNodePoolAssetType)
+}
+
+func (s assetRepository) SearchAllPubsubTopics(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllPubsubTopics")
+	defer done()
+
+	return s.searchAllResources(ctx, pubsubTopicAssetType)
+}
+
+func (s assetRepository) SearchAllPubsubSubscriptions(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllPubsubSubscriptions")
+	defer done()
+
+	return s.searchAllResources(ctx, pubsubSubscriptionAssetType)
+}
+
+func (s assetRepository) SearchAllServiceAccounts(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllServiceAccounts")
+	defer done()
+
+	return s.listAllResources(ctx, iamServiceAccountAssetType)
+}
+
+func (s assetRepository) SearchAllServiceAccountKeys(ctx context.Context) ([]*assetpb.Asset, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllServiceAccountKeys")
+	defer done()
+
+	return s.listAllResources(ctx, iamServiceAccountKeyAssetType)
+}
+
+func (s assetRepository) SearchAllKMSKeyRings(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllKMSKeyRings")
+	defer done()
+
+	return s.searchAllResources(ctx, kmsKeyRingAssetType)
+}
+
+func (s assetRepository) SearchAllKMSCryptoKeys(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ctx, done := profile.Method(ctx, "AssetRepository.SearchAllKMSCryptoKeys")
+	defer done()
+
+	return s.searchAllResources(ctx, kmsCryptoKeyAssetType)
 }
@@ -1,13 +1,16 @@
 package repository
 
 import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/google/config"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 type CloudResourceManagerRepository interface {
-	ListProjectsBindings() (map[string]map[string][]string, error)
+	ListProjectsBindings(ctx context.Context) (map[string]map[string][]string, error)
 }
 
 type cloudResourceManagerRepository struct {
@@ -24,13 +27,16 @@ func NewCloudResourceManagerRepository(service *cloudresourcemanager.Service, co
 	}
 }
 
-func (s *cloudResourceManagerRepository) ListProjectsBindings() (map[string]map[string][]string, error) {
+func (s *cloudResourceManagerRepository) ListProjectsBindings(ctx context.Context) (map[string]map[string][]string, error) {
+	ctx, done := profile.Method(ctx, "CloudResourceManagerRepository.ListProjectsBindings")
+	defer done()
+
 	if cachedResults := s.cache.Get("ListProjectsBindings"); cachedResults != nil {
 		return cachedResults.(map[string]map[string][]string), nil
 	}
 
 	request := new(cloudresourcemanager.GetIamPolicyRequest)
-	policy, err := s.service.Projects.GetIamPolicy(s.config.Project, request).Do()
+	policy, err := s.service.Projects.GetIamPolicy(s.config.Project, request).Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -3,8 +3,10 @@
 package repository
 
 import (
+	context "context"
+
+	assetpb "cloud.google.com/go/asset/apiv1/assetpb"
 	mock "github.com/stretchr/testify/mock"
-	asset "google.golang.org/genproto/googleapis/cloud/asset/v1"
 )
 
 // MockAssetRepository is an autogenerated mock type for the AssetRepository type
@@ -12,25 +14,233 @@ type MockAssetRepository struct {
 	mock.Mock
 }
 
-// SearchAllAddresses provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllAddresses() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllAddresses provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllAddresses(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.ResourceSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllBigtableInstances provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllBigtableInstances(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllBigtableTables provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllBigtableTables(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllBuckets provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllBuckets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.ResourceSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllCloudRunServices provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllCloudRunServices(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.ResourceSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllContainerClusters provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllContainerClusters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.ResourceSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllContainerNodePools provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllContainerNodePools(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.ResourceSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllDNSManagedZones provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllDNSManagedZones(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*assetpb.ResourceSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllDatasets provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllDatasets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +248,25 @@ func (_m *MockAssetRepository) SearchAllAddresses() ([]*asset.ResourceSearchResu
 	return r0, r1
 }
 
-// SearchAllBigtableInstances provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllBigtableInstances() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllDisks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllDisks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +274,25 @@ func (_m *MockAssetRepository) SearchAllBigtableInstances() ([]*asset.Asset, err
 	return r0, r1
 }
 
-// SearchAllBigtableTables provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllBigtableTables() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllFirewalls provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllFirewalls(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,25 +300,25 @@ func (_m *MockAssetRepository) SearchAllBigtableTables() ([]*asset.Asset, error)
 	return r0, r1
 }
 
-// SearchAllBuckets provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllBuckets() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllForwardingRules provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllForwardingRules(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,25 +326,25 @@ func (_m *MockAssetRepository) SearchAllBuckets() ([]*asset.ResourceSearchResult
 	return r0, r1
 }
 
-// SearchAllCloudRunServices provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllCloudRunServices() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllFunctions provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllFunctions(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -142,25 +352,25 @@ func (_m *MockAssetRepository) SearchAllCloudRunServices() ([]*asset.ResourceSea
 	return r0, r1
 }
 
-// SearchAllDNSManagedZones provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllDNSManagedZones() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllGlobalAddresses provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllGlobalAddresses(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,25 +378,25 @@ func (_m *MockAssetRepository) SearchAllDNSManagedZones() ([]*asset.ResourceSear
 	return r0, r1
 }
 
-// SearchAllDatasets provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllDatasets() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllGlobalForwardingRules provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllGlobalForwardingRules(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -194,25 +404,25 @@ func (_m *MockAssetRepository) SearchAllDatasets() ([]*asset.ResourceSearchResul
 	return r0, r1
 }
 
-// SearchAllDisks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllDisks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllHealthChecks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllHealthChecks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,25 +430,25 @@ func (_m *MockAssetRepository) SearchAllDisks() ([]*asset.ResourceSearchResult,
 	return r0, r1
 }
 
-// SearchAllFirewalls provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllFirewalls() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllImages provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllImages(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -246,25 +456,25 @@ func (_m *MockAssetRepository) SearchAllFirewalls() ([]*asset.ResourceSearchResu
 	return r0, r1
 }
 
-// SearchAllForwardingRules provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllForwardingRules() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllInstanceGroupManagers provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllInstanceGroupManagers(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -272,25 +482,25 @@ func (_m *MockAssetRepository) SearchAllForwardingRules() ([]*asset.Asset, error
 	return r0, r1
 }
 
-// SearchAllFunctions provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllFunctions() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllInstanceGroups provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllInstanceGroups(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -298,25 +508,25 @@ func (_m *MockAssetRepository) SearchAllFunctions() ([]*asset.Asset, error) {
 	return r0, r1
 }
 
-// SearchAllGlobalAddresses provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllGlobalAddresses() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllInstances provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllInstances(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -324,25 +534,25 @@ func (_m *MockAssetRepository) SearchAllGlobalAddresses() ([]*asset.Asset, error
 	return r0, r1
 }
 
-// SearchAllGlobalForwardingRules provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllGlobalForwardingRules() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllKMSCryptoKeys provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllKMSCryptoKeys(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -350,25 +560,25 @@ func (_m *MockAssetRepository) SearchAllGlobalForwardingRules() ([]*asset.Asset,
 	return r0, r1
 }
 
-// SearchAllHealthChecks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllHealthChecks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllKMSKeyRings provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllKMSKeyRings(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -376,25 +586,25 @@ func (_m *MockAssetRepository) SearchAllHealthChecks() ([]*asset.ResourceSearchR
 	return r0, r1
 }
 
-// SearchAllImages provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllImages() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllNetworks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllNetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -402,25 +612,25 @@ func (_m *MockAssetRepository) SearchAllImages() ([]*asset.ResourceSearchResult,
 	return r0, r1
 }
 
-// SearchAllInstanceGroupManagers provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllInstanceGroupManagers() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllNodeGroups provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllNodeGroups(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -428,25 +638,25 @@ func (_m *MockAssetRepository) SearchAllInstanceGroupManagers() ([]*asset.Asset,
 	return r0, r1
 }
 
-// SearchAllInstanceGroups provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllInstanceGroups() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllPubsubSubscriptions provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllPubsubSubscriptions(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -454,25 +664,25 @@ func (_m *MockAssetRepository) SearchAllInstanceGroups() ([]*asset.ResourceSearc
 	return r0, r1
 }
 
-// SearchAllInstances provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllInstances() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllPubsubTopics provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllPubsubTopics(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -480,25 +690,25 @@ func (_m *MockAssetRepository) SearchAllInstances() ([]*asset.ResourceSearchResu
 	return r0, r1
 }
 
-// SearchAllNetworks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllNetworks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllRouters provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllRouters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -506,25 +716,25 @@ func (_m *MockAssetRepository) SearchAllNetworks() ([]*asset.ResourceSearchResul
 	return r0, r1
 }
 
-// SearchAllNodeGroups provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllNodeGroups() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllSQLDatabaseInstances provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllSQLDatabaseInstances(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -532,25 +742,25 @@ func (_m *MockAssetRepository) SearchAllNodeGroups() ([]*asset.Asset, error) {
 	return r0, r1
 }
 
-// SearchAllRouters provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllRouters() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllServiceAccountKeys provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllServiceAccountKeys(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -558,25 +768,25 @@ func (_m *MockAssetRepository) SearchAllRouters() ([]*asset.ResourceSearchResult
 	return r0, r1
 }
 
-// SearchAllSQLDatabaseInstances provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllSQLDatabaseInstances() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllServiceAccounts provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllServiceAccounts(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -584,25 +794,25 @@ func (_m *MockAssetRepository) SearchAllSQLDatabaseInstances() ([]*asset.Asset,
 	return r0, r1
 }
 
-// SearchAllSslCertificates provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllSslCertificates() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllSslCertificates provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllSslCertificates(ctx context.Context) ([]*assetpb.Asset, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.Asset
+	var r0 []*assetpb.Asset
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.Asset, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.Asset, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.Asset)
+			r0 = ret.Get(0).([]*assetpb.Asset)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -610,25 +820,25 @@ func (_m *MockAssetRepository) SearchAllSslCertificates() ([]*asset.Asset, error
 	return r0, r1
 }
 
-// SearchAllSubnetworks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllSubnetworks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllSubnetworks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllSubnetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -636,25 +846,25 @@ func (_m *MockAssetRepository) SearchAllSubnetworks() ([]*asset.ResourceSearchRe
 	return r0, r1
 }
 
-// SearchAllTables provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllTables() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllTables provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllTables(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*asset.ResourceSearchResult
+	var r0 []*assetpb.ResourceSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*asset.ResourceSearchResult, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*assetpb.ResourceSearchResult, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*assetpb.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+			r0 = ret.Get(0).([]*assetpb.ResourceSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2,32 +2,36 @@
 
 package repository
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockStorageRepository is an autogenerated mock type for the StorageRepository type
 type MockStorageRepository struct {
 	mock.Mock
 }
 
-// ListAllBindings provides a mock function with given fields: bucketName
-func (_m *MockStorageRepository) ListAllBindings(bucketName string) (map[string][]string, error) {
-	ret := _m.Called(bucketName)
+// ListAllBindings provides a mock function with given fields: ctx, bucketName
+func (_m *MockStorageRepository) ListAllBindings(ctx context.Context, bucketName string) (map[string][]string, error) {
+	ret := _m.Called(ctx, bucketName)
 
 	var r0 map[string][]string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (map[string][]string, error)); ok {
-		return rf(bucketName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string][]string, error)); ok {
+		return rf(ctx, bucketName)
 	}
-	if rf, ok := ret.Get(0).(func(string) map[string][]string); ok {
-		r0 = rf(bucketName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string][]string); ok {
+		r0 = rf(ctx, bucketName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string][]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(bucketName)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bucketName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -6,11 +6,12 @@ import (
 	"sync"
 
 	"cloud.google.com/go/storage"
+	"github.com/snyk/driftctl/enumeration/profile"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 )
 
 type StorageRepository interface {
-	ListAllBindings(bucketName string) (map[string][]string, error)
+	ListAllBindings(ctx context.Context, bucketName string) (map[string][]string, error)
 }
 
 type storageRepository struct {
@@ -27,7 +28,9 @@ func NewStorageRepository(client *storage.Client, cache cache.Cache) *storageRep
 	}
 }
 
-func (s storageRepository) ListAllBindings(bucketName string) (map[string][]string, error) {
+func (s storageRepository) ListAllBindings(ctx context.Context, bucketName string) (map[string][]string, error) {
+	ctx, done := profile.Method(ctx, "StorageRepository.ListAllBindings")
+	defer done()
 
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -36,7 +39,7 @@ func (s storageRepository) ListAllBindings(bucketName string) (map[string][]stri
 	}
 
 	bucket := s.client.Bucket(bucketName)
-	policy, err := bucket.IAM().Policy(context.Background())
+	policy, err := bucket.IAM().Policy(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2,9 +2,15 @@ package google
 
 import (
 	"regexp"
+	"strings"
 )
 
 func trimResourceName(name string) string {
 	re, _ := regexp.Compile(`^\/\/[\w]+.googleapis.com\/`)
 	return re.ReplaceAllString(name, "")
 }
+
+// GKE asset names use zones/ for zonal clusters while Terraform IDs always use locations/
+func trimContainerResourceName(name string) string {
+	return strings.Replace(trimResourceName(name), "/zones/", "/locations/", 1)
+}
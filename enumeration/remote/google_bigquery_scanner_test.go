@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -33,7 +34,6 @@ func TestGoogleBigqueryDataset(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no dataset",
@@ -110,11 +110,8 @@ func TestGoogleBigqueryDataset(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -132,7 +129,6 @@ func TestGoogleBigqueryTable(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no table",
@@ -209,11 +205,8 @@ func TestGoogleBigqueryTable(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
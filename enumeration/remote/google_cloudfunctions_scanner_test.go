@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -34,7 +35,6 @@ func TestGoogleCloudFunctionsFunction(t *testing.T) {
 		response         []*assetpb.Asset
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute instance",
@@ -134,11 +134,8 @@ func TestGoogleCloudFunctionsFunction(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -33,13 +34,11 @@ func TestGoogleCloudRunService(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 		assertExpected   func(t *testing.T, got []*resource.Resource)
 	}{
 		{
 			test:     "no resource",
 			response: []*assetpb.ResourceSearchResult{},
-			wantErr:  nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
@@ -64,7 +63,6 @@ func TestGoogleCloudRunService(t *testing.T) {
 					Location:    "us-central1",
 				},
 			},
-			wantErr: nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 2)
 
@@ -77,7 +75,6 @@ func TestGoogleCloudRunService(t *testing.T) {
 		},
 		{
 			test:        "should return access denied error",
-			wantErr:     nil,
 			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
 			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
 				alerter.On(
@@ -130,11 +127,8 @@ func TestGoogleCloudRunService(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
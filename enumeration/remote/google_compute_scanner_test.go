@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -35,7 +36,6 @@ func TestGoogleComputeFirewall(t *testing.T) {
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
 		assertExpected   func(*testing.T, []*resource.Resource)
-		wantErr          error
 	}{
 		{
 			test:     "no compute firewall",
@@ -44,7 +44,6 @@ func TestGoogleComputeFirewall(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiples compute firewall",
@@ -78,7 +77,6 @@ func TestGoogleComputeFirewall(t *testing.T) {
 				assert.Equal(t, "projects/cloudskiff-dev-elie/global/firewalls/test-firewall-2", got[2].ResourceId())
 				assert.Equal(t, googleresource.GoogleComputeFirewallResourceType, got[2].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:        "cannot list compute firewall",
@@ -101,7 +99,6 @@ func TestGoogleComputeFirewall(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -149,11 +146,8 @@ func TestGoogleComputeFirewall(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			c.assertExpected(tt, got)
@@ -167,13 +161,11 @@ func TestGoogleComputeRouter(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 		assertExpected   func(t *testing.T, got []*resource.Resource)
 	}{
 		{
 			test:     "no compute router",
 			response: []*assetpb.ResourceSearchResult{},
-			wantErr:  nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
@@ -197,7 +189,6 @@ func TestGoogleComputeRouter(t *testing.T) {
 					Name:        "//compute.googleapis.com/projects/cloudskiff-dev-raphael/regions/us-central1/routers/test-router-2",
 				},
 			},
-			wantErr: nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 3)
 
@@ -213,7 +204,6 @@ func TestGoogleComputeRouter(t *testing.T) {
 		},
 		{
 			test:        "should return access denied error",
-			wantErr:     nil,
 			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
 			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
 				alerter.On(
@@ -266,11 +256,8 @@ func TestGoogleComputeRouter(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
@@ -288,7 +275,6 @@ func TestGoogleComputeInstance(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute instance",
@@ -365,11 +351,8 @@ func TestGoogleComputeInstance(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -387,7 +370,6 @@ func TestGoogleComputeNetwork(t *testing.T) {
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
 		assertExpected   func(*testing.T, []*resource.Resource)
-		wantErr          error
 	}{
 		{
 			test:     "no network",
@@ -396,7 +378,6 @@ func TestGoogleComputeNetwork(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiple networks",
@@ -430,7 +411,6 @@ func TestGoogleComputeNetwork(t *testing.T) {
 				assert.Equal(t, "projects/driftctl-qa-1/global/networks/driftctl-unittest-3", got[2].ResourceId())
 				assert.Equal(t, googleresource.GoogleComputeNetworkResourceType, got[2].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:        "cannot list compute networks",
@@ -453,7 +433,6 @@ func TestGoogleComputeNetwork(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -501,11 +480,8 @@ func TestGoogleComputeNetwork(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 
@@ -522,7 +498,6 @@ func TestGoogleComputeInstanceGroup(t *testing.T) {
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
 		assertExpected   func(*testing.T, []*resource.Resource)
-		wantErr          error
 	}{
 		{
 			test:     "no instance group",
@@ -531,7 +506,6 @@ func TestGoogleComputeInstanceGroup(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiple instance groups",
@@ -561,7 +535,6 @@ func TestGoogleComputeInstanceGroup(t *testing.T) {
 				assert.Equal(t, "projects/cloudskiff-dev-raphael/zones/us-central1-a/instanceGroups/driftctl-test-2", got[1].ResourceId())
 				assert.Equal(t, googleresource.GoogleComputeInstanceGroupResourceType, got[1].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:        "cannot list instance groups",
@@ -584,7 +557,6 @@ func TestGoogleComputeInstanceGroup(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -632,11 +604,8 @@ func TestGoogleComputeInstanceGroup(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
@@ -652,7 +621,6 @@ func TestGoogleComputeAddress(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute address",
@@ -747,11 +715,8 @@ func TestGoogleComputeAddress(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -768,7 +733,6 @@ func TestGoogleComputeGlobalAddress(t *testing.T) {
 		response         []*assetpb.Asset
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no resource",
@@ -868,11 +832,8 @@ func TestGoogleComputeGlobalAddress(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -890,7 +851,6 @@ func TestGoogleComputeSubnetwork(t *testing.T) {
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
 		assertExpected   func(*testing.T, []*resource.Resource)
-		wantErr          error
 	}{
 		{
 			test:     "no subnetwork",
@@ -899,7 +859,6 @@ func TestGoogleComputeSubnetwork(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiple subnetworks",
@@ -933,7 +892,6 @@ func TestGoogleComputeSubnetwork(t *testing.T) {
 				assert.Equal(t, "projects/cloudskiff-dev-raphael/regions/us-central1/subnetworks/driftctl-unittest-3", got[2].ResourceId())
 				assert.Equal(t, googleresource.GoogleComputeSubnetworkResourceType, got[2].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:        "cannot list compute subnetworks",
@@ -956,7 +914,6 @@ func TestGoogleComputeSubnetwork(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -1004,11 +961,8 @@ func TestGoogleComputeSubnetwork(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			c.assertExpected(tt, got)
@@ -1023,7 +977,6 @@ func TestGoogleComputeDisk(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute disk",
@@ -1107,11 +1060,8 @@ func TestGoogleComputeDisk(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1128,7 +1078,6 @@ func TestGoogleComputeImage(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute image",
@@ -1212,11 +1161,8 @@ func TestGoogleComputeImage(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1233,7 +1179,6 @@ func TestGoogleComputeHealthCheck(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute health check",
@@ -1317,11 +1262,8 @@ func TestGoogleComputeHealthCheck(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1338,7 +1280,6 @@ func TestGoogleComputeNodeGroup(t *testing.T) {
 		response         []*assetpb.Asset
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute node group",
@@ -1422,11 +1363,8 @@ func TestGoogleComputeNodeGroup(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1443,7 +1381,6 @@ func TestGoogleComputeForwardingRule(t *testing.T) {
 		response         []*assetpb.Asset
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute forwarding rules",
@@ -1527,11 +1464,8 @@ func TestGoogleComputeForwardingRule(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1548,7 +1482,6 @@ func TestGoogleComputeSslCertificate(t *testing.T) {
 		response         []*assetpb.Asset
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute ssl certificates",
@@ -1632,11 +1565,8 @@ func TestGoogleComputeSslCertificate(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1653,7 +1583,6 @@ func TestGoogleComputeInstanceGroupManager(t *testing.T) {
 		response         []*assetpb.Asset
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute instance group manager",
@@ -1737,11 +1666,8 @@ func TestGoogleComputeInstanceGroupManager(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -1758,7 +1684,6 @@ func TestGoogleComputeGlobalForwardingRule(t *testing.T) {
 		response         []*assetpb.Asset
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:     "no compute global forwarding rules",
@@ -1842,11 +1767,8 @@ func TestGoogleComputeGlobalForwardingRule(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			if c.assertExpected != nil {
@@ -0,0 +1,240 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	googleresource "github.com/snyk/driftctl/enumeration/resource/google"
+	"github.com/snyk/driftctl/mocks"
+
+	testgoogle "github.com/snyk/driftctl/test/google"
+
+	assetpb "cloud.google.com/go/asset/apiv1/assetpb"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGoogleContainerCluster(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no container cluster",
+			response: []*assetpb.ResourceSearchResult{},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples container cluster",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType:   "container.googleapis.com/Cluster",
+					DisplayName: "regional",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/clusters/regional",
+				},
+				{
+					AssetType:   "container.googleapis.com/Cluster",
+					DisplayName: "zonal",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/zones/us-central1-a/clusters/zonal",
+				},
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/locations/us-central1/clusters/regional", got[0].ResourceId())
+				assert.Equal(t, googleresource.GoogleContainerClusterResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/locations/us-central1-a/clusters/zonal", got[1].ResourceId())
+				assert.Equal(t, googleresource.GoogleContainerClusterResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test:        "should return access denied error",
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleContainerClusterResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleContainerClusterResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleContainerClusterEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(tt, got)
+			}
+		})
+	}
+}
+
+func TestGoogleContainerNodePool(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no container node pool",
+			response: []*assetpb.ResourceSearchResult{},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples container node pool",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType:   "container.googleapis.com/NodePool",
+					DisplayName: "pool-1",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/clusters/regional/nodePools/pool-1",
+				},
+				{
+					AssetType:   "container.googleapis.com/NodePool",
+					DisplayName: "pool-2",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/zones/us-central1-a/clusters/zonal/nodePools/pool-2",
+				},
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/locations/us-central1/clusters/regional/nodePools/pool-1", got[0].ResourceId())
+				assert.Equal(t, googleresource.GoogleContainerNodePoolResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/locations/us-central1-a/clusters/zonal/nodePools/pool-2", got[1].ResourceId())
+				assert.Equal(t, googleresource.GoogleContainerNodePoolResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test:        "should return access denied error",
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleContainerNodePoolResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleContainerNodePoolResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleContainerNodePoolEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(tt, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	googleresource "github.com/snyk/driftctl/enumeration/resource/google"
+	"github.com/snyk/driftctl/mocks"
+
+	testgoogle "github.com/snyk/driftctl/test/google"
+
+	assetpb "cloud.google.com/go/asset/apiv1/assetpb"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestGoogleServiceAccount(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.Asset
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no service account",
+			response: []*assetpb.Asset{},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples service account",
+			response: []*assetpb.Asset{
+				{
+					AssetType: "iam.googleapis.com/ServiceAccount",
+					Name:      "//iam.googleapis.com/projects/cloudskiff-dev-elie/serviceAccounts/112233445566778899",
+					Resource: &assetpb.Resource{
+						Data: &structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"name":  structpb.NewStringValue("projects/cloudskiff-dev-elie/serviceAccounts/sa-1@cloudskiff-dev-elie.iam.gserviceaccount.com"),
+								"email": structpb.NewStringValue("sa-1@cloudskiff-dev-elie.iam.gserviceaccount.com"),
+							},
+						},
+					},
+				},
+				{
+					AssetType: "iam.googleapis.com/ServiceAccount",
+					Name:      "//iam.googleapis.com/projects/cloudskiff-dev-elie/serviceAccounts/998877665544332211",
+					Resource: &assetpb.Resource{
+						Data: &structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"email": structpb.NewStringValue("noname@example.com"),
+							},
+						},
+					},
+				},
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/serviceAccounts/sa-1@cloudskiff-dev-elie.iam.gserviceaccount.com", got[0].ResourceId())
+				assert.Equal(t, googleresource.GoogleServiceAccountResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test:        "should return access denied error",
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleServiceAccountResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleServiceAccountResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssertServerWithList(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleServiceAccountEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(tt, got)
+			}
+		})
+	}
+}
+
+func TestGoogleServiceAccountKey(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.Asset
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no service account key",
+			response: []*assetpb.Asset{},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples service account key",
+			response: []*assetpb.Asset{
+				{
+					AssetType: "iam.googleapis.com/ServiceAccountKey",
+					Name:      "//iam.googleapis.com/projects/cloudskiff-dev-elie/serviceAccounts/112233445566778899/keys/userkey",
+					Resource: &assetpb.Resource{
+						Data: &structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"name":    structpb.NewStringValue("projects/cloudskiff-dev-elie/serviceAccounts/sa-1@cloudskiff-dev-elie.iam.gserviceaccount.com/keys/userkey"),
+								"keyType": structpb.NewStringValue("USER_MANAGED"),
+							},
+						},
+					},
+				},
+				{
+					AssetType: "iam.googleapis.com/ServiceAccountKey",
+					Name:      "//iam.googleapis.com/projects/cloudskiff-dev-elie/serviceAccounts/112233445566778899/keys/systemkey",
+					Resource: &assetpb.Resource{
+						Data: &structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"name":    structpb.NewStringValue("projects/cloudskiff-dev-elie/serviceAccounts/sa-1@cloudskiff-dev-elie.iam.gserviceaccount.com/keys/systemkey"),
+								"keyType": structpb.NewStringValue("SYSTEM_MANAGED"),
+							},
+						},
+					},
+				},
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/serviceAccounts/sa-1@cloudskiff-dev-elie.iam.gserviceaccount.com/keys/userkey", got[0].ResourceId())
+				assert.Equal(t, googleresource.GoogleServiceAccountKeyResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test:        "should return access denied error",
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleServiceAccountKeyResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleServiceAccountKeyResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssertServerWithList(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleServiceAccountKeyEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(tt, got)
+			}
+		})
+	}
+}
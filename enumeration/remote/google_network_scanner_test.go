@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -33,13 +34,11 @@ func TestGoogleDNSNanagedZone(t *testing.T) {
 		response         []*assetpb.ResourceSearchResult
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 		assertExpected   func(t *testing.T, got []*resource.Resource)
 	}{
 		{
 			test:     "no managed zone",
 			response: []*assetpb.ResourceSearchResult{},
-			wantErr:  nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
@@ -67,7 +66,6 @@ func TestGoogleDNSNanagedZone(t *testing.T) {
 					Name:        "//dns.googleapis.com/projects/cloudskiff-dev-raphael/managedZones/123456789",
 				},
 			},
-			wantErr: nil,
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 3)
 
@@ -83,7 +81,6 @@ func TestGoogleDNSNanagedZone(t *testing.T) {
 		},
 		{
 			test:        "should return access denied error",
-			wantErr:     nil,
 			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
 			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
 				alerter.On(
@@ -136,11 +133,8 @@ func TestGoogleDNSNanagedZone(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -31,21 +32,19 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 		repositoryMock   func(repository *repository.MockCloudResourceManagerRepository)
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
-		wantErr          error
 	}{
 		{
 			test:    "no bindings",
 			dirName: "google_project_member_empty",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(map[string]map[string][]string{}, nil)
+				repository.On("ListProjectsBindings", mock.Anything).Return(map[string]map[string][]string{}, nil)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "Cannot list bindings",
 			dirName: "google_project_member_listing_error",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(
+				repository.On("ListProjectsBindings", mock.Anything).Return(
 					map[string]map[string][]string{},
 					errors.New("googleapi: Error 403: driftctl-acc-circle@driftctl-qa-1.iam.gserviceaccount.com does not have project.getIamPolicy access., forbidden"))
 			},
@@ -63,13 +62,12 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 					),
 				).Once()
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiples storage buckets, multiple bindings",
 			dirName: "google_project_member_listing_multiple",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(map[string]map[string][]string{
+				repository.On("ListProjectsBindings", mock.Anything).Return(map[string]map[string][]string{
 					"": {
 						"roles/editor": {
 							"user:martin.guibert@cloudskiff.com",
@@ -82,7 +80,6 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 					},
 				}, nil)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -122,11 +119,8 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			test.TestAgainstGoldenFileNoCty(got, resType.String(), c.dirName, provider, deserializer, shouldUpdate, tt)
@@ -0,0 +1,232 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/google"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	googleresource "github.com/snyk/driftctl/enumeration/resource/google"
+	"github.com/snyk/driftctl/mocks"
+
+	testgoogle "github.com/snyk/driftctl/test/google"
+
+	assetpb "cloud.google.com/go/asset/apiv1/assetpb"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGooglePubsubTopic(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no pubsub topic",
+			response: []*assetpb.ResourceSearchResult{},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples pubsub topic",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType:   "pubsub.googleapis.com/Topic",
+					DisplayName: "topic-1",
+					Name:        "//pubsub.googleapis.com/projects/cloudskiff-dev-elie/topics/topic-1",
+				},
+				{
+					AssetType:   "pubsub.googleapis.com/Topic",
+					DisplayName: "topic-2",
+					Name:        "//pubsub.googleapis.com/projects/cloudskiff-dev-elie/topics/topic-2",
+				},
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/topics/topic-1", got[0].ResourceId())
+				assert.Equal(t, googleresource.GooglePubsubTopicResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/topics/topic-2", got[1].ResourceId())
+				assert.Equal(t, googleresource.GooglePubsubTopicResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test:        "should return access denied error",
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GooglePubsubTopicResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GooglePubsubTopicResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGooglePubsubTopicEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(tt, got)
+			}
+		})
+	}
+}
+
+func TestGooglePubsubSubscription(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no pubsub subscription",
+			response: []*assetpb.ResourceSearchResult{},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples pubsub subscription",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType:   "pubsub.googleapis.com/Subscription",
+					DisplayName: "sub-1",
+					Name:        "//pubsub.googleapis.com/projects/cloudskiff-dev-elie/subscriptions/sub-1",
+				},
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+
+				assert.Equal(t, "projects/cloudskiff-dev-elie/subscriptions/sub-1", got[0].ResourceId())
+				assert.Equal(t, googleresource.GooglePubsubSubscriptionResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test:        "should return access denied error",
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GooglePubsubSubscriptionResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GooglePubsubSubscriptionResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGooglePubsubSubscriptionEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(tt, got)
+			}
+		})
+	}
+}
@@ -38,7 +38,6 @@ func TestGoogleStorageBucket(t *testing.T) {
 		responseErr      error
 		setupAlerterMock func(alerter *mocks.AlerterInterface)
 		assertExpected   func(*testing.T, []*resource.Resource)
-		wantErr          error
 	}{
 		{
 			test:     "no storage buckets",
@@ -47,7 +46,6 @@ func TestGoogleStorageBucket(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiples storage buckets",
@@ -78,7 +76,6 @@ func TestGoogleStorageBucket(t *testing.T) {
 				assert.Equal(t, "driftctl-unittest-3", got[2].ResourceId())
 				assert.Equal(t, googleresource.GoogleStorageBucketResourceType, got[2].ResourceType())
 			},
-			wantErr: nil,
 		},
 		{
 			test:        "cannot list storage buckets",
@@ -101,7 +98,6 @@ func TestGoogleStorageBucket(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -158,11 +154,8 @@ func TestGoogleStorageBucket(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, err, c.wantErr)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			c.assertExpected(tt, got)
@@ -179,24 +172,22 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 		responseErr           error
 		setupAlerterMock      func(alerter *mocks.AlerterInterface)
 		assertExpected        func(*testing.T, []*resource.Resource)
-		wantErr               error
 	}{
 		{
 			test:    "no storage buckets",
 			dirName: "google_storage_bucket_member_empty",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{}, nil)
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiples storage buckets, no bindings",
 			dirName: "google_storage_bucket_member_empty",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -208,19 +199,18 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(map[string][]string{}, nil)
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-2").Return(map[string][]string{}, nil)
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(map[string][]string{}, nil)
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-2").Return(map[string][]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "Cannot list bindings",
 			dirName: "google_storage_bucket_member_listing_error",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -228,7 +218,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(
 					map[string][]string{},
 					errors.New("googleapi: Error 403: driftctl-acc-circle@driftctl-qa-1.iam.gserviceaccount.com does not have storage.buckets.getIamPolicy access to the Google Cloud Storage bucket., forbidden"))
 			},
@@ -249,13 +239,12 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
 			},
-			wantErr: nil,
 		},
 		{
 			test:    "multiples storage buckets, multiple bindings",
 			dirName: "google_storage_bucket_member_listing_multiple",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -267,12 +256,12 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(map[string][]string{
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(map[string][]string{
 					"roles/storage.admin":        {"user:elie.charra@cloudskiff.com"},
 					"roles/storage.objectViewer": {"user:william.beuil@cloudskiff.com"},
 				}, nil)
 
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-2").Return(map[string][]string{
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-2").Return(map[string][]string{
 					"roles/storage.admin":        {"user:william.beuil@cloudskiff.com"},
 					"roles/storage.objectViewer": {"user:elie.charra@cloudskiff.com"},
 				}, nil)
@@ -291,7 +280,6 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				assert.Contains(t, resourceIds, "b/dctlgstoragebucketiambinding-2/roles/storage.admin/user:william.beuil@cloudskiff.com")
 				assert.Contains(t, resourceIds, "b/dctlgstoragebucketiambinding-2/roles/storage.admin/user:william.beuil@cloudskiff.com")
 			},
-			wantErr: nil,
 		},
 	}
 
@@ -344,11 +332,8 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
 			s := NewScanner(remoteLibrary, alerter, testFilter)
-			got, err := s.Resources()
-			assert.Equal(tt, c.wantErr, err)
-			if err != nil {
-				return
-			}
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
 			alerter.AssertExpectations(tt)
 			testFilter.AssertExpectations(tt)
 			c.assertExpected(tt, got)
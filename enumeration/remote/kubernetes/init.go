@@ -0,0 +1,92 @@
+package kubernetes
+
+import (
+	"net/http"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/remote/kubernetes/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/traffic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, rateLimits *ratelimit.Controller, repositoryCache cache.Cache, capture *traffic.Capture) error {
+
+	provider, err := NewKubernetesTerraformProvider(version, progress, configDir)
+	if err != nil {
+		return err
+	}
+	provider.SetParallelism(rateLimits.Parallelism())
+	// A replayed scan needs neither a kubeconfig nor the provider, only the recorded schema
+	if !capture.Replaying() {
+		err = provider.CheckCredentialsExist()
+		if err != nil {
+			return err
+		}
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
+	}
+	tfProvider, err := capture.Provider(provider)
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := captureRestConfig(provider, capture)
+	if err != nil {
+		return err
+	}
+	limiter := rateLimits.Limiter("kubernetes")
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return ratelimit.NewTransport(limiter, rt)
+	})
+	restConfig.Wrap(profile.NewTransport)
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	repo := repository.NewKubernetesRepository(clientset, repositoryCache)
+
+	providerLibrary.AddProvider(terraform.KUBERNETES, tfProvider)
+
+	remoteLibrary.AddEnumerator(NewKubernetesNamespaceEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesDeploymentEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesServiceEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesConfigMapEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesServiceAccountEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesRoleEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesRoleBindingEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesClusterRoleEnumerator(repo, factory))
+	remoteLibrary.AddEnumerator(NewKubernetesClusterRoleBindingEnumerator(repo, factory))
+
+	return nil
+}
+
+// captureRestConfig returns the config of the cluster to scan, a replayed scan only needs the recorded
+// API server address. The capture transport is the first wrapper so that it sits right above the network.
+func captureRestConfig(provider *KubernetesTerraformProvider, capture *traffic.Capture) (*rest.Config, error) {
+	restConfig := &rest.Config{}
+	if !capture.Replaying() {
+		var err error
+		restConfig, err = provider.GetConfig().restConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if capture != nil {
+		restConfig.Host = capture.Setting("kubernetes.host", restConfig.Host)
+		restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return capture.Transport("kubernetes", rt)
+		})
+	}
+	return restConfig, nil
+}
@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/traffic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func TestCaptureRestConfig_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"NamespaceList","apiVersion":"v1","items":[{"metadata":{"name":"default"}}]}`))
+	}))
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(kubeconfig, []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: secret
+`, server.URL)), 0600))
+	t.Setenv("KUBE_CONFIG_PATH", kubeconfig)
+
+	listNamespaces := func(capture *traffic.Capture) ([]string, error) {
+		restConfig, err := captureRestConfig(&KubernetesTerraformProvider{}, capture)
+		if err != nil {
+			return nil, err
+		}
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+		list, err := clientset.CoreV1().Namespaces().List(context.Background(), v1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(list.Items))
+		for _, namespace := range list.Items {
+			names = append(names, namespace.Name)
+		}
+		return names, nil
+	}
+
+	dir := t.TempDir()
+	recorder, err := traffic.NewRecorder(dir, "k8s+tf")
+	require.NoError(t, err)
+	names, err := listNamespaces(recorder)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, names)
+
+	// Replay must need neither the cluster nor the kubeconfig
+	server.Close()
+	t.Setenv("KUBE_CONFIG_PATH", filepath.Join(t.TempDir(), "missing"))
+
+	replayer, err := traffic.NewReplayer(dir, "k8s+tf")
+	require.NoError(t, err)
+	names, err = listNamespaces(replayer)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, names)
+}
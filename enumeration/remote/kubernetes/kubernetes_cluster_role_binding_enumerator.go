@@ -0,0 +1,48 @@
+package kubernetes
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/kubernetes/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/kubernetes"
+)
+
+type KubernetesClusterRoleBindingEnumerator struct {
+	repository repository.KubernetesRepository
+	factory    resource.ResourceFactory
+}
+
+func NewKubernetesClusterRoleBindingEnumerator(repo repository.KubernetesRepository, factory resource.ResourceFactory) *KubernetesClusterRoleBindingEnumerator {
+	return &KubernetesClusterRoleBindingEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *KubernetesClusterRoleBindingEnumerator) SupportedType() resource.ResourceType {
+	return kubernetes.KubernetesClusterRoleBindingResourceType
+}
+
+func (e *KubernetesClusterRoleBindingEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	objects, err := e.repository.ListAllClusterRoleBindings(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(objects))
+
+	for _, object := range objects {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				objectID(object),
+				objectAttributes(object),
+			),
+		)
+	}
+
+	return results, err
+}
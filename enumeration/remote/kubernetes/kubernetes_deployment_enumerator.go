@@ -0,0 +1,48 @@
+package kubernetes
+
+import (
+	"context"
+
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/kubernetes/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/kubernetes"
+)
+
+type KubernetesDeploymentEnumerator struct {
+	repository repository.KubernetesRepository
+	factory    resource.ResourceFactory
+}
+
+func NewKubernetesDeploymentEnumerator(repo repository.KubernetesRepository, factory resource.ResourceFactory) *KubernetesDeploymentEnumerator {
+	return &KubernetesDeploymentEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *KubernetesDeploymentEnumerator) SupportedType() resource.ResourceType {
+	return kubernetes.KubernetesDeploymentResourceType
+}
+
+func (e *KubernetesDeploymentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	objects, err := e.repository.ListAllDeployments(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(objects))
+
+	for _, object := range objects {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				objectID(object),
+				objectAttributes(object),
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,101 @@
+package kubernetes
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/terraform"
+	tf "github.com/snyk/driftctl/enumeration/terraform"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+type KubernetesTerraformProvider struct {
+	*terraform.TerraformProvider
+	name    string
+	version string
+}
+
+type kubernetesConfig struct {
+	ConfigPath    string
+	ConfigContext string
+}
+
+func NewKubernetesTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*KubernetesTerraformProvider, error) {
+	if version == "" {
+		version = "2.13.1"
+	}
+	p := &KubernetesTerraformProvider{
+		version: version,
+		name:    tf.KUBERNETES,
+	}
+	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
+		Key:       p.name,
+		Version:   version,
+		ConfigDir: configDir,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
+		Name: p.name,
+		GetProviderConfig: func(_ string) interface{} {
+			c := p.GetConfig()
+			return map[string]interface{}{
+				"config_path":    c.kubeconfigPath(),
+				"config_context": c.ConfigContext,
+			}
+		},
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+	p.TerraformProvider = tfProvider
+	return p, err
+}
+
+// GetConfig uses the same environment variables as the terraform provider
+func (p KubernetesTerraformProvider) GetConfig() kubernetesConfig {
+	return kubernetesConfig{
+		ConfigPath:    os.Getenv("KUBE_CONFIG_PATH"),
+		ConfigContext: os.Getenv("KUBE_CTX"),
+	}
+}
+
+func (p *KubernetesTerraformProvider) Name() string {
+	return p.name
+}
+
+func (p *KubernetesTerraformProvider) Version() string {
+	return p.version
+}
+
+func (p *KubernetesTerraformProvider) CheckCredentialsExist() error {
+	if _, err := p.GetConfig().restConfig(); err != nil {
+		return errors.Errorf("Could not load a kubeconfig: %s\n"+
+			"Please set the KUBECONFIG or KUBE_CONFIG_PATH environment variable, or create ~/.kube/config. "+
+			"The context can be selected with the KUBE_CTX environment variable.", err)
+	}
+	return nil
+}
+
+func (c kubernetesConfig) loadingRules() *clientcmd.ClientConfigLoadingRules {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = c.ConfigPath
+	return rules
+}
+
+// kubeconfigPath returns the kubeconfig file to pass to the terraform provider,
+// which does not fall back to KUBECONFIG or ~/.kube/config by itself
+func (c kubernetesConfig) kubeconfigPath() string {
+	if c.ConfigPath != "" {
+		return c.ConfigPath
+	}
+	return c.loadingRules().GetDefaultFilename()
+}
+
+func (c kubernetesConfig) restConfig() (*rest.Config, error) {
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: c.ConfigContext}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(c.loadingRules(), overrides).ClientConfig()
+}
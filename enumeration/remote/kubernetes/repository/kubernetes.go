@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// listPageSize bounds the number of objects returned by a single list call
+const listPageSize = 500
+
+// KubernetesRepository only returns objects metadata: enumeration never needs the spec,
+// and this way config map data never leaves the repository.
+type KubernetesRepository interface {
+	ListAllNamespaces(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllDeployments(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllServices(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllConfigMaps(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllServiceAccounts(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllRoles(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllRoleBindings(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllClusterRoles(ctx context.Context) ([]metav1.ObjectMeta, error)
+	ListAllClusterRoleBindings(ctx context.Context) ([]metav1.ObjectMeta, error)
+}
+
+type kubernetesRepository struct {
+	client kubernetes.Interface
+	cache  cache.Cache
+}
+
+func NewKubernetesRepository(client kubernetes.Interface, c cache.Cache) *kubernetesRepository {
+	return &kubernetesRepository{
+		client: client,
+		cache:  c,
+	}
+}
+
+// listPage lists a single page and returns the metadata of its items along with the continue token
+type listPage func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error)
+
+func (r *kubernetesRepository) listAll(ctx context.Context, cacheKey string, list listPage) ([]metav1.ObjectMeta, error) {
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]metav1.ObjectMeta), nil
+	}
+
+	results := make([]metav1.ObjectMeta, 0)
+	opts := metav1.ListOptions{Limit: listPageSize}
+	for {
+		items, next, err := list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, items...)
+		if next == "" {
+			break
+		}
+		opts.Continue = next
+	}
+
+	r.cache.Put(cacheKey, results)
+	return results, nil
+}
+
+func (r *kubernetesRepository) ListAllNamespaces(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllNamespaces")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllNamespaces", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.CoreV1().Namespaces().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllDeployments(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllDeployments")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllDeployments", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllServices(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllServices")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllServices", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.CoreV1().Services(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllConfigMaps(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllConfigMaps")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllConfigMaps", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllServiceAccounts(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllServiceAccounts")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllServiceAccounts", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.CoreV1().ServiceAccounts(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllRoles(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllRoles")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllRoles", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.RbacV1().Roles(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllRoleBindings(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllRoleBindings")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllRoleBindings", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllClusterRoles(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllClusterRoles")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllClusterRoles", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.RbacV1().ClusterRoles().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
+
+func (r *kubernetesRepository) ListAllClusterRoleBindings(ctx context.Context) ([]metav1.ObjectMeta, error) {
+	ctx, done := profile.Method(ctx, "KubernetesRepository.ListAllClusterRoleBindings")
+	defer done()
+
+	return r.listAll(ctx, "kubernetesListAllClusterRoleBindings", func(ctx context.Context, opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		res, err := r.client.RbacV1().ClusterRoleBindings().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		metas := make([]metav1.ObjectMeta, 0, len(res.Items))
+		for _, item := range res.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+		return metas, res.Continue, nil
+	})
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestKubernetesRepository_ListAll(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api"}},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api-config"},
+			Data:       map[string]string{"password": "hunter2"},
+		},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api"}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "reader"}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api-reader"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "admin"}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "admins"}},
+	)
+
+	r := NewKubernetesRepository(client, cache.New(0))
+
+	tests := []struct {
+		name     string
+		list     func(context.Context) ([]metav1.ObjectMeta, error)
+		expected []string
+	}{
+		{name: "namespaces", list: r.ListAllNamespaces, expected: []string{"/app", "/default"}},
+		{name: "deployments", list: r.ListAllDeployments, expected: []string{"app/api"}},
+		{name: "services", list: r.ListAllServices, expected: []string{"app/api"}},
+		{name: "config maps", list: r.ListAllConfigMaps, expected: []string{"app/api-config"}},
+		{name: "service accounts", list: r.ListAllServiceAccounts, expected: []string{"app/api"}},
+		{name: "roles", list: r.ListAllRoles, expected: []string{"app/reader"}},
+		{name: "role bindings", list: r.ListAllRoleBindings, expected: []string{"app/api-reader"}},
+		{name: "cluster roles", list: r.ListAllClusterRoles, expected: []string{"/admin"}},
+		{name: "cluster role bindings", list: r.ListAllClusterRoleBindings, expected: []string{"/admins"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.list(context.Background())
+			assert.Nil(t, err)
+			names := make([]string, 0, len(got))
+			for _, meta := range got {
+				names = append(names, meta.Namespace+"/"+meta.Name)
+			}
+			assert.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
+
+func TestKubernetesRepository_ListAllConfigMaps_Paginated(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	calls := 0
+	client.PrependReactor("list", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		calls++
+		if calls == 1 {
+			return true, &corev1.ConfigMapList{
+				ListMeta: metav1.ListMeta{Continue: "next"},
+				Items:    []corev1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "first"}}},
+			}, nil
+		}
+		return true, &corev1.ConfigMapList{
+			Items: []corev1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "second"}}},
+		}, nil
+	})
+
+	store := cache.New(1)
+	r := NewKubernetesRepository(client, store)
+
+	got, err := r.ListAllConfigMaps(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []metav1.ObjectMeta{
+		{Namespace: "app", Name: "first"},
+		{Namespace: "app", Name: "second"},
+	}, got)
+
+	// Check that results were cached
+	cachedData, err := r.ListAllConfigMaps(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, got, cachedData)
+	assert.Equal(t, 2, calls)
+}
+
+func TestKubernetesRepository_ListAllDeployments_WithError(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	expectedError := errors.New("test error from api server")
+	client.PrependReactor("list", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, expectedError
+	})
+
+	r := NewKubernetesRepository(client, cache.New(1))
+
+	_, err := r.ListAllDeployments(context.Background())
+	assert.Equal(t, expectedError, err)
+}
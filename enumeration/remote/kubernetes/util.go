@@ -0,0 +1,37 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// objectID returns the id used by the terraform provider, namespace/name for
+// namespaced objects and name for cluster scoped ones
+func objectID(object metav1.ObjectMeta) string {
+	if object.Namespace == "" {
+		return object.Name
+	}
+	return fmt.Sprintf("%s/%s", object.Namespace, object.Name)
+}
+
+// objectAttributes exposes what middlewares need to tell system objects apart
+func objectAttributes(object metav1.ObjectMeta) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"name": object.Name,
+	}
+	if object.Namespace != "" {
+		attrs["namespace"] = object.Namespace
+	}
+	if len(object.Labels) > 0 {
+		labels := make(map[string]interface{}, len(object.Labels))
+		for k, v := range object.Labels {
+			labels[k] = v
+		}
+		attrs["labels"] = labels
+	}
+	if controller := metav1.GetControllerOfNoCopy(&object); controller != nil {
+		attrs["controller"] = fmt.Sprintf("%s/%s", controller.Kind, controller.Name)
+	}
+	return attrs
+}
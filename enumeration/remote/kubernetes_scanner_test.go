@@ -0,0 +1,207 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/kubernetes"
+	"github.com/snyk/driftctl/enumeration/remote/kubernetes/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourcekubernetes "github.com/snyk/driftctl/enumeration/resource/kubernetes"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestScanKubernetes(t *testing.T) {
+	controller := true
+	objects := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api", Labels: map[string]string{"app": "api"}}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api"}},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "app",
+				Name:      "api-leader",
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "Deployment", Name: "api", Controller: &controller},
+				},
+			},
+			Data: map[string]string{"password": "hunter2"},
+		},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api"}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "reader"}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "api-reader"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "view", Labels: map[string]string{"kubernetes.io/bootstrapping": "rbac-defaults"}}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "viewers"}},
+	}
+
+	cases := []struct {
+		test         string
+		resourceType string
+		enumerator   func(repository.KubernetesRepository, resource.ResourceFactory) common.Enumerator
+		expected     map[string]map[string]interface{}
+	}{
+		{
+			test:         "namespaces",
+			resourceType: resourcekubernetes.KubernetesNamespaceResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesNamespaceEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"app": {"name": "app"},
+			},
+		},
+		{
+			test:         "deployments",
+			resourceType: resourcekubernetes.KubernetesDeploymentResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesDeploymentEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"app/api": {"name": "api", "namespace": "app", "labels": map[string]interface{}{"app": "api"}},
+			},
+		},
+		{
+			test:         "services",
+			resourceType: resourcekubernetes.KubernetesServiceResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesServiceEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"app/api": {"name": "api", "namespace": "app"},
+			},
+		},
+		{
+			test:         "config maps without their data",
+			resourceType: resourcekubernetes.KubernetesConfigMapResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesConfigMapEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"app/api-leader": {"name": "api-leader", "namespace": "app", "controller": "Deployment/api"},
+			},
+		},
+		{
+			test:         "service accounts",
+			resourceType: resourcekubernetes.KubernetesServiceAccountResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesServiceAccountEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"app/api": {"name": "api", "namespace": "app"},
+			},
+		},
+		{
+			test:         "roles",
+			resourceType: resourcekubernetes.KubernetesRoleResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesRoleEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"app/reader": {"name": "reader", "namespace": "app"},
+			},
+		},
+		{
+			test:         "role bindings",
+			resourceType: resourcekubernetes.KubernetesRoleBindingResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesRoleBindingEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"app/api-reader": {"name": "api-reader", "namespace": "app"},
+			},
+		},
+		{
+			test:         "cluster roles",
+			resourceType: resourcekubernetes.KubernetesClusterRoleResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesClusterRoleEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"view": {"name": "view", "labels": map[string]interface{}{"kubernetes.io/bootstrapping": "rbac-defaults"}},
+			},
+		},
+		{
+			test:         "cluster role bindings",
+			resourceType: resourcekubernetes.KubernetesClusterRoleBindingResourceType,
+			enumerator: func(repo repository.KubernetesRepository, factory resource.ResourceFactory) common.Enumerator {
+				return kubernetes.NewKubernetesClusterRoleBindingEnumerator(repo, factory)
+			},
+			expected: map[string]map[string]interface{}{
+				"viewers": {"name": "viewers"},
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+			alerter := &mocks.AlerterInterface{}
+
+			repo := repository.NewKubernetesRepository(fake.NewSimpleClientset(objects...), cache.New(0))
+			remoteLibrary.AddEnumerator(c.enumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources(context.Background())
+			assert.Nil(tt, err)
+
+			assert.Len(tt, got, len(c.expected))
+			for _, res := range got {
+				assert.Equal(tt, c.resourceType, res.ResourceType())
+				expectedAttrs, exist := c.expected[res.ResourceId()]
+				if assert.True(tt, exist, "unexpected resource %s", res.ResourceId()) {
+					assert.Equal(tt, resource.Attributes(expectedAttrs), *res.Attributes())
+				}
+			}
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestScanKubernetes_AccessDenied(t *testing.T) {
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "", errors.New("cannot list resource \"configmaps\" in API group \"\" at the cluster scope"))
+
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("list", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, forbidden
+	})
+
+	factory := terraform.NewTerraformResourceFactory()
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(kubernetes.NewKubernetesConfigMapEnumerator(repository.NewKubernetesRepository(client, cache.New(0)), factory))
+
+	alerter := &mocks.AlerterInterface{}
+	alerter.On("SendAlert", resourcekubernetes.KubernetesConfigMapResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteKubernetesTerraform, remoteerr.NewResourceListingErrorWithType(forbidden, resourcekubernetes.KubernetesConfigMapResourceType, resourcekubernetes.KubernetesConfigMapResourceType), alerts.EnumerationPhase)).Return()
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter, testFilter)
+	got, err := s.Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, got, 0)
+	alerter.AssertExpectations(t)
+}
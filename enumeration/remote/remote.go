@@ -4,13 +4,18 @@ import (
 	"github.com/pkg/errors"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote/aws"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/remote/github"
 	"github.com/snyk/driftctl/enumeration/remote/google"
+	"github.com/snyk/driftctl/enumeration/remote/kubernetes"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/traffic"
 )
 
 var supportedRemotes = []string{
@@ -18,6 +23,33 @@ var supportedRemotes = []string{
 	common.RemoteGithubTerraform,
 	common.RemoteGoogleTerraform,
 	common.RemoteAzureTerraform,
+	common.RemoteKubernetesTerraform,
+	common.RemoteCloudflareTerraform,
+}
+
+// captureRemotes are the remotes whose cloud API calls go through an HTTP client that can be
+// recorded and replayed. GCP lists resources through gRPC and is left out.
+var captureRemotes = []string{
+	common.RemoteAWSTerraform,
+	common.RemoteGithubTerraform,
+	common.RemoteAzureTerraform,
+	common.RemoteKubernetesTerraform,
+	common.RemoteCloudflareTerraform,
+}
+
+// IsCaptureSupported tells whether a scan of the remote can be recorded and replayed
+func IsCaptureSupported(remote string) bool {
+	for _, r := range captureRemotes {
+		if r == remote {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCaptureSupportedRemotes returns the remotes a scan can be recorded and replayed for
+func GetCaptureSupportedRemotes() []string {
+	return captureRemotes
 }
 
 func IsSupported(remote string) bool {
@@ -29,16 +61,24 @@ func IsSupported(remote string) bool {
 	return false
 }
 
-func Activate(remote, version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Activate(remote, version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, rateLimits *ratelimit.Controller, repositoryCache cache.Cache, capture *traffic.Capture) error {
+	if capture != nil && !IsCaptureSupported(remote) {
+		return errors.Errorf("record and replay are not supported for remote '%s'", remote)
+	}
+
 	switch remote {
 	case common.RemoteAWSTerraform:
-		return aws.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return aws.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, rateLimits, repositoryCache, capture)
 	case common.RemoteGithubTerraform:
-		return github.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return github.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, rateLimits, repositoryCache, capture)
 	case common.RemoteGoogleTerraform:
-		return google.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return google.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, rateLimits, repositoryCache)
 	case common.RemoteAzureTerraform:
-		return azurerm.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return azurerm.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, rateLimits, repositoryCache, capture)
+	case common.RemoteKubernetesTerraform:
+		return kubernetes.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, rateLimits, repositoryCache, capture)
+	case common.RemoteCloudflareTerraform:
+		return cloudflare.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, rateLimits, repositoryCache, capture)
 
 	default:
 		return errors.Errorf("unsupported remote '%s'", remote)
@@ -0,0 +1,17 @@
+package remote
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/traffic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivate_CaptureUnsupportedRemote(t *testing.T) {
+	capture, err := traffic.NewRecorder(t.TempDir(), common.RemoteGoogleTerraform)
+	assert.NoError(t, err)
+
+	err = Activate(common.RemoteGoogleTerraform, "", nil, nil, nil, nil, nil, "", nil, nil, capture)
+	assert.EqualError(t, err, "record and replay are not supported for remote 'gcp+tf'")
+}
@@ -1,16 +1,20 @@
 package remote
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/github"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/cloudflare/cloudflare-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func HandleResourceEnumerationError(err error, alerter alerter.AlerterInterface) error {
@@ -55,6 +59,23 @@ func HandleResourceEnumerationError(err error, alerter alerter.AlerterInterface)
 		return nil
 	}
 
+	if apierrors.IsForbidden(rootCause) {
+		alerts.SendEnumerationAlert(common.RemoteKubernetesTerraform, alerter, listError)
+		return nil
+	}
+
+	// The cloudflare client returns an AuthenticationError for 403 responses and an AuthorizationError for 401 ones
+	if _, ok := rootCause.(*cloudflare.AuthenticationError); ok {
+		alerts.SendEnumerationAlert(common.RemoteCloudflareTerraform, alerter, listError)
+		return nil
+	}
+
+	// GitHub REST API answers 404 instead of 403 when the token cannot see a resource
+	if restErr, ok := rootCause.(*github.RESTError); ok && (restErr.StatusCode == http.StatusForbidden || restErr.StatusCode == http.StatusNotFound) {
+		alerts.SendEnumerationAlert(common.RemoteGithubTerraform, alerter, listError)
+		return nil
+	}
+
 	return err
 }
 
@@ -8,10 +8,14 @@ import (
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/github"
 
 	resourcegithub "github.com/snyk/driftctl/enumeration/resource/github"
+	resourcekubernetes "github.com/snyk/driftctl/enumeration/resource/kubernetes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/stretchr/testify/assert"
 
@@ -97,6 +101,24 @@ func TestHandleGithubEnumerationErrors(t *testing.T) {
 			wantAlerts: alerter.Alerts{"github_team": []alerter.Alert{alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), "github_team", "github_team"), alerts.EnumerationPhase)}},
 			wantErr:    false,
 		},
+		{
+			name:       "Handled REST forbidden error",
+			err:        remoteerr.NewResourceListingError(&github.RESTError{StatusCode: 403, Message: "Resource not accessible by integration"}, resourcegithub.GithubActionsSecretResourceType),
+			wantAlerts: alerter.Alerts{"github_actions_secret": []alerter.Alert{alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(&github.RESTError{StatusCode: 403, Message: "Resource not accessible by integration"}, "github_actions_secret", "github_actions_secret"), alerts.EnumerationPhase)}},
+			wantErr:    false,
+		},
+		{
+			name:       "Handled REST not found error",
+			err:        remoteerr.NewResourceListingError(&github.RESTError{StatusCode: 404, Message: "Not Found"}, resourcegithub.GithubRepositoryWebhookResourceType),
+			wantAlerts: alerter.Alerts{"github_repository_webhook": []alerter.Alert{alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(&github.RESTError{StatusCode: 404, Message: "Not Found"}, "github_repository_webhook", "github_repository_webhook"), alerts.EnumerationPhase)}},
+			wantErr:    false,
+		},
+		{
+			name:       "Not handled REST error",
+			err:        remoteerr.NewResourceListingError(&github.RESTError{StatusCode: 500, Message: "Server Error"}, resourcegithub.GithubRepositoryWebhookResourceType),
+			wantAlerts: map[string][]alerter.Alert{},
+			wantErr:    true,
+		},
 		{
 			name:       "Not handled graphql error",
 			err:        remoteerr.NewResourceListingError(errors.New("This is a not handler graphql error"), resourcegithub.GithubTeamResourceType),
@@ -265,3 +287,37 @@ func TestResourceScanningErrorMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleKubernetesEnumerationErrors(t *testing.T) {
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "", errors.New("cannot list resource"))
+
+	tests := []struct {
+		name       string
+		err        error
+		wantAlerts alerter.Alerts
+		wantErr    bool
+	}{
+		{
+			name:       "Handled forbidden error",
+			err:        remoteerr.NewResourceListingError(forbidden, resourcekubernetes.KubernetesConfigMapResourceType),
+			wantAlerts: alerter.Alerts{"kubernetes_config_map": []alerter.Alert{alerts.NewRemoteAccessDeniedAlert(common.RemoteKubernetesTerraform, remoteerr.NewResourceListingErrorWithType(forbidden, "kubernetes_config_map", "kubernetes_config_map"), alerts.EnumerationPhase)}},
+			wantErr:    false,
+		},
+		{
+			name:       "Not handled unauthorized error",
+			err:        remoteerr.NewResourceListingError(apierrors.NewUnauthorized("expired token"), resourcekubernetes.KubernetesConfigMapResourceType),
+			wantAlerts: map[string][]alerter.Alert{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alertr := alerter.NewAlerter()
+			gotErr := HandleResourceEnumerationError(tt.err, alertr)
+			assert.Equal(t, tt.wantErr, gotErr != nil)
+
+			retrieve := alertr.Retrieve()
+			assert.Equal(t, tt.wantAlerts, retrieve)
+		})
+	}
+}
@@ -2,10 +2,15 @@ package remote
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/parallel"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
 
@@ -13,33 +18,63 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+var errEnumerationTimeout = errors.New("enumeration timed out")
+
 type Scanner struct {
-	enumeratorRunner *parallel.ParallelRunner
-	remoteLibrary    *common.RemoteLibrary
-	alerter          alerter.AlerterInterface
-	filter           enumeration.Filter
+	lock              sync.Mutex
+	enumeratorRunner  *parallel.ParallelRunner
+	parallelism       int64
+	remoteLibrary     *common.RemoteLibrary
+	alerter           alerter.AlerterInterface
+	filter            enumeration.Filter
+	enumeratorTimeout time.Duration
+	profile           *profile.Profile
 }
 
 func NewScanner(remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInterface, filter enumeration.Filter) *Scanner {
 	return &Scanner{
-		enumeratorRunner: parallel.NewParallelRunner(context.TODO(), 10),
-		remoteLibrary:    remoteLibrary,
-		alerter:          alerter,
-		filter:           filter,
+		parallelism:   ratelimit.DefaultParallelism,
+		remoteLibrary: remoteLibrary,
+		alerter:       alerter,
+		filter:        filter,
+		profile:       profile.New(),
 	}
 }
 
-func (s *Scanner) retrieveRunnerResults(runner *parallel.ParallelRunner) ([]*resource.Resource, error) {
+// SetEnumeratorTimeout sets a deadline for every enumerator, an enumerator that exceeds it
+// is abandoned and an alert is sent instead. Zero means no deadline.
+func (s *Scanner) SetEnumeratorTimeout(timeout time.Duration) {
+	s.enumeratorTimeout = timeout
+}
+
+// SetParallelism sets how many resource types are listed at the same time, it must be called
+// before the scan starts
+func (s *Scanner) SetParallelism(parallelism int64) {
+	s.parallelism = parallelism
+}
+
+// enumerationResult is what an enumerator routine sends to the runner
+type enumerationResult struct {
+	ty        resource.ResourceType
+	resources []*resource.Resource
+}
+
+// retrieveRunnerResults also returns the resource types that were received, so that an
+// interrupted scan knows which types are incomplete
+func (s *Scanner) retrieveRunnerResults(runner *parallel.ParallelRunner) ([]*resource.Resource, map[resource.ResourceType]struct{}, error) {
 	results := make([]*resource.Resource, 0)
+	completed := make(map[resource.ResourceType]struct{})
 loop:
 	for {
 		select {
-		case resources, ok := <-runner.Read():
-			if !ok || resources == nil {
+		case value, ok := <-runner.Read():
+			if !ok || value == nil {
 				break loop
 			}
 
-			for _, res := range resources.([]*resource.Resource) {
+			result := value.(*enumerationResult)
+			completed[result.ty] = struct{}{}
+			for _, res := range result.resources {
 				if res != nil {
 					results = append(results, res)
 				}
@@ -48,10 +83,16 @@ loop:
 			break loop
 		}
 	}
-	return results, runner.Err()
+	return results, completed, runner.Err()
 }
 
-func (s *Scanner) scan() ([]*resource.Resource, error) {
+func (s *Scanner) scan(ctx context.Context) ([]*resource.Resource, error) {
+	runner := parallel.NewParallelRunner(ctx, s.parallelism)
+	s.lock.Lock()
+	s.enumeratorRunner = runner
+	s.lock.Unlock()
+
+	scannedTypes := make([]resource.ResourceType, 0)
 	for _, enum := range s.remoteLibrary.Enumerators() {
 		if s.filter.IsTypeIgnored(enum.SupportedType()) {
 			logrus.WithFields(logrus.Fields{
@@ -59,14 +100,32 @@ func (s *Scanner) scan() ([]*resource.Resource, error) {
 			}).Debug("Ignored enumeration of resources since it is ignored in filter")
 			continue
 		}
+		scannedTypes = append(scannedTypes, enum.SupportedType())
 		enumerator := enum
-		s.enumeratorRunner.Run(func() (interface{}, error) {
-			resources, err := enumerator.Enumerate()
-			if err != nil {
+		runner.Run(func() (interface{}, error) {
+			start := time.Now()
+			resources, err := s.enumerate(profile.WithEnumerator(ctx, s.profile, enumerator.SupportedType().String()), enumerator)
+			s.profile.RecordEnumeration(enumerator.SupportedType().String(), time.Since(start), len(resources))
+			if err == errEnumerationTimeout {
+				logrus.WithFields(logrus.Fields{
+					"type":    enumerator.SupportedType(),
+					"timeout": s.enumeratorTimeout,
+				}).Debug("Enumeration timed out")
+				s.alerter.SendAlert(enumerator.SupportedType().String(), alerts.NewEnumerationTimeoutAlert(enumerator.SupportedType(), s.enumeratorTimeout))
+				return &enumerationResult{ty: enumerator.SupportedType()}, nil
+			}
+			if err != nil && ctx.Err() == nil {
 				err := HandleResourceEnumerationError(err, s.alerter)
-				if err == nil {
-					return []*resource.Resource{}, nil
+				if err != nil {
+					// Do not abort the whole scan, only this resource type is reported as incomplete
+					logrus.WithFields(logrus.Fields{
+						"type": enumerator.SupportedType(),
+					}).Debugf("Enumeration failed: %+v", err)
+					s.alerter.SendAlert(enumerator.SupportedType().String(), alerts.NewIncompleteEnumerationAlert(enumerator.SupportedType(), err))
 				}
+				return &enumerationResult{ty: enumerator.SupportedType()}, nil
+			}
+			if err != nil {
 				return nil, err
 			}
 			for _, res := range resources {
@@ -78,27 +137,97 @@ func (s *Scanner) scan() ([]*resource.Resource, error) {
 					"type": res.ResourceType(),
 				}).Debug("Found cloud resource")
 			}
-			return resources, nil
+			return &enumerationResult{ty: enumerator.SupportedType(), resources: resources}, nil
 		})
 	}
 
-	enumerationResult, err := s.retrieveRunnerResults(s.enumeratorRunner)
+	results, completed, err := s.retrieveRunnerResults(runner)
+	if err == nil {
+		// The runner is done as soon as its parent context is, without reporting it as an error
+		err = ctx.Err()
+	}
 	if err != nil {
-		return nil, err
+		// The scan was interrupted, keep what was already listed and flag every other type
+		logrus.WithField("error", err).Warn("Scan interrupted, results will be partial")
+		for _, ty := range scannedTypes {
+			if _, ok := completed[ty]; !ok {
+				s.alerter.SendAlert(ty.String(), alerts.NewIncompleteEnumerationAlert(ty, err))
+			}
+		}
 	}
 
-	return enumerationResult, nil
+	return results, nil
 }
 
-func (s *Scanner) Resources() ([]*resource.Resource, error) {
-	resources, err := s.scan()
+// enumerate runs the enumerator with the scanner deadline, it returns as soon as the context is done
+// even if the enumerator is still waiting for an API call
+func (s *Scanner) enumerate(parentCtx context.Context, enumerator common.Enumerator) ([]*resource.Resource, error) {
+	ctx := parentCtx
+	if s.enumeratorTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(parentCtx, s.enumeratorTimeout)
+		defer cancel()
+	}
+
+	type result struct {
+		resources []*resource.Resource
+		err       error
+	}
+	// Buffered so that an abandoned enumerator does not leak a blocked goroutine once it returns
+	resultCh := make(chan result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				resultCh <- result{err: errors.Errorf("enumerator for %s paniced: %s", enumerator.SupportedType(), r)}
+			}
+		}()
+		resources, err := enumerator.Enumerate(ctx)
+		resultCh <- result{resources, err}
+	}()
+
+	var res result
+	select {
+	case res = <-resultCh:
+	case <-ctx.Done():
+		res = result{err: ctx.Err()}
+	}
+
+	// The enumerator may also have returned an error wrapping the deadline
+	if res.err != nil && ctx.Err() == context.DeadlineExceeded && parentCtx.Err() == nil {
+		return nil, errEnumerationTimeout
+	}
+	return res.resources, res.err
+}
+
+func (s *Scanner) Resources(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := s.scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return resources, err
 }
 
+// Timings returns the time spent listing each enumerated resource type
+func (s *Scanner) Timings() map[string]time.Duration {
+	report := s.profile.Report()
+	timings := make(map[string]time.Duration, len(report.Enumerators))
+	for _, stats := range report.Enumerators {
+		timings[stats.Type] = stats.Duration
+	}
+	return timings
+}
+
+// Profile returns the time, API calls and resources count of every enumerator and repository method
+func (s *Scanner) Profile() *profile.Report {
+	return s.profile.Report()
+}
+
+// Stop interrupts the running scan, if any
 func (s *Scanner) Stop() {
 	logrus.Debug("Stopping scanner")
-	s.enumeratorRunner.Stop(errors.New("interrupted"))
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.enumeratorRunner != nil {
+		s.enumeratorRunner.Stop(errors.New("interrupted"))
+	}
 }
@@ -1,15 +1,21 @@
 package remote
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 
 	"github.com/snyk/driftctl/enumeration/resource"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestScannerShouldIgnoreType(t *testing.T) {
@@ -27,7 +33,132 @@ func TestScannerShouldIgnoreType(t *testing.T) {
 	testFilter.On("IsTypeIgnored", resource.ResourceType("FakeType")).Return(true)
 
 	s := NewScanner(remoteLibrary, alerter, testFilter)
-	_, err := s.Resources()
+	_, err := s.Resources(context.Background())
 	assert.Nil(t, err)
 	fakeEnumerator.AssertExpectations(t)
 }
+
+func TestScannerEnumeratorTimeout(t *testing.T) {
+	testAlerter := alerter.NewAlerter()
+
+	slowEnumerator := &common.MockEnumerator{}
+	slowEnumerator.On("SupportedType").Return(resource.ResourceType("SlowType"))
+	slowEnumerator.On("Enumerate", mock.Anything).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.DeadlineExceeded)
+
+	fastEnumerator := &common.MockEnumerator{}
+	fastEnumerator.On("SupportedType").Return(resource.ResourceType("FastType"))
+	fastEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "fast", Type: "FastType"}}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(slowEnumerator)
+	remoteLibrary.AddEnumerator(fastEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, testAlerter, testFilter)
+	s.SetEnumeratorTimeout(10 * time.Millisecond)
+	resources, err := s.Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []*resource.Resource{{Id: "fast", Type: "FastType"}}, resources)
+	assert.Equal(t, alerter.Alerts{
+		"SlowType": []alerter.Alert{alerts.NewEnumerationTimeoutAlert("SlowType", 10*time.Millisecond)},
+	}, testAlerter.Retrieve())
+}
+
+func TestScannerInterrupted(t *testing.T) {
+	testAlerter := alerter.NewAlerter()
+
+	blockingEnumerator := &common.MockEnumerator{}
+	blockingEnumerator.On("SupportedType").Return(resource.ResourceType("BlockingType"))
+	blockingEnumerator.On("Enumerate", mock.Anything).Run(func(args mock.Arguments) {
+		time.Sleep(time.Second)
+	}).Return([]*resource.Resource{}, nil)
+
+	fastEnumerator := &common.MockEnumerator{}
+	fastEnumerator.On("SupportedType").Return(resource.ResourceType("FastType"))
+	fastEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "fast", Type: "FastType"}}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(blockingEnumerator)
+	remoteLibrary.AddEnumerator(fastEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	s := NewScanner(remoteLibrary, testAlerter, testFilter)
+	resources, err := s.Resources(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []*resource.Resource{{Id: "fast", Type: "FastType"}}, resources)
+	assert.Equal(t, alerter.Alerts{
+		"BlockingType": []alerter.Alert{alerts.NewIncompleteEnumerationAlert("BlockingType", context.DeadlineExceeded)},
+	}, testAlerter.Retrieve())
+}
+
+func TestScannerEnumeratorFailure(t *testing.T) {
+	testAlerter := alerter.NewAlerter()
+	enumerationErr := errors.New("unexpected error")
+
+	failingEnumerator := &common.MockEnumerator{}
+	failingEnumerator.On("SupportedType").Return(resource.ResourceType("FailingType"))
+	failingEnumerator.On("Enumerate", mock.Anything).Return(nil, enumerationErr)
+
+	fastEnumerator := &common.MockEnumerator{}
+	fastEnumerator.On("SupportedType").Return(resource.ResourceType("FastType"))
+	fastEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "fast", Type: "FastType"}}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(failingEnumerator)
+	remoteLibrary.AddEnumerator(fastEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, testAlerter, testFilter)
+	resources, err := s.Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []*resource.Resource{{Id: "fast", Type: "FastType"}}, resources)
+	assert.Equal(t, alerter.Alerts{
+		"FailingType": []alerter.Alert{alerts.NewIncompleteEnumerationAlert("FailingType", enumerationErr)},
+	}, testAlerter.Retrieve())
+}
+
+func TestScannerProfile(t *testing.T) {
+	testAlerter := alerter.NewAlerter()
+
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Run(func(args mock.Arguments) {
+		ctx, done := profile.Method(args.Get(0).(context.Context), "FakeRepository.ListAll")
+		defer done()
+		profile.RecordAPICall(ctx, 0)
+		profile.RecordAPICall(ctx, 2)
+	}).Return([]*resource.Resource{{Id: "fake1", Type: "FakeType"}, {Id: "fake2", Type: "FakeType"}}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, testAlerter, testFilter)
+	_, err := s.Resources(context.Background())
+	assert.Nil(t, err)
+
+	report := s.Profile()
+	assert.Len(t, report.Enumerators, 1)
+	assert.Equal(t, "FakeType", report.Enumerators[0].Type)
+	assert.Equal(t, 2, report.Enumerators[0].Resources)
+	assert.Equal(t, 2, report.Enumerators[0].APICalls)
+	assert.Equal(t, 2, report.Enumerators[0].Retries)
+	assert.Len(t, report.Methods, 1)
+	assert.Equal(t, "FakeRepository.ListAll", report.Methods[0].Method)
+	assert.Equal(t, 1, report.Methods[0].Calls)
+	assert.Equal(t, 2, report.Methods[0].APICalls)
+	assert.Equal(t, report.Enumerators[0].Duration, s.Timings()["FakeType"])
+}
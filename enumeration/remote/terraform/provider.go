@@ -9,17 +9,16 @@ import (
 	"time"
 
 	"github.com/eapache/go-resiliency/retrier"
-	"github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/plugin/discovery"
 	"github.com/hashicorp/terraform/providers"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	progress2 "github.com/snyk/driftctl/enumeration"
-	"github.com/snyk/driftctl/enumeration/parallel"
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/gocty"
+	"golang.org/x/sync/semaphore"
 )
 
 const EXIT_ERROR = 3
@@ -37,18 +36,18 @@ type TerraformProviderConfig struct {
 type TerraformProvider struct {
 	lock              sync.Mutex
 	providerInstaller *tf.ProviderInstaller
-	grpcProviders     map[string]*plugin.GRPCProvider
+	grpcProviders     map[string]*tf.GRPCProvider
 	schemas           map[string]providers.Schema
 	Config            TerraformProviderConfig
-	runner            *parallel.ParallelRunner
+	reads             *semaphore.Weighted
 	progress          progress2.ProgressCounter
 }
 
 func NewTerraformProvider(installer *tf.ProviderInstaller, config TerraformProviderConfig, progress progress2.ProgressCounter) (*TerraformProvider, error) {
 	p := TerraformProvider{
 		providerInstaller: installer,
-		runner:            parallel.NewParallelRunner(context.TODO(), 10),
-		grpcProviders:     make(map[string]*plugin.GRPCProvider),
+		reads:             semaphore.NewWeighted(10),
+		grpcProviders:     make(map[string]*tf.GRPCProvider),
 		Config:            config,
 		progress:          progress,
 	}
@@ -83,8 +82,10 @@ func (p *TerraformProvider) Schema() map[string]providers.Schema {
 	return p.schemas
 }
 
-func (p *TerraformProvider) Runner() *parallel.ParallelRunner {
-	return p.runner
+// SetParallelism sets how many resources are read at the same time, it must be called before
+// the provider is used
+func (p *TerraformProvider) SetParallelism(parallelism int64) {
+	p.reads = semaphore.NewWeighted(parallelism)
 }
 
 func (p *TerraformProvider) configure(alias string) error {
@@ -142,7 +143,7 @@ func (p *TerraformProvider) configure(alias string) error {
 	return nil
 }
 
-func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value, error) {
+func (p *TerraformProvider) ReadResource(ctx context.Context, args tf.ReadResourceArgs) (*cty.Value, error) {
 
 	logrus.WithFields(logrus.Fields{
 		"id":    args.ID,
@@ -189,13 +190,16 @@ func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value,
 	var newState cty.Value
 	r := retrier.New(retrier.ConstantBackoff(3, 100*time.Millisecond), nil)
 
-	err = r.Run(func() error {
-		resp := p.grpcProviders[alias].ReadResource(providers.ReadResourceRequest{
+	err = r.RunCtx(ctx, func(ctx context.Context) error {
+		resp, err := p.readResource(ctx, alias, providers.ReadResourceRequest{
 			TypeName:     typ,
 			PriorState:   priorState,
 			Private:      []byte{},
 			ProviderMeta: cty.NullVal(cty.DynamicPseudoType),
 		})
+		if err != nil {
+			return err
+		}
 		if resp.Diagnostics.HasErrors() {
 			return resp.Diagnostics.Err()
 		}
@@ -214,6 +218,20 @@ func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value,
 	return &newState, nil
 }
 
+// readResource waits for a free read slot and cancels the call to the plugin when the context is done
+func (p *TerraformProvider) readResource(ctx context.Context, alias string, req providers.ReadResourceRequest) (providers.ReadResourceResponse, error) {
+	if err := p.reads.Acquire(ctx, 1); err != nil {
+		return providers.ReadResourceResponse{}, err
+	}
+	defer p.reads.Release(1)
+
+	resp := p.grpcProviders[alias].ReadResourceWithContext(ctx, req)
+	if ctx.Err() != nil {
+		return providers.ReadResourceResponse{}, ctx.Err()
+	}
+	return resp, nil
+}
+
 func (p *TerraformProvider) Cleanup() {
 	for alias, client := range p.grpcProviders {
 		logrus.WithFields(logrus.Fields{
@@ -0,0 +1,3 @@
+package azurerm
+
+const AzureKubernetesClusterResourceType = "azurerm_kubernetes_cluster"
@@ -0,0 +1,3 @@
+package azurerm
+
+const AzureKubernetesClusterNodePoolResourceType = "azurerm_kubernetes_cluster_node_pool"
@@ -0,0 +1,3 @@
+package azurerm
+
+const AzureLinuxFunctionAppResourceType = "azurerm_linux_function_app"
@@ -0,0 +1,3 @@
+package azurerm
+
+const AzureLinuxWebAppResourceType = "azurerm_linux_web_app"
@@ -0,0 +1,3 @@
+package azurerm
+
+const AzureServicePlanResourceType = "azurerm_service_plan"
@@ -0,0 +1,3 @@
+package cloudflare
+
+const CloudflareFirewallRuleResourceType = "cloudflare_firewall_rule"
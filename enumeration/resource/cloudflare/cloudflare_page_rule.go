@@ -0,0 +1,3 @@
+package cloudflare
+
+const CloudflarePageRuleResourceType = "cloudflare_page_rule"
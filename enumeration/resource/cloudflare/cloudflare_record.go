@@ -0,0 +1,3 @@
+package cloudflare
+
+const CloudflareRecordResourceType = "cloudflare_record"
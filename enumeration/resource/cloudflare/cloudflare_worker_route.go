@@ -0,0 +1,3 @@
+package cloudflare
+
+const CloudflareWorkerRouteResourceType = "cloudflare_worker_route"
@@ -0,0 +1,3 @@
+package cloudflare
+
+const CloudflareZoneResourceType = "cloudflare_zone"
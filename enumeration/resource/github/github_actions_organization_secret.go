@@ -0,0 +1,3 @@
+package github
+
+const GithubActionsOrganizationSecretResourceType = "github_actions_organization_secret"
@@ -0,0 +1,3 @@
+package github
+
+const GithubActionsSecretResourceType = "github_actions_secret"
@@ -0,0 +1,3 @@
+package github
+
+const GithubRepositoryCollaboratorResourceType = "github_repository_collaborator"
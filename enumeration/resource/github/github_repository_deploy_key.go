@@ -0,0 +1,3 @@
+package github
+
+const GithubRepositoryDeployKeyResourceType = "github_repository_deploy_key"
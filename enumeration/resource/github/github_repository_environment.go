@@ -0,0 +1,3 @@
+package github
+
+const GithubRepositoryEnvironmentResourceType = "github_repository_environment"
@@ -0,0 +1,3 @@
+package github
+
+const GithubRepositoryRulesetResourceType = "github_repository_ruleset"
@@ -0,0 +1,3 @@
+package github
+
+const GithubRepositoryWebhookResourceType = "github_repository_webhook"
@@ -0,0 +1,3 @@
+package google
+
+const GoogleContainerClusterResourceType = "google_container_cluster"
@@ -0,0 +1,3 @@
+package google
+
+const GoogleContainerNodePoolResourceType = "google_container_node_pool"
@@ -0,0 +1,3 @@
+package google
+
+const GoogleKMSCryptoKeyResourceType = "google_kms_crypto_key"
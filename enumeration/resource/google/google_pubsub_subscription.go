@@ -0,0 +1,3 @@
+package google
+
+const GooglePubsubSubscriptionResourceType = "google_pubsub_subscription"
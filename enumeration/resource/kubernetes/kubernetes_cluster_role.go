@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesClusterRoleResourceType = "kubernetes_cluster_role"
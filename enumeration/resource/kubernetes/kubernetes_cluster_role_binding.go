@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesClusterRoleBindingResourceType = "kubernetes_cluster_role_binding"
@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesConfigMapResourceType = "kubernetes_config_map"
@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesDeploymentResourceType = "kubernetes_deployment"
@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesNamespaceResourceType = "kubernetes_namespace"
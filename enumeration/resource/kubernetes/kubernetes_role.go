@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesRoleResourceType = "kubernetes_role"
@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesRoleBindingResourceType = "kubernetes_role_binding"
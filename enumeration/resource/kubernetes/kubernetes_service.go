@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesServiceResourceType = "kubernetes_service"
@@ -0,0 +1,3 @@
+package kubernetes
+
+const KubernetesServiceAccountResourceType = "kubernetes_service_account"
@@ -1,11 +1,23 @@
 package resource
 
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/profile"
+)
+
 // Supplier supply the list of resource.Resource, it's the main interface to retrieve remote resources
 type Supplier interface {
-	Resources() ([]*Resource, error)
+	Resources(ctx context.Context) ([]*Resource, error)
 }
 
 type StoppableSupplier interface {
 	Supplier
 	Stop()
 }
+
+// ProfiledSupplier reports what retrieving resources cost
+type ProfiledSupplier interface {
+	Supplier
+	Profile() *profile.Report
+}
@@ -0,0 +1,231 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/plugin"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/msgpack"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const readResourceMethod = "/tfplugin5.Provider/ReadResource"
+
+// Field numbers of the tfplugin5 messages used by ReadResourceWithContext
+const (
+	readResourceRequestTypeName     protowire.Number = 1
+	readResourceRequestCurrentState protowire.Number = 2
+	readResourceRequestPrivate      protowire.Number = 3
+
+	readResourceResponseNewState    protowire.Number = 1
+	readResourceResponseDiagnostics protowire.Number = 2
+	readResourceResponsePrivate     protowire.Number = 3
+
+	dynamicValueMsgpack protowire.Number = 1
+
+	diagnosticSeverity protowire.Number = 1
+	diagnosticSummary  protowire.Number = 2
+	diagnosticDetail   protowire.Number = 3
+
+	diagnosticSeverityError   = 1
+	diagnosticSeverityWarning = 2
+)
+
+// GRPCProvider is the terraform client of a provider plugin. Every call made by plugin.GRPCProvider
+// is bound to the plugin lifetime, so it also keeps the plugin connection to read resources with
+// a context that can be canceled.
+type GRPCProvider struct {
+	*plugin.GRPCProvider
+	conn *grpc.ClientConn
+}
+
+// ReadResourceWithContext behaves like ReadResource but the call to the plugin is canceled with ctx
+func (p *GRPCProvider) ReadResourceWithContext(ctx context.Context, r providers.ReadResourceRequest) (resp providers.ReadResourceResponse) {
+	resSchema, exist := p.GetSchema().ResourceTypes[r.TypeName]
+	if !exist || resSchema.Block == nil {
+		resp.Diagnostics = resp.Diagnostics.Append(errors.Errorf("unknown resource type %s", r.TypeName))
+		return resp
+	}
+	return readResource(ctx, p.conn, resSchema, r)
+}
+
+func readResource(ctx context.Context, conn *grpc.ClientConn, resSchema providers.Schema, r providers.ReadResourceRequest) (resp providers.ReadResourceResponse) {
+	ty := resSchema.Block.ImpliedType()
+
+	mp, err := msgpack.Marshal(r.PriorState, ty)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	var req []byte
+	req = protowire.AppendTag(req, readResourceRequestTypeName, protowire.BytesType)
+	req = protowire.AppendString(req, r.TypeName)
+	req = protowire.AppendTag(req, readResourceRequestCurrentState, protowire.BytesType)
+	req = protowire.AppendBytes(req, appendDynamicValue(nil, mp))
+	req = protowire.AppendTag(req, readResourceRequestPrivate, protowire.BytesType)
+	req = protowire.AppendBytes(req, r.Private)
+
+	var raw []byte
+	if err := conn.Invoke(ctx, readResourceMethod, &req, &raw, grpc.ForceCodec(rawCodec{})); err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	newState, private, diags, err := parseReadResourceResponse(raw)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+	resp.Diagnostics = diags
+
+	resp.NewState = cty.NullVal(ty)
+	if newState != nil {
+		resp.NewState, err = msgpack.Unmarshal(newState, ty)
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(err)
+			return resp
+		}
+	}
+	resp.Private = private
+
+	return resp
+}
+
+func appendDynamicValue(b, mp []byte) []byte {
+	b = protowire.AppendTag(b, dynamicValueMsgpack, protowire.BytesType)
+	return protowire.AppendBytes(b, mp)
+}
+
+// parseReadResourceResponse returns the msgpack encoded new state, which is nil when the
+// resource does not exist anymore, the private data and the diagnostics of the response
+func parseReadResourceResponse(b []byte) ([]byte, []byte, tfdiags.Diagnostics, error) {
+	var newState, private []byte
+	var diags tfdiags.Diagnostics
+
+	err := consumeFields(b, func(num protowire.Number, value []byte) error {
+		switch num {
+		case readResourceResponseNewState:
+			newState = []byte{}
+			return consumeFields(value, func(num protowire.Number, value []byte) error {
+				if num == dynamicValueMsgpack {
+					newState = value
+				}
+				return nil
+			})
+		case readResourceResponseDiagnostics:
+			diag, err := parseDiagnostic(value)
+			if err != nil {
+				return err
+			}
+			diags = diags.Append(diag)
+		case readResourceResponsePrivate:
+			private = value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// A DynamicValue with no msgpack field is a null state
+	if len(newState) == 0 {
+		newState = nil
+	}
+
+	return newState, private, diags, nil
+}
+
+func parseDiagnostic(b []byte) (tfdiags.Diagnostic, error) {
+	var severity tfdiags.Severity
+	var summary, detail string
+
+	err := consumeFields(b, func(num protowire.Number, value []byte) error {
+		switch num {
+		case diagnosticSeverity:
+			v, n := protowire.ConsumeVarint(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			switch v {
+			case diagnosticSeverityError:
+				severity = tfdiags.Error
+			case diagnosticSeverityWarning:
+				severity = tfdiags.Warning
+			}
+		case diagnosticSummary:
+			summary = string(value)
+		case diagnosticDetail:
+			detail = string(value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tfdiags.WholeContainingBody(severity, summary, detail), nil
+}
+
+// consumeFields calls fn with the raw value of every field of a message, varints are passed
+// as their encoded bytes
+func consumeFields(b []byte, fn func(num protowire.Number, value []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var value []byte
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n >= 0 {
+				value = b[:n]
+			}
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if err := fn(num, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rawCodec sends and receives already encoded messages, it keeps the proto name so that
+// the plugin decodes them with its own codec
+type rawCodec struct{}
+
+var _ encoding.Codec = rawCodec{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, errors.Errorf("unexpected message type %T", v)
+	}
+	return *b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return errors.Errorf("unexpected message type %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
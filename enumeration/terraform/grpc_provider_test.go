@@ -0,0 +1,182 @@
+package terraform
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/msgpack"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+var testResourceSchema = providers.Schema{
+	Block: &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Optional: true},
+		},
+	},
+}
+
+// startTestPlugin serves handler for every call and returns a connection to it
+func startTestPlugin(t *testing.T, handler func(ctx context.Context, method string, req []byte) ([]byte, error)) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+			method, _ := grpc.MethodFromServerStream(stream)
+			var req []byte
+			if err := stream.RecvMsg(&req); err != nil {
+				return err
+			}
+			resp, err := handler(stream.Context(), method, req)
+			if err != nil {
+				return err
+			}
+			return stream.SendMsg(&resp)
+		}),
+	)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return conn
+}
+
+func TestReadResource(t *testing.T) {
+	priorState := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("foo"),
+		"name": cty.NullVal(cty.String),
+	})
+	newState := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("foo"),
+		"name": cty.StringVal("bar"),
+	})
+
+	conn := startTestPlugin(t, func(_ context.Context, method string, req []byte) ([]byte, error) {
+		assert.Equal(t, readResourceMethod, method)
+
+		var typeName string
+		var currentState []byte
+		err := consumeFields(req, func(num protowire.Number, value []byte) error {
+			switch num {
+			case readResourceRequestTypeName:
+				typeName = string(value)
+			case readResourceRequestCurrentState:
+				return consumeFields(value, func(_ protowire.Number, value []byte) error {
+					currentState = value
+					return nil
+				})
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "test_resource", typeName)
+		state, err := msgpack.Unmarshal(currentState, testResourceSchema.Block.ImpliedType())
+		require.NoError(t, err)
+		assert.True(t, priorState.RawEquals(state))
+
+		mp, err := msgpack.Marshal(newState, testResourceSchema.Block.ImpliedType())
+		require.NoError(t, err)
+
+		var diag []byte
+		diag = protowire.AppendTag(diag, diagnosticSeverity, protowire.VarintType)
+		diag = protowire.AppendVarint(diag, diagnosticSeverityWarning)
+		diag = protowire.AppendTag(diag, diagnosticSummary, protowire.BytesType)
+		diag = protowire.AppendString(diag, "deprecated attribute")
+
+		var resp []byte
+		resp = protowire.AppendTag(resp, readResourceResponseNewState, protowire.BytesType)
+		resp = protowire.AppendBytes(resp, appendDynamicValue(nil, mp))
+		resp = protowire.AppendTag(resp, readResourceResponseDiagnostics, protowire.BytesType)
+		resp = protowire.AppendBytes(resp, diag)
+		resp = protowire.AppendTag(resp, readResourceResponsePrivate, protowire.BytesType)
+		resp = protowire.AppendBytes(resp, []byte(`{"schema_version":"1"}`))
+		return resp, nil
+	})
+
+	resp := readResource(context.Background(), conn, testResourceSchema, providers.ReadResourceRequest{
+		TypeName:   "test_resource",
+		PriorState: priorState,
+		Private:    []byte{},
+	})
+
+	assert.False(t, resp.Diagnostics.HasErrors())
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, "deprecated attribute", resp.Diagnostics[0].Description().Summary)
+	assert.True(t, newState.RawEquals(resp.NewState))
+	assert.Equal(t, []byte(`{"schema_version":"1"}`), resp.Private)
+}
+
+func TestReadResource_NullState(t *testing.T) {
+	conn := startTestPlugin(t, func(_ context.Context, _ string, _ []byte) ([]byte, error) {
+		var diag []byte
+		diag = protowire.AppendTag(diag, diagnosticSeverity, protowire.VarintType)
+		diag = protowire.AppendVarint(diag, diagnosticSeverityError)
+		diag = protowire.AppendTag(diag, diagnosticSummary, protowire.BytesType)
+		diag = protowire.AppendString(diag, "access denied")
+
+		var resp []byte
+		resp = protowire.AppendTag(resp, readResourceResponseDiagnostics, protowire.BytesType)
+		resp = protowire.AppendBytes(resp, diag)
+		return resp, nil
+	})
+
+	resp := readResource(context.Background(), conn, testResourceSchema, providers.ReadResourceRequest{
+		TypeName:   "test_resource",
+		PriorState: cty.NullVal(testResourceSchema.Block.ImpliedType()),
+	})
+
+	assert.True(t, resp.NewState.IsNull())
+	assert.EqualError(t, resp.Diagnostics.Err(), "access denied")
+}
+
+func TestReadResource_Canceled(t *testing.T) {
+	received := make(chan struct{})
+	canceled := make(chan struct{})
+	conn := startTestPlugin(t, func(ctx context.Context, _ string, _ []byte) ([]byte, error) {
+		close(received)
+		<-ctx.Done()
+		close(canceled)
+		return nil, ctx.Err()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+
+	resp := readResource(ctx, conn, testResourceSchema, providers.ReadResourceRequest{
+		TypeName:   "test_resource",
+		PriorState: cty.NullVal(testResourceSchema.Block.ImpliedType()),
+	})
+	assert.True(t, resp.Diagnostics.HasErrors())
+
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the call to the plugin was not canceled")
+	}
+}
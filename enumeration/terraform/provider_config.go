@@ -6,8 +6,11 @@ import (
 )
 
 type ProviderConfig struct {
-	Key       string
-	Version   string
+	Key     string
+	Version string
+	// Namespace is the registry namespace of providers that are not published by hashicorp,
+	// those providers are downloaded from the GitHub releases of <namespace>/terraform-provider-<key>
+	Namespace string
 	ConfigDir string
 }
 
@@ -16,6 +19,18 @@ func (c *ProviderConfig) GetDownloadUrl() string {
 	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
 		arch = "amd64"
 	}
+	if c.Namespace != "" && c.Namespace != "hashicorp" {
+		return fmt.Sprintf(
+			"https://github.com/%s/terraform-provider-%s/releases/download/v%s/terraform-provider-%s_%s_%s_%s.zip",
+			c.Namespace,
+			c.Key,
+			c.Version,
+			c.Key,
+			c.Version,
+			runtime.GOOS,
+			arch,
+		)
+	}
 	return fmt.Sprintf(
 		"https://releases.hashicorp.com/terraform-provider-%s/%s/terraform-provider-%s_%s_%s_%s.zip",
 		c.Key,
@@ -52,9 +52,10 @@ func TestProviderConfig_GetDownloadUrl(t *testing.T) {
 		arch = "amd64"
 	}
 	type fields struct {
-		Key     string
-		Version string
-		Postfix string
+		Key       string
+		Version   string
+		Namespace string
+		Postfix   string
 	}
 	tests := []struct {
 		name   string
@@ -73,12 +74,26 @@ func TestProviderConfig_GetDownloadUrl(t *testing.T) {
 				arch,
 			),
 		},
+		{
+			name: "test for cloudflare provider",
+			fields: fields{
+				Key:       "cloudflare",
+				Version:   "3.35.0",
+				Namespace: "cloudflare",
+			},
+			want: fmt.Sprintf(
+				"https://github.com/cloudflare/terraform-provider-cloudflare/releases/download/v3.35.0/terraform-provider-cloudflare_3.35.0_%s_%s.zip",
+				runtime.GOOS,
+				arch,
+			),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &ProviderConfig{
-				Key:     tt.fields.Key,
-				Version: tt.fields.Version,
+				Key:       tt.fields.Key,
+				Version:   tt.fields.Version,
+				Namespace: tt.fields.Namespace,
 			}
 			if got := c.GetDownloadUrl(); got != tt.want {
 				t.Errorf("GetDownloadUrl() = %v, want %v", got, tt.want)
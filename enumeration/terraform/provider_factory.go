@@ -1,11 +1,13 @@
 package terraform
 
 import (
+	goplugin "github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/plugin/discovery"
+	"github.com/pkg/errors"
 )
 
-func NewGRPCProvider(meta discovery.PluginMeta) (*plugin.GRPCProvider, error) {
+func NewGRPCProvider(meta discovery.PluginMeta) (*GRPCProvider, error) {
 	client := Client(meta)
 	// Request the RPC terraformProvider so we can get the provider
 	// so we can build the actual RPC-implemented provider.
@@ -18,8 +20,17 @@ func NewGRPCProvider(meta discovery.PluginMeta) (*plugin.GRPCProvider, error) {
 		return nil, err
 	}
 
-	GRPCProvider := raw.(*plugin.GRPCProvider)
-	GRPCProvider.PluginClient = client
+	grpcClient, ok := rpcClient.(*goplugin.GRPCClient)
+	if !ok {
+		client.Kill()
+		return nil, errors.Errorf("unexpected plugin protocol for %s", meta.Path)
+	}
+
+	provider := raw.(*plugin.GRPCProvider)
+	provider.PluginClient = client
 
-	return GRPCProvider, nil
+	return &GRPCProvider{
+		GRPCProvider: provider,
+		conn:         grpcClient.Conn,
+	}, nil
 }
@@ -5,10 +5,12 @@ import (
 )
 
 const (
-	AWS    string = "aws"
-	GITHUB string = "github"
-	GOOGLE string = "google"
-	AZURE  string = "azurerm"
+	AWS        string = "aws"
+	GITHUB     string = "github"
+	GOOGLE     string = "google"
+	AZURE      string = "azurerm"
+	KUBERNETES string = "kubernetes"
+	CLOUDFLARE string = "cloudflare"
 )
 
 type ProviderLibrary struct {
@@ -1,13 +1,15 @@
 package terraform
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/resource"
 
 	"github.com/zclconf/go-cty/cty"
 )
 
 type ResourceReader interface {
-	ReadResource(args ReadResourceArgs) (*cty.Value, error)
+	ReadResource(ctx context.Context, args ReadResourceArgs) (*cty.Value, error)
 }
 
 type ReadResourceArgs struct {
@@ -0,0 +1,129 @@
+package traffic
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const manifestFilename = "capture.json"
+
+type manifest struct {
+	Remote          string            `json:"remote"`
+	ProviderVersion string            `json:"provider_version,omitempty"`
+	Settings        map[string]string `json:"settings,omitempty"`
+}
+
+// Capture records every cloud API call and terraform provider response of a scan into a directory,
+// or replays them from it so that the scan runs offline.
+// A nil Capture neither records nor replays.
+type Capture struct {
+	dir      string
+	replay   bool
+	lock     sync.Mutex
+	manifest manifest
+}
+
+// NewRecorder creates a capture of the given remote in dir, a previous capture in dir is overwritten
+func NewRecorder(dir, remote string) (*Capture, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "unable to create capture directory")
+	}
+	c := &Capture{
+		dir:      dir,
+		manifest: manifest{Remote: remote, Settings: map[string]string{}},
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.writeManifest(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewReplayer opens a capture made by NewRecorder, it must have been recorded for the same remote
+func NewReplayer(dir, remote string) (*Capture, error) {
+	content, err := os.ReadFile(filepath.Join(dir, manifestFilename))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read capture in %s", dir)
+	}
+	c := &Capture{dir: dir, replay: true}
+	if err := json.Unmarshal(content, &c.manifest); err != nil {
+		return nil, errors.Wrapf(err, "unable to read capture in %s", dir)
+	}
+	if c.manifest.Remote != remote {
+		return nil, errors.Errorf("capture in %s was recorded for %s, not %s", dir, c.manifest.Remote, remote)
+	}
+	return c, nil
+}
+
+func (c *Capture) Replaying() bool {
+	return c != nil && c.replay
+}
+
+// Setting returns the recorded value of a setting the requests depend on, such as the region.
+// While recording, value is recorded and returned as is.
+func (c *Capture) Setting(key, value string) string {
+	if c == nil {
+		return value
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.replay {
+		return c.manifest.Settings[key]
+	}
+	c.manifest.Settings[key] = value
+	if err := c.writeManifest(); err != nil {
+		logrus.WithFields(logrus.Fields{"setting": key, "error": err}).Warn("Unable to record capture setting")
+	}
+	return value
+}
+
+// ProviderVersion returns the version of the terraform provider the capture was recorded with
+func (c *Capture) ProviderVersion() string {
+	if c == nil {
+		return ""
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.manifest.ProviderVersion
+}
+
+func (c *Capture) setProviderVersion(version string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.manifest.ProviderVersion = version
+	return c.writeManifest()
+}
+
+func (c *Capture) writeManifest() error {
+	content, err := json.MarshalIndent(c.manifest, "", " ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(c.dir, manifestFilename), content, 0600)
+}
+
+func (c *Capture) write(path string, v interface{}) error {
+	content, err := json.MarshalIndent(v, "", " ")
+	if err != nil {
+		return err
+	}
+	path = filepath.Join(c.dir, path)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0600)
+}
+
+func (c *Capture) read(path string, v interface{}) error {
+	content, err := os.ReadFile(filepath.Join(c.dir, path))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, v)
+}
@@ -0,0 +1,41 @@
+package traffic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapture_Settings(t *testing.T) {
+	dir := t.TempDir()
+
+	recorder, err := NewRecorder(dir, "aws+tf")
+	assert.NoError(t, err)
+	assert.False(t, recorder.Replaying())
+	assert.Equal(t, "us-east-1", recorder.Setting("aws.region", "us-east-1"))
+
+	replayer, err := NewReplayer(dir, "aws+tf")
+	assert.NoError(t, err)
+	assert.True(t, replayer.Replaying())
+	assert.Equal(t, "us-east-1", replayer.Setting("aws.region", "eu-west-3"))
+}
+
+func TestNewReplayer_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewReplayer(dir, "aws+tf")
+	assert.ErrorContains(t, err, "unable to read capture in "+dir)
+
+	_, err = NewRecorder(dir, "github+tf")
+	assert.NoError(t, err)
+	_, err = NewReplayer(dir, "aws+tf")
+	assert.EqualError(t, err, "capture in "+dir+" was recorded for github+tf, not aws+tf")
+}
+
+func TestCapture_Nil(t *testing.T) {
+	var capture *Capture
+	assert.False(t, capture.Replaying())
+	assert.Equal(t, "us-east-1", capture.Setting("aws.region", "us-east-1"))
+	assert.Equal(t, "", capture.ProviderVersion())
+	assert.Nil(t, capture.Transport("aws", nil))
+}
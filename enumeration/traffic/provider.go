@@ -0,0 +1,157 @@
+package traffic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+	"sort"
+
+	"github.com/hashicorp/terraform/providers"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+const schemaFilename = "schema.json"
+
+// readResult is a recorded ReadResource response
+type readResult struct {
+	Type  json.RawMessage `json:"type,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Err   *string         `json:"error,omitempty"`
+}
+
+type provider struct {
+	capture  *Capture
+	provider terraform.TerraformProvider
+	// schema is the recorded one when replaying
+	schema map[string]providers.Schema
+}
+
+// Provider records the schema and the resources read by a terraform provider, or answers from the capture
+// when replaying, in which case the provider is never started
+func (c *Capture) Provider(p terraform.TerraformProvider) (terraform.TerraformProvider, error) {
+	if c == nil {
+		return p, nil
+	}
+	if c.replay {
+		schema := map[string]providers.Schema{}
+		if err := c.read(schemaFilename, &schema); err != nil {
+			return nil, errors.Wrap(err, "unable to read recorded provider schema")
+		}
+		return &provider{capture: c, provider: p, schema: schema}, nil
+	}
+	if err := c.setProviderVersion(p.Version()); err != nil {
+		return nil, err
+	}
+	return &provider{capture: c, provider: p}, nil
+}
+
+func (p *provider) Schema() map[string]providers.Schema {
+	if p.capture.replay {
+		return p.schema
+	}
+	schema := p.provider.Schema()
+	if err := p.capture.write(schemaFilename, schema); err != nil {
+		logrus.WithField("error", err).Warn("Unable to record provider schema, the capture will not be replayable")
+	}
+	return schema
+}
+
+func (p *provider) ReadResource(ctx context.Context, args terraform.ReadResourceArgs) (*cty.Value, error) {
+	file := path.Join("resources", string(args.Ty), readResourceKey(args)+".json")
+
+	if p.capture.replay {
+		recorded := readResult{}
+		if err := p.capture.read(file, &recorded); err != nil {
+			return nil, errors.Errorf("no recorded response for %s %s", args.Ty, args.ID)
+		}
+		return recorded.value()
+	}
+
+	value, err := p.provider.ReadResource(ctx, args)
+	recorded, recordErr := newReadResult(value, err)
+	if recordErr == nil {
+		recordErr = p.capture.write(file, recorded)
+	}
+	if recordErr != nil {
+		return nil, errors.Wrapf(recordErr, "unable to record %s %s", args.Ty, args.ID)
+	}
+	return value, err
+}
+
+func (p *provider) Cleanup() {
+	if !p.capture.replay {
+		p.provider.Cleanup()
+	}
+}
+
+func (p *provider) Name() string {
+	return p.provider.Name()
+}
+
+func (p *provider) Version() string {
+	if p.capture.replay {
+		return p.capture.ProviderVersion()
+	}
+	return p.provider.Version()
+}
+
+func newReadResult(value *cty.Value, err error) (readResult, error) {
+	result := readResult{}
+	if value != nil {
+		var marshalErr error
+		if result.Type, marshalErr = ctyjson.MarshalType(value.Type()); marshalErr != nil {
+			return result, marshalErr
+		}
+		if result.Value, marshalErr = ctyjson.Marshal(*value, value.Type()); marshalErr != nil {
+			return result, marshalErr
+		}
+	}
+	if err != nil {
+		msg := err.Error()
+		result.Err = &msg
+	}
+	return result, nil
+}
+
+func (r readResult) value() (*cty.Value, error) {
+	var value *cty.Value
+	if r.Type != nil {
+		ty, err := ctyjson.UnmarshalType(r.Type)
+		if err != nil {
+			return nil, err
+		}
+		val, err := ctyjson.Unmarshal(r.Value, ty)
+		if err != nil {
+			return nil, err
+		}
+		value = &val
+	}
+	if r.Err != nil {
+		return value, errors.New(*r.Err)
+	}
+	return value, nil
+}
+
+func readResourceKey(args terraform.ReadResourceArgs) string {
+	keys := make([]string, 0, len(args.Attributes))
+	for k := range args.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	hash.Write([]byte(args.ID))
+	for _, k := range keys {
+		hash.Write([]byte{0})
+		hash.Write([]byte(k))
+		hash.Write([]byte{0})
+		hash.Write([]byte(args.Attributes[k]))
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:32]
+}
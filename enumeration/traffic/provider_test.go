@@ -0,0 +1,86 @@
+package traffic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestProvider_RecordAndReplay(t *testing.T) {
+	schema := map[string]providers.Schema{
+		"aws_s3_bucket": {
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"bucket": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	}
+	bucket := cty.ObjectVal(map[string]cty.Value{"bucket": cty.StringVal("my-bucket")})
+	bucketArgs := terraform.ReadResourceArgs{Ty: "aws_s3_bucket", ID: "my-bucket", Attributes: map[string]string{"region": "us-east-1"}}
+	missingArgs := terraform.ReadResourceArgs{Ty: "aws_s3_bucket", ID: "missing"}
+
+	realProvider := &terraform.MockTerraformProvider{}
+	realProvider.On("Version").Return("3.19.0")
+	realProvider.On("Schema").Return(schema)
+	realProvider.On("ReadResource", mock.Anything, bucketArgs).Return(&bucket, nil)
+	realProvider.On("ReadResource", mock.Anything, missingArgs).Return(nil, errors.New("not found"))
+	realProvider.On("Cleanup").Return()
+
+	dir := t.TempDir()
+	recorder, err := NewRecorder(dir, "aws+tf")
+	assert.NoError(t, err)
+	provider, err := recorder.Provider(realProvider)
+	assert.NoError(t, err)
+	assert.Equal(t, schema, provider.Schema())
+	_, err = provider.ReadResource(context.Background(), bucketArgs)
+	assert.NoError(t, err)
+	_, err = provider.ReadResource(context.Background(), missingArgs)
+	assert.EqualError(t, err, "not found")
+	provider.Cleanup()
+	realProvider.AssertExpectations(t)
+
+	unstartedProvider := &terraform.MockTerraformProvider{}
+	unstartedProvider.On("Name").Return("aws")
+
+	replayer, err := NewReplayer(dir, "aws+tf")
+	assert.NoError(t, err)
+	provider, err = replayer.Provider(unstartedProvider)
+	assert.NoError(t, err)
+	assert.Equal(t, "aws", provider.Name())
+	assert.Equal(t, "3.19.0", provider.Version())
+	assert.Equal(t, schema, provider.Schema())
+
+	got, err := provider.ReadResource(context.Background(), bucketArgs)
+	assert.NoError(t, err)
+	assert.True(t, bucket.RawEquals(*got))
+
+	got, err = provider.ReadResource(context.Background(), missingArgs)
+	assert.Nil(t, got)
+	assert.EqualError(t, err, "not found")
+
+	_, err = provider.ReadResource(context.Background(), terraform.ReadResourceArgs{Ty: "aws_s3_bucket", ID: "my-bucket"})
+	assert.EqualError(t, err, "no recorded response for aws_s3_bucket my-bucket")
+
+	// The provider was never started, there is nothing to stop
+	provider.Cleanup()
+	unstartedProvider.AssertExpectations(t)
+}
+
+func TestProvider_ReplayWithoutSchema(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewRecorder(dir, "aws+tf")
+	assert.NoError(t, err)
+
+	replayer, err := NewReplayer(dir, "aws+tf")
+	assert.NoError(t, err)
+	_, err = replayer.Provider(&terraform.MockTerraformProvider{})
+	assert.ErrorContains(t, err, "unable to read recorded provider schema")
+}
@@ -0,0 +1,141 @@
+package traffic
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+)
+
+// interaction is a recorded HTTP exchange, request headers are left out since they hold credentials
+type interaction struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody string      `json:"request_body,omitempty"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        string      `json:"body,omitempty"`
+	BodyBase64  []byte      `json:"body_base64,omitempty"`
+}
+
+// MissingInteractionError is returned on replay for a request that was not recorded, it is
+// not temporary so that SDKs do not retry it
+type MissingInteractionError struct {
+	Method string
+	URL    string
+}
+
+func (e *MissingInteractionError) Error() string {
+	return fmt.Sprintf("no recorded response for %s %s", e.Method, e.URL)
+}
+
+func (e *MissingInteractionError) Temporary() bool {
+	return false
+}
+
+type transport struct {
+	capture *Capture
+	service string
+	base    http.RoundTripper
+}
+
+// Transport records the requests sent through base, or answers them from the capture when replaying.
+// Requests are told apart by their method, URL and body, service only groups them on disk.
+func (c *Capture) Transport(service string, base http.RoundTripper) http.RoundTripper {
+	if c == nil {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{capture: c, service: service, base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	file := path.Join("http", t.service, interactionKey(req.Method, req.URL.String(), requestBody)+".json")
+
+	if t.capture.replay {
+		recorded := interaction{}
+		if err := t.capture.read(file, &recorded); err != nil {
+			logrus.WithFields(logrus.Fields{"file": file, "error": err}).Debug("Unable to read recorded interaction")
+			return nil, &MissingInteractionError{Method: req.Method, URL: req.URL.String()}
+		}
+		return recorded.response(req), nil
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	recorded := interaction{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		RequestBody: string(requestBody),
+		StatusCode:  res.StatusCode,
+		Header:      res.Header,
+	}
+	if utf8.Valid(body) {
+		recorded.Body = string(body)
+	} else {
+		recorded.BodyBase64 = body
+	}
+	if err := t.capture.write(file, recorded); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i interaction) response(req *http.Request) *http.Response {
+	body := []byte(i.Body)
+	if i.BodyBase64 != nil {
+		body = i.BodyBase64
+	}
+	header := i.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func interactionKey(method, url string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(url))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))[:32]
+}
@@ -0,0 +1,92 @@
+package traffic
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if string(body) == "page=2" {
+			_, _ = w.Write([]byte(`{"page": 2}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte{0xff, 0xfe})
+	}))
+
+	dir := t.TempDir()
+	recorder, err := NewRecorder(dir, "aws+tf")
+	assert.NoError(t, err)
+	client := &http.Client{Transport: recorder.Transport("aws", nil)}
+
+	send := func(client *http.Client, body string) (*http.Response, error) {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/list", strings.NewReader(body))
+		req.Header.Set("Authorization", "secret")
+		return client.Do(req)
+	}
+
+	res, err := send(client, "page=2")
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(res.Body)
+	assert.Equal(t, `{"page": 2}`, string(body), "recorded response should still be readable")
+	res, err = send(client, "page=1")
+	assert.NoError(t, err)
+	res.Body.Close()
+
+	// Replay must not need the server anymore
+	server.Close()
+
+	replayer, err := NewReplayer(dir, "aws+tf")
+	assert.NoError(t, err)
+	client = &http.Client{Transport: replayer.Transport("aws", nil)}
+
+	res, err = send(client, "page=2")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+	body, _ = io.ReadAll(res.Body)
+	assert.Equal(t, `{"page": 2}`, string(body))
+
+	res, err = send(client, "page=1")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	body, _ = io.ReadAll(res.Body)
+	assert.Equal(t, []byte{0xff, 0xfe}, body)
+
+	_, err = send(client, "page=3")
+	assert.ErrorContains(t, err, "no recorded response for POST "+server.URL+"/list")
+}
+
+func TestTransport_CredentialsAreNotRecorded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	recorder, err := NewRecorder(dir, "aws+tf")
+	assert.NoError(t, err)
+	client := &http.Client{Transport: recorder.Transport("aws", nil)}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("Authorization", "secret")
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	res.Body.Close()
+
+	file := "http/aws/" + interactionKey(http.MethodGet, server.URL, nil) + ".json"
+	recorded := interaction{}
+	assert.NoError(t, recorder.read(file, &recorded))
+	assert.Equal(t, server.URL, recorded.URL)
+	content, _ := os.ReadFile(filepath.Join(dir, file))
+	assert.NotContains(t, string(content), "secret")
+}
@@ -76,6 +76,14 @@ func run() int {
 		if _, isNotInSync := err.(cmderrors.InfrastructureNotInSync); isNotInSync {
 			return scan.EXIT_NOT_IN_SYNC
 		}
+		if _, isPartial := err.(cmderrors.PartialScan); isPartial {
+			_, _ = fmt.Fprintln(os.Stderr, color.YellowString("%s", err))
+			return scan.EXIT_PARTIAL
+		}
+		if _, isDuplicate := err.(cmderrors.DuplicateOwnership); isDuplicate {
+			_, _ = fmt.Fprintln(os.Stderr, color.RedString("%s", err))
+			return scan.EXIT_DUPLICATE_OWNERSHIP
+		}
 		if cmd.IsReportingEnabled(&driftctlCmd.Command) {
 			sentry.CaptureException(err)
 		}
@@ -3,10 +3,12 @@ package analyser
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
 
 	"github.com/snyk/driftctl/enumeration/resource"
 )
@@ -19,12 +21,26 @@ type Summary struct {
 	TotalIaCSourceCount uint `json:"total_iac_source_count"`
 }
 
+// SourceSummary counts the resources of a single IaC source, managed ones and missing ones from the cloud provider
+type SourceSummary struct {
+	Source       string `json:"source"`
+	TotalManaged int    `json:"total_managed"`
+	TotalDeleted int    `json:"total_missing"`
+	// InSyncRatio is the percentage of the resources of the source that still exist on the cloud provider. It differs
+	// from the coverage of the analysis, which also counts unmanaged resources, as they belong to no source.
+	InSyncRatio int `json:"in_sync_ratio"`
+	// ResourceTypes counts the resources of the source by type
+	ResourceTypes map[string]int `json:"resource_types"`
+}
+
 type Analysis struct {
 	unmanaged       []*resource.Resource
 	managed         []*resource.Resource
 	deleted         []*resource.Resource
 	summary         Summary
 	alerts          alerter.Alerts
+	partial         bool
+	timings         *profile.Report
 	Duration        time.Duration
 	Date            time.Time
 	ProviderName    string
@@ -37,11 +53,14 @@ type serializableAnalysis struct {
 	Unmanaged       []resource.SerializableResource        `json:"unmanaged"`
 	Deleted         []resource.SerializableResource        `json:"missing"`
 	Coverage        int                                    `json:"coverage"`
+	Sources         []SourceSummary                        `json:"sources,omitempty"`
 	Alerts          map[string][]alerter.SerializableAlert `json:"alerts"`
 	ProviderName    string                                 `json:"provider_name"`
 	ProviderVersion string                                 `json:"provider_version"`
 	ScanDuration    uint                                   `json:"scan_duration,omitempty"`
 	Date            time.Time                              `json:"date"`
+	Partial         bool                                   `json:"partial,omitempty"`
+	Timings         *profile.Report                        `json:"timings,omitempty"`
 }
 
 type GenDriftIgnoreOptions struct {
@@ -77,10 +96,13 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 	}
 	bla.Summary = a.summary
 	bla.Coverage = a.Coverage()
+	bla.Sources = a.SourceSummaries()
 	bla.ProviderName = a.ProviderName
 	bla.ProviderVersion = a.ProviderVersion
 	bla.ScanDuration = uint(a.Duration.Seconds())
 	bla.Date = a.Date
+	bla.Partial = a.partial
+	bla.Timings = a.timings
 
 	return json.Marshal(bla)
 }
@@ -97,10 +119,18 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 		})
 	}
 	for _, d := range bla.Deleted {
-		a.AddDeleted(&resource.Resource{
+		res := &resource.Resource{
 			Id:   d.Id,
 			Type: d.Type,
-		})
+		}
+		if d.Source != nil {
+			res.Source = &resource.TerraformStateSource{
+				State:  d.Source.S,
+				Module: d.Source.Ns,
+				Name:   d.Source.Name,
+			}
+		}
+		a.AddDeleted(res)
 	}
 	for _, m := range bla.Managed {
 		res := &resource.Resource{
@@ -135,6 +165,8 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 	a.SetIaCSourceCount(bla.Summary.TotalIaCSourceCount)
 	a.Duration = time.Duration(bla.ScanDuration) * time.Second
 	a.Date = bla.Date
+	a.partial = bla.Partial
+	a.timings = bla.Timings
 	return nil
 }
 
@@ -164,6 +196,27 @@ func (a *Analysis) SetAlerts(alerts alerter.Alerts) {
 	a.alerts = alerts
 }
 
+// SetPartial flags an analysis made without the resources of some types, see IsPartial
+func (a *Analysis) SetPartial(partial bool) {
+	a.partial = partial
+}
+
+// IsPartial returns true when some resource types were not fully listed, the scan was interrupted
+// or an enumerator failed. Those types are reported in alerts and ignored by the analysis.
+func (a *Analysis) IsPartial() bool {
+	return a.partial
+}
+
+// SetTimings stores what listing each resource type cost
+func (a *Analysis) SetTimings(timings *profile.Report) {
+	a.timings = timings
+}
+
+// Timings returns what listing each resource type cost, nil when the remote resources were not enumerated
+func (a *Analysis) Timings() *profile.Report {
+	return a.timings
+}
+
 func (a *Analysis) SetIaCSourceCount(i uint) {
 	a.summary.TotalIaCSourceCount = i
 }
@@ -175,6 +228,47 @@ func (a *Analysis) Coverage() int {
 	return 0
 }
 
+// SourceSummaries breaks managed and missing resources down by IaC source, sorted by source. Unmanaged resources
+// belong to no source and are left out.
+func (a *Analysis) SourceSummaries() []SourceSummary {
+	summaries := make(map[string]*SourceSummary)
+	summaryOf := func(res *resource.Resource) *SourceSummary {
+		if res.Src() == nil {
+			return nil
+		}
+		summary, exist := summaries[res.Src().Source()]
+		if !exist {
+			summary = &SourceSummary{Source: res.Src().Source(), ResourceTypes: make(map[string]int)}
+			summaries[summary.Source] = summary
+		}
+		summary.ResourceTypes[res.ResourceType()]++
+		return summary
+	}
+	for _, res := range a.managed {
+		if summary := summaryOf(res); summary != nil {
+			summary.TotalManaged++
+		}
+	}
+	for _, res := range a.deleted {
+		if summary := summaryOf(res); summary != nil {
+			summary.TotalDeleted++
+		}
+	}
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	result := make([]SourceSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		summary.InSyncRatio = int((float32(summary.TotalManaged) / float32(summary.TotalManaged+summary.TotalDeleted)) * 100.0)
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Source < result[j].Source
+	})
+	return result
+}
+
 func (a *Analysis) Managed() []*resource.Resource {
 	return a.managed
 }
@@ -2,6 +2,7 @@ package analyser
 
 import (
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
 	"github.com/snyk/driftctl/pkg/filter"
 
@@ -53,6 +54,11 @@ func NewAnalyzer(alerter *alerter.Alerter, filter filter.Filter) *Analyzer {
 	return &Analyzer{alerter, filter}
 }
 
+// IsResourceIgnored tells whether the filter, usually the driftignore, leaves res out of the analysis
+func (a Analyzer) IsResourceIgnored(res *resource.Resource) bool {
+	return a.filter.IsResourceIgnored(res)
+}
+
 func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resource) (Analysis, error) {
 	analysis := Analysis{}
 
@@ -65,23 +71,23 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 		filteredRemoteResource = append(filteredRemoteResource, remoteRes)
 	}
 
+	remoteIndex := newResourceIndex(filteredRemoteResource)
+
 	haveComputedDiff := false
 	for _, stateRes := range resourcesFromState {
-		i, _, found := findCorrespondingRes(filteredRemoteResource, stateRes)
-
 		if a.filter.IsResourceIgnored(stateRes) || a.alerter.IsResourceIgnored(stateRes) {
 			continue
 		}
 
-		if !found {
+		// Matched resources are managed ones, it will remain only unmanaged ones in the index
+		if _, found := remoteIndex.Match(stateRes); !found {
 			analysis.AddDeleted(stateRes)
 			continue
 		}
 
-		// Remove managed resources, so it will remain only unmanaged ones
-		filteredRemoteResource = removeResourceByIndex(i, filteredRemoteResource)
 		analysis.AddManaged(stateRes)
 	}
+	filteredRemoteResource = remoteIndex.Unmatched()
 
 	if a.hasUnmanagedSecurityGroupRules(filteredRemoteResource) {
 		a.alerter.SendAlert("", newUnmanagedSecurityGroupRulesAlert())
@@ -99,24 +105,15 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 	analysis.SortResources()
 
 	analysis.SetAlerts(a.alerter.Retrieve())
-
-	return analysis, nil
-}
-
-func findCorrespondingRes(resources []*resource.Resource, res *resource.Resource) (int, *resource.Resource, bool) {
-	for i, r := range resources {
-		if res.Equal(r) {
-			return i, r, true
+	for _, typeAlerts := range analysis.Alerts() {
+		for _, alert := range typeAlerts {
+			if alerts.IsIncompleteEnumeration(alert) {
+				analysis.SetPartial(true)
+			}
 		}
 	}
-	return -1, nil, false
-}
 
-func removeResourceByIndex(i int, resources []*resource.Resource) []*resource.Resource {
-	if i == len(resources)-1 {
-		return resources[:len(resources)-1]
-	}
-	return append(resources[:i], resources[i+1:]...)
+	return analysis, nil
 }
 
 // hasUnmanagedSecurityGroupRules returns true if we find at least one unmanaged
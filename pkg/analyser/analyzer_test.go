@@ -2,6 +2,7 @@ package analyser
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	dctlresource "github.com/snyk/driftctl/pkg/resource"
 
 	alerter2 "github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 
 	"github.com/snyk/driftctl/pkg/filter"
 	"github.com/stretchr/testify/mock"
@@ -302,6 +304,48 @@ func TestAnalyze(t *testing.T) {
 			},
 			hasDrifted: true,
 		},
+		{
+			name: "Test incomplete resource type is not reported as missing",
+			iac: []*resource.Resource{
+				{
+					Id:   "bucket",
+					Type: "aws_s3_bucket",
+				},
+				{
+					Id:   "user",
+					Type: "aws_iam_user",
+				},
+			},
+			cloud: []*resource.Resource{
+				{
+					Id:   "user",
+					Type: "aws_iam_user",
+				},
+			},
+			alerts: alerter2.Alerts{
+				"aws_s3_bucket": []alerter2.Alert{
+					alerts.NewIncompleteEnumerationAlert("aws_s3_bucket", errors.New("interrupted")),
+				},
+			},
+			expected: Analysis{
+				managed: []*resource.Resource{
+					{
+						Id:   "user",
+						Type: "aws_iam_user",
+					},
+				},
+				summary: Summary{
+					TotalResources: 1,
+					TotalManaged:   1,
+				},
+				alerts: alerter2.Alerts{
+					"aws_s3_bucket": []alerter2.Alert{
+						alerts.NewIncompleteEnumerationAlert("aws_s3_bucket", errors.New("interrupted")),
+					},
+				},
+				partial: true,
+			},
+		},
 		{
 			name: "Test Discriminant function",
 			iac: []*resource.Resource{
@@ -408,6 +452,8 @@ func TestAnalyze(t *testing.T) {
 				t.Errorf("Drifted state does not match, got %t expected %t", result.IsSync(), !c.hasDrifted)
 			}
 
+			assert.Equal(t, c.expected.IsPartial(), result.IsPartial())
+
 			managedChanges, err := differ.Diff(result.Managed(), c.expected.Managed())
 			if err != nil {
 				t.Fatalf("Unable to compare %+v", err)
@@ -599,3 +645,38 @@ func TestAnalysis_UnmarshalJSON(t *testing.T) {
 	assert.Len(t, got.alerts, 1)
 	assert.Equal(t, got.alerts["aws_iam_access_key"][0].Message(), "This is an alert")
 }
+
+func TestAnalysis_SourceSummaries(t *testing.T) {
+	analysis := Analysis{}
+	assert.Nil(t, analysis.SourceSummaries())
+
+	analysis.AddManaged(
+		&resource.Resource{Id: "network", Type: "aws_vpc", Source: resource.NewTerraformStateSource("tfstate+s3://states/network.tfstate", "", "main")},
+		&resource.Resource{Id: "subnet", Type: "aws_subnet", Source: resource.NewTerraformStateSource("tfstate+s3://states/network.tfstate", "", "private")},
+		&resource.Resource{Id: "subnet-2", Type: "aws_subnet", Source: resource.NewTerraformStateSource("tfstate+s3://states/network.tfstate", "", "public")},
+		&resource.Resource{Id: "bucket", Type: "aws_s3_bucket", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "module.storage", "assets")},
+	)
+	analysis.AddDeleted(
+		&resource.Resource{Id: "gateway", Type: "aws_internet_gateway", Source: resource.NewTerraformStateSource("tfstate+s3://states/network.tfstate", "", "main")},
+		&resource.Resource{Id: "user", Type: "aws_iam_user", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "", "deploy")},
+		&resource.Resource{Id: "role", Type: "aws_iam_role", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "", "deploy")},
+	)
+	analysis.AddUnmanaged(&resource.Resource{Id: "unmanaged", Type: "aws_s3_bucket"})
+
+	assert.Equal(t, []SourceSummary{
+		{
+			Source:        "tfstate+s3://states/network.tfstate",
+			TotalManaged:  3,
+			TotalDeleted:  1,
+			InSyncRatio:   75,
+			ResourceTypes: map[string]int{"aws_vpc": 1, "aws_subnet": 2, "aws_internet_gateway": 1},
+		},
+		{
+			Source:        "tfstate://app.tfstate",
+			TotalManaged:  1,
+			TotalDeleted:  2,
+			InSyncRatio:   33,
+			ResourceTypes: map[string]int{"aws_s3_bucket": 1, "aws_iam_user": 1, "aws_iam_role": 1},
+		},
+	}, analysis.SourceSummaries())
+}
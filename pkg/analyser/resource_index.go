@@ -0,0 +1,64 @@
+package analyser
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+type resourceKey struct {
+	ty string
+	id string
+}
+
+// resourceIndex finds the remote resource corresponding to a state resource without scanning every
+// remote resource. Resources are bucketed by type and id, which resource.Resource.Equal compares
+// first, so Equal (and thus the schema DiscriminantFunc) is only called on resources of the same bucket.
+type resourceIndex struct {
+	resources []*resource.Resource
+	// buckets holds positions in resources, in their original order so that the first equal
+	// resource is matched like a linear scan would
+	buckets map[resourceKey][]int
+	matched []bool
+}
+
+func newResourceIndex(resources []*resource.Resource) *resourceIndex {
+	index := &resourceIndex{
+		resources: resources,
+		buckets:   make(map[resourceKey][]int, len(resources)),
+		matched:   make([]bool, len(resources)),
+	}
+	for i, res := range resources {
+		key := resourceKey{res.ResourceType(), res.ResourceId()}
+		index.buckets[key] = append(index.buckets[key], i)
+	}
+	return index
+}
+
+// Match returns the first unmatched resource equal to res and flags it as matched
+func (i *resourceIndex) Match(res *resource.Resource) (*resource.Resource, bool) {
+	key := resourceKey{res.ResourceType(), res.ResourceId()}
+	bucket := i.buckets[key]
+	for j, pos := range bucket {
+		if !res.Equal(i.resources[pos]) {
+			continue
+		}
+		i.matched[pos] = true
+		if len(bucket) == 1 {
+			delete(i.buckets, key)
+		} else {
+			i.buckets[key] = append(bucket[:j:j], bucket[j+1:]...)
+		}
+		return i.resources[pos], true
+	}
+	return nil, false
+}
+
+// Unmatched returns resources that were not matched, in their original order
+func (i *resourceIndex) Unmatched() []*resource.Resource {
+	unmatched := make([]*resource.Resource, 0, len(i.resources))
+	for pos, res := range i.resources {
+		if !i.matched[pos] {
+			unmatched = append(unmatched, res)
+		}
+	}
+	return unmatched
+}
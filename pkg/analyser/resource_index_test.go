@@ -0,0 +1,156 @@
+package analyser
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// linearMatch is the matching the analyzer used before the index, it is kept as a reference
+func linearMatch(remoteResources, stateResources []*resource.Resource) ([]*resource.Resource, []*resource.Resource) {
+	remaining := make([]*resource.Resource, len(remoteResources))
+	copy(remaining, remoteResources)
+	matched := make([]*resource.Resource, 0, len(stateResources))
+	for _, stateRes := range stateResources {
+		for i, r := range remaining {
+			if stateRes.Equal(r) {
+				matched = append(matched, r)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+	return matched, remaining
+}
+
+func TestResourceIndex(t *testing.T) {
+	byRegion := &resource.Schema{
+		DiscriminantFunc: func(self, res *resource.Resource) bool {
+			return (*self.Attrs)["region"] == (*res.Attrs)["region"]
+		},
+	}
+
+	cases := []struct {
+		name   string
+		remote []*resource.Resource
+		state  []*resource.Resource
+	}{
+		{
+			name: "test empty",
+		},
+		{
+			name: "test resources are matched by type and id",
+			remote: []*resource.Resource{
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{}},
+				{Id: "a", Type: "type2", Attrs: &resource.Attributes{}},
+				{Id: "b", Type: "type1", Attrs: &resource.Attributes{}},
+			},
+			state: []*resource.Resource{
+				{Id: "a", Type: "type2", Attrs: &resource.Attributes{}},
+				{Id: "b", Type: "type1", Attrs: &resource.Attributes{}},
+				{Id: "c", Type: "type1", Attrs: &resource.Attributes{}},
+			},
+		},
+		{
+			name: "test duplicated resources are matched once in order",
+			remote: []*resource.Resource{
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"n": 1}},
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"n": 2}},
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"n": 3}},
+			},
+			state: []*resource.Resource{
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{}},
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{}},
+			},
+		},
+		{
+			name: "test discriminant function is honoured",
+			remote: []*resource.Resource{
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"region": "us-east-1"}},
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"region": "eu-west-3"}},
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"region": "eu-west-1"}},
+			},
+			state: []*resource.Resource{
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"region": "eu-west-3"}, Sch: byRegion},
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"region": "ap-south-1"}, Sch: byRegion},
+				{Id: "a", Type: "type1", Attrs: &resource.Attributes{"region": "us-east-1"}, Sch: byRegion},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expectedMatched, expectedRemaining := linearMatch(c.remote, c.state)
+
+			index := newResourceIndex(c.remote)
+			matched := make([]*resource.Resource, 0, len(c.state))
+			for _, stateRes := range c.state {
+				if res, found := index.Match(stateRes); found {
+					matched = append(matched, res)
+				}
+			}
+
+			assert.Equal(t, expectedMatched, matched)
+			assert.Equal(t, expectedRemaining, index.Unmatched())
+		})
+	}
+}
+
+type noopFilter struct{}
+
+func (noopFilter) IsTypeIgnored(resource.ResourceType) bool  { return false }
+func (noopFilter) IsResourceIgnored(*resource.Resource) bool { return false }
+
+// generateResources returns remote and state resources where roughly a third of each side is
+// missing from the other one, state resources are shuffled to avoid matching in the same order
+func generateResources(count int) ([]*resource.Resource, []*resource.Resource) {
+	r := rand.New(rand.NewSource(int64(count)))
+	types := []string{"aws_s3_bucket", "aws_instance", "aws_iam_role", "aws_route53_record", "aws_security_group"}
+	remote := make([]*resource.Resource, 0, count)
+	state := make([]*resource.Resource, 0, count)
+	for i := 0; i < count; i++ {
+		res := &resource.Resource{
+			Id:    fmt.Sprintf("resource-%d", i),
+			Type:  types[i%len(types)],
+			Attrs: &resource.Attributes{},
+		}
+		switch i % 3 {
+		case 0:
+			remote = append(remote, res)
+		case 1:
+			state = append(state, res)
+		default:
+			remote = append(remote, res)
+			state = append(state, res)
+		}
+	}
+	r.Shuffle(len(state), func(i, j int) { state[i], state[j] = state[j], state[i] })
+	return remote, state
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	for _, count := range []int{10000, 100000, 1000000} {
+		remote, state := generateResources(count)
+
+		b.Run(fmt.Sprintf("index/%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				analyzer := NewAnalyzer(alerter.NewAlerter(), noopFilter{})
+				if _, err := analyzer.Analyze(remote, state); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("linear/%d", count), func(b *testing.B) {
+			if count > 100000 {
+				b.Skip("linear matching is too slow for this amount of resources")
+			}
+			for i := 0; i < b.N; i++ {
+				linearMatch(remote, state)
+			}
+		})
+	}
+}
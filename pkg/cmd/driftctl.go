@@ -71,6 +71,8 @@ func NewDriftctlCmd(build build.BuildInterface) *DriftctlCmd {
 	cmd.AddCommand(NewScanCmd(&pkg.ScanOptions{}))
 	cmd.AddCommand(NewFmtCmd(&pkg.FmtOptions{}))
 	cmd.AddCommand(NewGenDriftIgnoreCmd())
+	cmd.AddCommand(NewMiddlewaresCmd())
+	cmd.AddCommand(NewHistoryCmd(&HistoryOptions{}))
 
 	return cmd
 }
@@ -1,7 +1,23 @@
 package errors
 
+import "fmt"
+
 type InfrastructureNotInSync struct{}
 
 func (i InfrastructureNotInSync) Error() string {
 	return "Infrastructure is not in sync"
 }
+
+type PartialScan struct{}
+
+func (i PartialScan) Error() string {
+	return "Scan is partial, some resource types could not be scanned"
+}
+
+type DuplicateOwnership struct {
+	Count int
+}
+
+func (d DuplicateOwnership) Error() string {
+	return fmt.Sprintf("%d resource(s) are managed by more than one Terraform state", d.Count)
+}
@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/history"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/supplier"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/encryption"
+	"github.com/snyk/driftctl/pkg/memstore"
+	globaloutput "github.com/snyk/driftctl/pkg/output"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/schemas"
+	"github.com/spf13/cobra"
+)
+
+type HistoryOptions struct {
+	pkg.ScanOptions
+	Location     *backend.GitLocation
+	MaxRevisions int
+}
+
+func NewHistoryCmd(opts *HistoryOptions) *cobra.Command {
+	opts.BackendOptions = &backend.Options{}
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Find when unmanaged resources left a state committed to git",
+		Long: "Run the analysis against successive revisions of a state committed to git, from the newest one backwards,\n" +
+			"and report the commit at which each resource currently missing from the state was removed from it.\n" +
+			"Remote resources are enumerated once and reused for every revision.",
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			from, _ := cmd.Flags().GetString("from")
+			if from == "" {
+				return errors.New("From flag is required, use --from tfstate+git://REPOSITORY//PATH[@REF]")
+			}
+			iacSource, err := parseFromFlag([]string{from})
+			if err != nil {
+				return err
+			}
+			if iacSource[0].Backend != backend.BackendKeyGit {
+				return errors.Errorf("History requires a state committed to git, use --from tfstate+git://REPOSITORY//PATH[@REF]")
+			}
+			opts.Location, err = backend.ParseGitPath(iacSource[0].Path)
+			if err != nil {
+				return err
+			}
+			opts.From = iacSource
+
+			to, _ := cmd.Flags().GetString("to")
+			if !remote.IsSupported(to) {
+				return errors.Errorf(
+					"unsupported cloud provider '%s'\nValid values are: %s",
+					to,
+					strings.Join(remote.GetSupportedRemotes(), ","),
+				)
+			}
+
+			providerVersion, _ := cmd.Flags().GetString("tf-provider-version")
+			if err := validateTfProviderVersionString(providerVersion); err != nil {
+				return err
+			}
+			opts.ProviderVersion = providerVersion
+
+			if opts.MaxRevisions < 1 {
+				return errors.New("Max revisions flag should be at least 1")
+			}
+			if opts.Timeout < 0 {
+				return errors.New("Timeout flag should not be negative")
+			}
+
+			opts.ConfigDir, _ = cmd.Flags().GetString("config-dir")
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return historyRun(opts, cmd.OutOrStdout())
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringP(
+		"from",
+		"f",
+		"",
+		"State committed to git, tfstate+git://REPOSITORY//PATH[@REF] where REF defaults to HEAD\n",
+	)
+	supportedRemotes := remote.GetSupportedRemotes()
+	fl.StringVarP(&opts.To,
+		"to",
+		"t",
+		supportedRemotes[0],
+		"Cloud provider source\n"+
+			"Accepted values are: "+strings.Join(supportedRemotes, ",")+"\n",
+	)
+	fl.String(
+		"tf-provider-version",
+		"",
+		"Terraform provider version to use.\n",
+	)
+	fl.StringVar(&opts.EncryptionDir,
+		"tf-encryption-dir",
+		".",
+		"Directory of the Terraform files holding the encryption block of encrypted states, "+encryption.EnvVar+" is merged over it\n",
+	)
+	fl.IntVar(&opts.MaxRevisions,
+		"max-revisions",
+		20,
+		"Maximum number of state revisions to analyse\n",
+	)
+	fl.DurationVar(&opts.Timeout,
+		"timeout",
+		0,
+		"Maximum duration of the analysis of every revision (e.g. 30m), no limit by default\n",
+	)
+	fl.StringVar(&opts.DriftignorePath,
+		"driftignore",
+		".driftignore",
+		"Path to the driftignore file",
+	)
+
+	configDir, err := homedir.Dir()
+	if err != nil {
+		configDir = os.TempDir()
+	}
+	fl.String(
+		"config-dir",
+		configDir,
+		"Directory path that driftctl uses for configuration.\n",
+	)
+
+	return cmd
+}
+
+func historyRun(opts *HistoryOptions, out io.Writer) error {
+	ctx := context.Background()
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ctx, stop := interruptContext(ctx)
+	defer stop()
+
+	globaloutput.ChangePrinter(globaloutput.NewConsolePrinter())
+
+	commits, err := opts.Location.Log(opts.MaxRevisions)
+	if err != nil {
+		return err
+	}
+	if len(commits) == 0 {
+		return errors.Errorf("no revision of %s was found in %s", opts.Location.Path, opts.Location.Repository)
+	}
+	revisions := make([]history.Revision, 0, len(commits))
+	for _, commit := range commits {
+		revisions = append(revisions, history.Revision{
+			Hash:    commit.Hash.String(),
+			When:    commit.Author.When,
+			Author:  commit.Author.Name,
+			Message: strings.SplitN(strings.TrimSpace(commit.Message), "\n", 2)[0],
+		})
+	}
+
+	encryptionConfig, err := encryption.LoadConfig(opts.EncryptionDir)
+	if err != nil {
+		return err
+	}
+	opts.BackendOptions.Encryption = encryptionConfig
+
+	// Enumerators only run for the first revision, the alerts they send are replayed to the others
+	recorder := history.NewAlertRecorder()
+	providerLibrary := terraform.NewProviderLibrary()
+	remoteLibrary := common.NewRemoteLibrary()
+	scanProgress := globaloutput.NewProgress("Scanning resources", "Scanned resources", false)
+	resourceSchemaRepository := schemas.NewSchemaRepository()
+	resFactory := dctlresource.NewDriftctlResourceFactory(resourceSchemaRepository)
+
+	err = remote.Activate(opts.To, opts.ProviderVersion, recorder, providerLibrary, remoteLibrary, scanProgress, resFactory, opts.ConfigDir, ratelimit.NewController(opts.RateLimits), cache.New(100), nil)
+	if err != nil {
+		return err
+	}
+
+	providerName := common.RemoteParameter(opts.To).GetProviderAddress().Type
+	err = resourceSchemaRepository.Init(providerName, opts.ProviderVersion, providerLibrary.Provider(providerName).Schema())
+	if err != nil {
+		return err
+	}
+
+	defer providerLibrary.Cleanup()
+
+	driftIgnore := filter.NewDriftIgnore(opts.DriftignorePath)
+	cachedLibrary := history.NewCachedLibrary(remoteLibrary)
+
+	report, err := history.Run(ctx, revisions, func(ctx context.Context, revision history.Revision) (*analyser.Analysis, error) {
+		location := *opts.Location
+		location.Ref = revision.Hash
+		iacProgress := globaloutput.NewProgress(fmt.Sprintf("Scanning state at %s", revision.Hash[:7]), "Scanned states", true)
+
+		// An alerter is done once an analysis retrieved its alerts, so every revision gets its own
+		alerter := alerter.NewAlerter()
+		scanner := remote.NewScanner(cachedLibrary, alerter, driftIgnore)
+		remoteSupplier := history.NewRevisionSupplier(scanner, recorder, alerter)
+
+		iacSupplier, err := supplier.GetIACSupplier([]config.SupplierConfig{{
+			Key:     opts.From[0].Key,
+			Backend: backend.BackendKeyGit,
+			Path:    location.String(),
+		}}, providerLibrary, opts.BackendOptions, iacProgress, alerter, resFactory, driftIgnore)
+		if err != nil {
+			return nil, err
+		}
+
+		ctl := pkg.NewDriftCTL(
+			remoteSupplier,
+			iacSupplier,
+			alerter,
+			analyser.NewAnalyzer(alerter, driftIgnore),
+			resFactory,
+			&opts.ScanOptions,
+			scanProgress,
+			iacProgress,
+			resourceSchemaRepository,
+			memstore.New(),
+		)
+		return ctl.Run(ctx)
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() != nil {
+			return errors.Errorf("history did not complete within %s", opts.Timeout)
+		}
+		return err
+	}
+
+	printHistoryReport(out, opts.Location, report)
+	return nil
+}
+
+func printHistoryReport(out io.Writer, location *backend.GitLocation, report *history.Report) {
+	fmt.Fprintf(out, "Analysed %d revision(s) of %s\n", len(report.Revisions), location)
+	if len(report.Disappearances) == 0 {
+		fmt.Fprintln(out, "No resource is missing from the state")
+		return
+	}
+
+	fmt.Fprintf(out, "Found %d resource(s) missing from the state:\n", len(report.Disappearances))
+	for _, d := range report.Disappearances {
+		fmt.Fprintf(out, "  - %s (%s)\n", d.Resource.ResourceId(), d.Resource.ResourceType())
+		if d.LastSeen == nil {
+			fmt.Fprintf(out, "    Not managed in any analysed revision, the oldest is %s\n", formatRevision(report.Revisions[len(report.Revisions)-1]))
+			continue
+		}
+		fmt.Fprintf(out, "    Removed in %s\n", formatRevision(d.RemovedIn))
+		fmt.Fprintf(out, "    Last seen in %s\n", formatRevision(*d.LastSeen))
+	}
+}
+
+func formatRevision(revision history.Revision) string {
+	hash := revision.Hash
+	if len(hash) > 7 {
+		hash = hash[:7]
+	}
+	return fmt.Sprintf("%s %s by %s: %s", hash, revision.When.Format("2006-01-02 15:04:05"), revision.Author, revision.Message)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/history"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryCmd_Invalid(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"history"}, expected: "From flag is required, use --from tfstate+git://REPOSITORY//PATH[@REF]"},
+		{args: []string{"history", "--from", "tfstate://terraform.tfstate"}, expected: "History requires a state committed to git, use --from tfstate+git://REPOSITORY//PATH[@REF]"},
+		{args: []string{"history", "--from", "tfstate+git://terraform.tfstate"}, expected: "Unable to parse git path: terraform.tfstate. Must be REPOSITORY//PATH[@REF]"},
+		{args: []string{"history", "--from", "tfstate+git://.//terraform.tfstate", "--to", "test"}, expected: "unsupported cloud provider 'test'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf,k8s+tf,cloudflare+tf"},
+		{args: []string{"history", "--from", "tfstate+git://.//terraform.tfstate", "--max-revisions", "0"}, expected: "Max revisions flag should be at least 1"},
+		{args: []string{"history", "--from", "tfstate+git://.//terraform.tfstate", "--timeout", "-1s"}, expected: "Timeout flag should not be negative"},
+	}
+
+	for _, tt := range cases {
+		rootCmd := &cobra.Command{Use: "root"}
+		rootCmd.AddCommand(NewHistoryCmd(&HistoryOptions{}))
+		_, err := test.Execute(rootCmd, tt.args...)
+		if assert.Error(t, err, tt.args) {
+			assert.Equal(t, tt.expected, err.Error())
+		}
+	}
+}
+
+func TestPrintHistoryReport(t *testing.T) {
+	removedIn := history.Revision{Hash: "4f2a9c1e7d", When: time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC), Author: "jane", Message: "Move bucket to another stack"}
+	lastSeen := history.Revision{Hash: "b7e01d33aa", When: time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC), Author: "john", Message: "Add bucket"}
+
+	out := &bytes.Buffer{}
+	printHistoryReport(out, &backend.GitLocation{Repository: "infra", Path: "terraform.tfstate"}, &history.Report{
+		Revisions: []history.Revision{removedIn, lastSeen},
+		Disappearances: []history.Disappearance{
+			{Resource: &resource.Resource{Id: "bucket", Type: "aws_s3_bucket"}, RemovedIn: removedIn, LastSeen: &lastSeen},
+			{Resource: &resource.Resource{Id: "role", Type: "aws_iam_role"}},
+		},
+	})
+
+	assert.Equal(t, `Analysed 2 revision(s) of infra//terraform.tfstate
+Found 2 resource(s) missing from the state:
+  - bucket (aws_s3_bucket)
+    Removed in 4f2a9c1 2024-03-02 10:00:00 by jane: Move bucket to another stack
+    Last seen in b7e01d3 2024-03-01 09:30:00 by john: Add bucket
+  - role (aws_iam_role)
+    Not managed in any analysed revision, the oldest is b7e01d3 2024-03-01 09:30:00 by john: Add bucket
+`, out.String())
+}
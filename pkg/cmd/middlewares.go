@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/pkg"
+	"github.com/spf13/cobra"
+)
+
+func NewMiddlewaresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "middlewares",
+		Short: "List built-in middlewares",
+		Long: "List built-in middlewares in the order they run. Middlewares reconcile remote and state resources before they are compared,\n" +
+			"they can be disabled with scan --disable-middleware. Plugin and rule middlewares run after them.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, m := range pkg.ListMiddlewares() {
+				if m.DisabledInStrictMode {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s (disabled with --strict)\n", m.Name)
+					continue
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), m.Name)
+			}
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/snyk/driftctl/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddlewaresCmd(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "root"}
+	rootCmd.AddCommand(NewMiddlewaresCmd())
+
+	output, err := test.Execute(rootCmd, "middlewares")
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	assert.Equal(t, "Route53RecordIDReconcilier", lines[0])
+	assert.Contains(t, lines, "AwsBucketPolicyExpander")
+	assert.Contains(t, lines, "AwsDefaults (disabled with --strict)")
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -17,15 +18,20 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/build"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/plugin"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote"
 	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/terraform"
 	"github.com/snyk/driftctl/enumeration/terraform/lock"
+	"github.com/snyk/driftctl/enumeration/traffic"
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
 	"github.com/snyk/driftctl/pkg/memstore"
+	"github.com/snyk/driftctl/pkg/middlewares"
 	dctlresource "github.com/snyk/driftctl/pkg/resource"
 	"github.com/snyk/driftctl/pkg/resource/schemas"
 	"github.com/snyk/driftctl/pkg/telemetry"
@@ -38,6 +44,7 @@ import (
 	"github.com/snyk/driftctl/pkg/filter"
 	"github.com/snyk/driftctl/pkg/iac/supplier"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/encryption"
 	globaloutput "github.com/snyk/driftctl/pkg/output"
 )
 
@@ -115,6 +122,40 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 
 			opts.ConfigDir, _ = cmd.Flags().GetString("config-dir")
 
+			if opts.Timeout < 0 {
+				return errors.New("Timeout flag should not be negative")
+			}
+			if opts.EnumeratorTimeout < 0 {
+				return errors.New("Enumerator timeout flag should not be negative")
+			}
+
+			if opts.Record != "" && opts.Replay != "" {
+				return errors.New("Record and replay flags cannot be used together")
+			}
+			if (opts.Record != "" || opts.Replay != "") && !remote.IsCaptureSupported(to) {
+				return errors.Errorf(
+					"Record and replay flags are not supported for cloud provider '%s'\nSupported values are: %s",
+					to,
+					strings.Join(remote.GetCaptureSupportedRemotes(), ","),
+				)
+			}
+
+			for _, name := range opts.DisabledMiddlewares {
+				for _, enabled := range opts.EnabledMiddlewares {
+					if name == enabled {
+						return errors.Errorf("Middleware %s cannot be both enabled and disabled", name)
+					}
+				}
+			}
+
+			if opts.RateLimits.Parallelism < 1 {
+				return errors.New("Parallelism flag should be at least 1")
+			}
+			rateLimitFlag, _ := cmd.Flags().GetStringSlice("rate-limit")
+			if err := ratelimit.ParseRates(&opts.RateLimits, rateLimitFlag); err != nil {
+				return err
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -181,6 +222,18 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		"Terraform Cloud / Enterprise API endpoint.\n"+
 			"Only used with tfstate+tfcloud backend.\n",
 	)
+	fl.StringSliceVar(&opts.BackendOptions.TFCloudWorkspaceTags,
+		"tfc-workspace-tags",
+		[]string{},
+		"Only read the workspaces having all of these tags when listing an organization (e.g. tfstate+tfcloud://my-org).\n"+
+			"Only used with tfstate+tfcloud backend.\n",
+	)
+	fl.StringVar(&opts.BackendOptions.TFCloudProject,
+		"tfc-project",
+		"",
+		"Only read the workspaces of this project ID (prj-xxx) when listing an organization.\n"+
+			"Only used with tfstate+tfcloud backend.\n",
+	)
 	fl.StringVar(&opts.BackendOptions.AzureRMBackendOptions.StorageAccount,
 		"azurerm-storage-account",
 		os.Getenv("AZURE_STORAGE_ACCOUNT"),
@@ -201,6 +254,11 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		false,
 		"Includes cloud provider service-linked roles (disabled by default)",
 	)
+	fl.BoolVar(&opts.FailOnDuplicateOwnership,
+		"fail-on-duplicate-ownership",
+		false,
+		"Fail when a resource is managed by more than one Terraform state",
+	)
 	fl.StringVar(&opts.DriftignorePath,
 		"driftignore",
 		".driftignore",
@@ -213,11 +271,94 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 			"Example: *,!aws_s3* (everything but resources that are prefixed with aws_s3 are ignored) \n"+
 			"When using this parameter the driftignore file is not processed\n"+
 			"When using multiple instances of this argument, order will be respected")
+	fl.StringVar(&opts.Discover,
+		"discover",
+		"",
+		"Walk a directory for Terraform root modules and terragrunt.hcl files with a remote_state block and scan every state found\n"+
+			"The current workspace of each module is used, the states found are printed before the scan\n",
+	)
+	fl.BoolVar(&opts.AllWorkspaces,
+		"all-workspaces",
+		false,
+		"Scan the states of every workspace of the backends found in Terraform files instead of the current workspace only\n"+
+			"Supported for the s3, gcs, azurerm, consul, pg and kubernetes backends\n",
+	)
+	fl.StringVar(&opts.MiddlewareRules,
+		"middleware-rules",
+		"",
+		"Path to a YAML file of rules that drop resources, rename their type or expand an attribute into child resources\n"+
+			"Rules run after the built-in middlewares, set dry_run: true in the file to only log what they touch\n",
+	)
+	fl.StringSliceVar(&opts.DisabledMiddlewares,
+		"disable-middleware",
+		[]string{},
+		"Names of middlewares not to run, run driftctl middlewares to list them\n",
+	)
+	fl.StringSliceVar(&opts.EnabledMiddlewares,
+		"enable-middleware",
+		[]string{},
+		"Names of middlewares to run even though --strict disables them\n",
+	)
+	fl.StringVar(&opts.MiddlewareTrace,
+		"middleware-trace",
+		"",
+		"Path to write, as JSON, how many remote and state resources each middleware added, removed or changed\n"+
+			"Tracing fingerprints every resource before and after each middleware, changes are also logged with debug output\n",
+	)
 	fl.String(
 		"tf-lockfile",
 		".terraform.lock.hcl",
 		"Terraform lock file to get the provider's version from. Will be ignored if the file doesn't exist.\n",
 	)
+	fl.StringVar(&opts.EncryptionDir,
+		"tf-encryption-dir",
+		".",
+		"Directory of the Terraform files holding the encryption block of encrypted states, "+encryption.EnvVar+" is merged over it\n",
+	)
+	fl.StringVar(&opts.BackendOptions.TerraformBinary,
+		"tf-binary",
+		"",
+		"terraform or tofu binary the tfstate+exec:// backend runs state pull with\n"+
+			"Defaults to terraform, or tofu when terraform is not on PATH\n",
+	)
+	fl.DurationVar(&opts.Timeout,
+		"timeout",
+		0,
+		"Maximum duration of the scan (e.g. 30m), no limit by default\n",
+	)
+	fl.DurationVar(&opts.EnumeratorTimeout,
+		"enumerator-timeout",
+		0,
+		"Maximum duration to list a single resource type (e.g. 5m), no limit by default\n"+
+			"Resource types that exceed it are skipped and reported in alerts\n",
+	)
+	fl.IntVar(&opts.RateLimits.Parallelism,
+		"parallelism",
+		ratelimit.DefaultParallelism,
+		"Number of resource types listed, and of resources read, at the same time\n",
+	)
+	fl.StringSlice(
+		"rate-limit",
+		[]string{},
+		"Maximum number of API requests per second, for every service or for a single one (e.g. 20,ec2=10,s3=50)\n"+
+			"Services are unlimited by default and always slow down when the cloud provider throttles requests\n",
+	)
+	fl.StringVar(&opts.Record,
+		"record",
+		"",
+		"Directory to record every cloud API response and provider read of the scan into, to replay it later with --replay\n"+
+			"The capture holds the details of your cloud resources but no credentials. Not supported for gcp+tf\n",
+	)
+	fl.StringVar(&opts.Replay,
+		"replay",
+		"",
+		"Directory of a scan recorded with --record to run offline, without cloud credentials. Not supported for gcp+tf\n",
+	)
+	fl.BoolVar(&opts.Profile,
+		"profile",
+		false,
+		"Print the time, API calls and retries spent listing each resource type and in each repository method\n",
+	)
 
 	configDir, err := homedir.Dir()
 	if err != nil {
@@ -238,11 +379,36 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 	return cmd
 }
 
+// newTrafficCapture returns the capture to record or replay the scan, or nil when neither was asked
+func newTrafficCapture(opts *pkg.ScanOptions) (*traffic.Capture, error) {
+	if opts.Record != "" {
+		return traffic.NewRecorder(opts.Record, opts.To)
+	}
+	if opts.Replay == "" {
+		return nil, nil
+	}
+	capture, err := traffic.NewReplayer(opts.Replay, opts.To)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ProviderVersion == "" {
+		opts.ProviderVersion = capture.ProviderVersion()
+	}
+	return capture, nil
+}
+
 func scanRun(opts *pkg.ScanOptions) error {
 	store := memstore.New()
 
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	ctx := context.Background()
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ctx, stop := interruptContext(ctx)
+	defer stop()
 
 	alerter := alerter.NewAlerter()
 
@@ -252,8 +418,16 @@ func scanRun(opts *pkg.ScanOptions) error {
 		globaloutput.ChangePrinter(globaloutput.NewConsolePrinter())
 	}
 
+	if opts.Discover != "" {
+		supplierConfigs, err := discoverBackends(opts.Discover, opts.AllWorkspaces)
+		if err != nil {
+			return err
+		}
+		opts.From = append(opts.From, supplierConfigs...)
+	}
+
 	if len(opts.From) == 0 {
-		supplierConfigs, err := retrieveBackendsFromHCL("")
+		supplierConfigs, err := retrieveBackendsFromHCL("", opts.AllWorkspaces)
 		if err != nil {
 			return err
 		}
@@ -268,6 +442,12 @@ func scanRun(opts *pkg.ScanOptions) error {
 		})
 	}
 
+	encryptionConfig, err := encryption.LoadConfig(opts.EncryptionDir)
+	if err != nil {
+		return err
+	}
+	opts.BackendOptions.Encryption = encryptionConfig
+
 	providerLibrary := terraform.NewProviderLibrary()
 	remoteLibrary := common.NewRemoteLibrary()
 
@@ -278,7 +458,12 @@ func scanRun(opts *pkg.ScanOptions) error {
 
 	resFactory := dctlresource.NewDriftctlResourceFactory(resourceSchemaRepository)
 
-	err := remote.Activate(opts.To, opts.ProviderVersion, alerter, providerLibrary, remoteLibrary, scanProgress, resFactory, opts.ConfigDir)
+	capture, err := newTrafficCapture(opts)
+	if err != nil {
+		return err
+	}
+
+	err = remote.Activate(opts.To, opts.ProviderVersion, alerter, providerLibrary, remoteLibrary, scanProgress, resFactory, opts.ConfigDir, ratelimit.NewController(opts.RateLimits), cache.New(100), capture)
 	if err != nil {
 		if err == aws.AWSCredentialsNotFoundError {
 			// special case command-line advice, because AWS is the default cloud
@@ -302,11 +487,37 @@ func scanRun(opts *pkg.ScanOptions) error {
 		logrus.Trace("Exited")
 	}()
 
+	plugins, err := plugin.Load(ctx, filepath.Join(opts.ConfigDir, "plugins"), opts.To)
+	if err != nil {
+		return err
+	}
+	defer plugins.Close()
+	enumeratedTypes := make([]string, 0, len(remoteLibrary.Enumerators()))
+	for _, e := range remoteLibrary.Enumerators() {
+		enumeratedTypes = append(enumeratedTypes, string(e.SupportedType()))
+	}
+	middlewareNames := make([]string, 0)
+	for _, m := range pkg.ListMiddlewares() {
+		middlewareNames = append(middlewareNames, m.Name)
+	}
+	err = plugins.CheckCollisions(enumeratedTypes, middlewareNames)
+	if err != nil {
+		return err
+	}
+	for _, p := range plugins {
+		for _, ty := range p.Manifest().ResourceTypes {
+			dctlresource.AddSupportedType(ty)
+			remoteLibrary.AddEnumerator(plugin.NewEnumerator(p.Plugin(), ty, resFactory))
+		}
+	}
+
 	logrus.Debug("Checking for driftignore")
 	driftIgnore := filter.NewDriftIgnore(opts.DriftignorePath, opts.Driftignores...)
 
 	// TODO use enum library interface here
 	scanner := remote.NewScanner(remoteLibrary, alerter, driftIgnore)
+	scanner.SetEnumeratorTimeout(opts.EnumeratorTimeout)
+	scanner.SetParallelism(int64(opts.RateLimits.Parallelism))
 
 	iacSupplier, err := supplier.GetIACSupplier(opts.From, providerLibrary, opts.BackendOptions, iacProgress, alerter, resFactory, driftIgnore)
 	if err != nil {
@@ -325,15 +536,52 @@ func scanRun(opts *pkg.ScanOptions) error {
 		resourceSchemaRepository,
 		store,
 	)
+	for _, p := range plugins {
+		for _, name := range p.Manifest().Middlewares {
+			ctl.AddMiddlewares(middlewares.NewPluginMiddleware(p.Plugin(), name, resFactory))
+		}
+	}
+	if opts.MiddlewareRules != "" {
+		for _, p := range plugins {
+			middlewareNames = append(middlewareNames, p.Manifest().Middlewares...)
+		}
+		rules, err := middlewares.LoadRules(opts.MiddlewareRules, resFactory, middlewareNames)
+		if err != nil {
+			return err
+		}
+		ctl.AddMiddlewares(rules...)
+	}
 
+	return runScan(ctx, ctl, opts, store)
+}
+
+// interruptContext returns a context canceled on SIGINT or SIGTERM, an interrupted scan stops
+// listing resources and reports what was already listed
+func interruptContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
-		<-c
-		logrus.Warn("Detected interrupt, cleanup ...")
-		ctl.Stop()
+		select {
+		case <-c:
+			logrus.Warn("Detected interrupt, cleanup ...")
+			cancel()
+		case <-ctx.Done():
+		}
 	}()
+	return ctx, func() {
+		signal.Stop(c)
+		cancel()
+	}
+}
 
-	analysis, err := ctl.Run()
+// runScan runs the scan, writes its outputs and returns the error matching the exit code of the analysis
+func runScan(ctx context.Context, ctl *pkg.DriftCTL, opts *pkg.ScanOptions, store memstore.Store) error {
+	analysis, err := ctl.Run(ctx)
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() != nil {
+			return errors.Errorf("scan did not complete within %s", opts.Timeout)
+		}
 		return err
 	}
 
@@ -361,11 +609,25 @@ func scanRun(opts *pkg.ScanOptions) error {
 	globaloutput.Printf(color.WhiteString("Scan duration: %s\n", analysis.Duration.Round(time.Second)))
 	globaloutput.Printf(color.WhiteString("Provider version used to scan: %s. Use --tf-provider-version to use another version.\n"), opts.ProviderVersion)
 
+	if opts.Profile && analysis.Timings() != nil {
+		globaloutput.Printf("%s", output.FormatProfile(analysis.Timings()))
+	}
+
 	if !opts.DisableTelemetry {
 		tl := telemetry.NewTelemetry(&build.Build{})
 		tl.SendTelemetry(store.Bucket(memstore.TelemetryBucket))
 	}
 
+	if opts.FailOnDuplicateOwnership {
+		if count := countDuplicateOwnership(analysis); count > 0 {
+			return cmderrors.DuplicateOwnership{Count: count}
+		}
+	}
+
+	if analysis.IsPartial() {
+		return cmderrors.PartialScan{}
+	}
+
 	if !analysis.IsSync() {
 		return cmderrors.InfrastructureNotInSync{}
 	}
@@ -373,6 +635,19 @@ func scanRun(opts *pkg.ScanOptions) error {
 	return nil
 }
 
+// countDuplicateOwnership returns how many resources the analysis found managed by several states
+func countDuplicateOwnership(analysis *analyser.Analysis) int {
+	count := 0
+	for _, alerts := range analysis.Alerts() {
+		for _, alert := range alerts {
+			if _, ok := alert.(*state.DuplicateOwnershipAlert); ok {
+				count++
+			}
+		}
+	}
+	return count
+}
+
 func validateTfProviderVersionString(version string) error {
 	if version == "" {
 		return nil
@@ -383,7 +658,7 @@ func validateTfProviderVersionString(version string) error {
 	return nil
 }
 
-func retrieveBackendsFromHCL(workdir string) ([]config.SupplierConfig, error) {
+func retrieveBackendsFromHCL(workdir string, allWorkspaces bool) ([]config.SupplierConfig, error) {
 	matches, err := filepath.Glob(path.Join(workdir, "*.tf"))
 	if err != nil {
 		return nil, err
@@ -400,16 +675,8 @@ func retrieveBackendsFromHCL(workdir string) ([]config.SupplierConfig, error) {
 			continue
 		}
 
-		var cfg *config.SupplierConfig
 		ws := hcl.GetCurrentWorkspaceName(path.Dir(match))
-
-		if body.Cloud != nil {
-			cfg = body.Cloud.SupplierConfig(ws)
-		}
-		if body.Backend != nil {
-			cfg = body.Backend.SupplierConfig(ws)
-		}
-		if cfg != nil {
+		if cfg := body.SupplierConfig(ws, allWorkspaces); cfg != nil {
 			globaloutput.Printf(color.WhiteString("Using Terraform state %s found in %s. Use the --from flag to specify another state file.\n"), cfg, match)
 			supplierConfigs = append(supplierConfigs, *cfg)
 		}
@@ -417,3 +684,20 @@ func retrieveBackendsFromHCL(workdir string) ([]config.SupplierConfig, error) {
 
 	return supplierConfigs, nil
 }
+
+func discoverBackends(root string, allWorkspaces bool) ([]config.SupplierConfig, error) {
+	discovered, err := hcl.Discover(root, allWorkspaces)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to discover states in %s", root)
+	}
+	if len(discovered) == 0 {
+		return nil, errors.Errorf("no Terraform state configuration was found in %s", root)
+	}
+
+	supplierConfigs := make([]config.SupplierConfig, 0, len(discovered))
+	for _, found := range discovered {
+		globaloutput.Printf(color.WhiteString("Discovered Terraform state %s for %s (workspace %s) in %s\n"), found.Config, found.Dir, found.Workspace, found.File)
+		supplierConfigs = append(supplierConfigs, found.Config)
+	}
+	return supplierConfigs, nil
+}
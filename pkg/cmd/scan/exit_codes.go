@@ -4,4 +4,10 @@ const (
 	EXIT_IN_SYNC     = 0
 	EXIT_NOT_IN_SYNC = 1
 	EXIT_ERROR       = 2
+	// EXIT_PARTIAL is returned when outputs were written but some resource types could not be scanned.
+	// 3 is already used when the scan is interrupted while configuring the terraform provider.
+	EXIT_PARTIAL = 4
+	// EXIT_DUPLICATE_OWNERSHIP is returned when outputs were written but --fail-on-duplicate-ownership found resources
+	// managed by several states.
+	EXIT_DUPLICATE_OWNERSHIP = 5
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
 
@@ -143,11 +144,44 @@ func (c Console) writeSummary(analysis *analyser.Analysis) {
 		fmt.Printf(" - %s resource(s) not managed by Terraform\n", unmanaged)
 		fmt.Printf(" - %s resource(s) found in a Terraform state but missing on the cloud provider\n", deleted)
 	}
+	c.writeSourceSummaries(analysis)
 	if analysis.IsSync() {
 		fmt.Println(color.GreenString("Congrats! Your infrastructure is fully in sync."))
 	}
 }
 
+// writeSourceSummaries breaks the summary down by IaC source, only when several sources were scanned
+func (c Console) writeSourceSummaries(analysis *analyser.Analysis) {
+	sources := analysis.SourceSummaries()
+	if len(sources) < 2 {
+		return
+	}
+	boldWriter := color.New(color.Bold)
+	errorWriter := color.New(color.Bold, color.FgRed)
+
+	fmt.Printf("Found %s IaC source(s)\n", boldWriter.Sprintf("%d", len(sources)))
+	for _, source := range sources {
+		fmt.Print(color.BlueString("  %s\n", source.Source))
+		fmt.Printf("   - %s%% in sync\n", boldWriter.Sprintf("%d", source.InSyncRatio))
+		fmt.Printf("   - %d resource(s) managed by Terraform\n", source.TotalManaged)
+		deleted := fmt.Sprintf("%d", source.TotalDeleted)
+		if source.TotalDeleted > 0 {
+			deleted = errorWriter.Sprintf("%d", source.TotalDeleted)
+		}
+		fmt.Printf("   - %s resource(s) missing on the cloud provider\n", deleted)
+
+		types := make([]string, 0, len(source.ResourceTypes))
+		for ty := range source.ResourceTypes {
+			types = append(types, ty)
+		}
+		sort.Strings(types)
+		for i, ty := range types {
+			types[i] = fmt.Sprintf("%s (%d)", ty, source.ResourceTypes[ty])
+		}
+		fmt.Printf("   - Resource types: %s\n", strings.Join(types, ", "))
+	}
+}
+
 func groupByType(resources []*resource.Resource) (map[string][]*resource.Resource, []string) {
 	result := map[string][]*resource.Resource{}
 	for _, res := range resources {
@@ -31,6 +31,7 @@ type HTMLTemplateParams struct {
 	ScanDate        string
 	Coverage        int
 	Summary         analyser.Summary
+	Sources         []analyser.SourceSummary
 	Unmanaged       []*resource.Resource
 	Deleted         []*resource.Resource
 	Alerts          alerter.Alerts
@@ -111,6 +112,7 @@ func (c *HTML) Write(analysis *analyser.Analysis) error {
 		ScanDate:        analysis.Date.Format("Jan 02, 2006"),
 		Coverage:        analysis.Coverage(),
 		Summary:         analysis.Summary(),
+		Sources:         analysis.SourceSummaries(),
 		Unmanaged:       analysis.Unmanaged(),
 		Deleted:         analysis.Deleted(),
 		Alerts:          analysis.Alerts(),
@@ -0,0 +1,34 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/profile"
+)
+
+// FormatProfile renders the enumerators and repository methods stats as tables, the slowest first
+func FormatProfile(report *profile.Report) string {
+	buf := &bytes.Buffer{}
+	w := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(buf, "\nEnumeration profile:")
+	fmt.Fprintln(w, "RESOURCE TYPE\tDURATION\tRESOURCES\tAPI CALLS\tRETRIES\t")
+	for _, stats := range report.Enumerators {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t\n", stats.Type, stats.Duration.Round(time.Millisecond), stats.Resources, stats.APICalls, stats.Retries)
+	}
+	_ = w.Flush()
+
+	if len(report.Methods) > 0 {
+		fmt.Fprintln(buf, "\nRepository methods profile:")
+		fmt.Fprintln(w, "METHOD\tCALLS\tDURATION\tAPI CALLS\tRETRIES\t")
+		for _, stats := range report.Methods {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%d\t\n", stats.Method, stats.Calls, stats.Duration.Round(time.Millisecond), stats.APICalls, stats.Retries)
+		}
+		_ = w.Flush()
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,32 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatProfile(t *testing.T) {
+	report := &profile.Report{
+		Enumerators: []profile.EnumeratorStats{
+			{Type: "aws_s3_bucket", Duration: 1500 * time.Millisecond, Resources: 3, APICalls: 4, Retries: 1},
+			{Type: "aws_iam_user", Duration: 20 * time.Millisecond, Resources: 12, APICalls: 1},
+		},
+		Methods: []profile.MethodStats{
+			{Method: "S3Repository.ListAllBuckets", Calls: 7, Duration: 20 * time.Millisecond, APICalls: 1},
+		},
+	}
+
+	assert.Equal(t, `
+Enumeration profile:
+RESOURCE TYPE  DURATION  RESOURCES  API CALLS  RETRIES  
+aws_s3_bucket  1.5s      3          4          1        
+aws_iam_user   20ms      12         1          0        
+
+Repository methods profile:
+METHOD                       CALLS  DURATION  API CALLS  RETRIES  
+S3Repository.ListAllBuckets  7      20ms      1          0        
+`, FormatProfile(report))
+}
@@ -1,15 +1,34 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
 
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/pkg/analyser"
+	cmderrors "github.com/snyk/driftctl/pkg/cmd/errors"
+	"github.com/snyk/driftctl/pkg/cmd/scan/output"
+	"github.com/snyk/driftctl/pkg/filter"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/memstore"
+	globaloutput "github.com/snyk/driftctl/pkg/output"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/schemas"
 	"github.com/snyk/driftctl/test"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // TODO: Test successful scan
@@ -49,6 +68,8 @@ func TestScanCmd_Valid(t *testing.T) {
 		{args: []string{"scan", "-o", "html://result.html", "-o", "json://result.json"}},
 		{args: []string{"scan", "--tf-lockfile", "../.terraform.lock.hcl"}},
 		{args: []string{"scan", "--only-unmanaged"}},
+		{args: []string{"scan", "--fail-on-duplicate-ownership"}},
+		{args: []string{"scan", "--tf-encryption-dir", "infra"}},
 	}
 
 	for _, tt := range cases {
@@ -71,26 +92,36 @@ func TestScanCmd_Invalid(t *testing.T) {
 		{args: []string{"scan", "-e"}, expected: `unknown shorthand flag: 'e' in -e`},
 		{args: []string{"scan", "--error"}, expected: `unknown flag: --error`},
 		{args: []string{"scan", "-t"}, expected: `flag needs an argument: 't' in -t`},
-		{args: []string{"scan", "-t", "glou"}, expected: "unsupported cloud provider 'glou'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf"},
+		{args: []string{"scan", "-t", "glou"}, expected: "unsupported cloud provider 'glou'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf,k8s+tf,cloudflare+tf"},
 		{args: []string{"scan", "--to"}, expected: `flag needs an argument: --to`},
-		{args: []string{"scan", "--to", "glou"}, expected: "unsupported cloud provider 'glou'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf"},
+		{args: []string{"scan", "--to", "glou"}, expected: "unsupported cloud provider 'glou'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf,k8s+tf,cloudflare+tf"},
 		{args: []string{"scan", "-f"}, expected: `flag needs an argument: 'f' in -f`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+exec://,tfstate+git://"},
+		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+exec://,tfstate+git://"},
+		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+exec://,tfstate+git://"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+exec://,tfstate+git://"},
+		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+exec://,tfstate+git://"},
 		{args: []string{"scan", "--from", "unsupported://test"}, expected: "Unsupported IaC source 'unsupported': \nAccepted values are: tfstate"},
-		{args: []string{"scan", "--from", "tfstate+foobar://test"}, expected: "Unsupported IaC backend 'foobar': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm"},
-		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm"},
+		{args: []string{"scan", "--from", "tfstate+foobar://test"}, expected: "Unsupported IaC backend 'foobar': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm,consul,pg,kubernetes,exec,git"},
+		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm,consul,pg,kubernetes,exec,git"},
 		{args: []string{"scan", "--filter", "Type='test'"}, expected: "unable to parse filter expression: SyntaxError: Expected tRbracket, received: tUnknown"},
 		{args: []string{"scan", "--filter", "Type='test'", "--filter", "Type='test2'"}, expected: "Filter flag should be specified only once"},
 		{args: []string{"scan", "--tf-provider-version", ".30.2"}, expected: "Invalid version argument .30.2, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--tf-provider-version", "foo"}, expected: "Invalid version argument foo, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--driftignore"}, expected: "flag needs an argument: --driftignore"},
 		{args: []string{"scan", "--tf-lockfile"}, expected: "flag needs an argument: --tf-lockfile"},
+		{args: []string{"scan", "--timeout", "foo"}, expected: `invalid argument "foo" for "--timeout" flag: time: invalid duration "foo"`},
+		{args: []string{"scan", "--timeout", "-1m"}, expected: "Timeout flag should not be negative"},
+		{args: []string{"scan", "--enumerator-timeout", "-1m"}, expected: "Enumerator timeout flag should not be negative"},
+		{args: []string{"scan", "--parallelism", "0"}, expected: "Parallelism flag should be at least 1"},
+		{args: []string{"scan", "--record", "capture", "--replay", "capture"}, expected: "Record and replay flags cannot be used together"},
+		{args: []string{"scan", "--to", "gcp+tf", "--record", "capture"}, expected: "Record and replay flags are not supported for cloud provider 'gcp+tf'\nSupported values are: aws+tf,github+tf,azure+tf,k8s+tf,cloudflare+tf"},
+		{args: []string{"scan", "--to", "gcp+tf", "--replay", "capture"}, expected: "Record and replay flags are not supported for cloud provider 'gcp+tf'\nSupported values are: aws+tf,github+tf,azure+tf,k8s+tf,cloudflare+tf"},
+		{args: []string{"scan", "--rate-limit", "ec2=fast"}, expected: "invalid rate limit 'ec2=fast', expected a positive number of requests per second"},
+		{args: []string{"scan", "--rate-limit", "=10"}, expected: "invalid rate limit '=10', service name is empty"},
+		{args: []string{"scan", "--disable-middleware", "AwsDefaults", "--enable-middleware", "AwsDefaults"}, expected: "Middleware AwsDefaults cannot be both enabled and disabled"},
 	}
 
 	for _, tt := range cases {
@@ -140,6 +171,20 @@ func Test_Options(t *testing.T) {
 				assert.Equal(t, "", opts.ProviderVersion)
 			},
 		},
+		{
+			name: "should read the encryption configuration from the working directory",
+			args: []string{"scan"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, ".", opts.EncryptionDir)
+			},
+		},
+		{
+			name: "should read the encryption configuration from the given directory",
+			args: []string{"scan", "--tf-encryption-dir", "infra/states"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, "infra/states", opts.EncryptionDir)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -160,10 +205,11 @@ func Test_Options(t *testing.T) {
 
 func Test_RetrieveBackendsFromHCL(t *testing.T) {
 	cases := []struct {
-		name     string
-		dir      string
-		expected []config.SupplierConfig
-		wantErr  error
+		name          string
+		dir           string
+		allWorkspaces bool
+		expected      []config.SupplierConfig
+		wantErr       error
 	}{
 		{
 			name: "should parse s3 backend and ignore invalid file",
@@ -176,6 +222,18 @@ func Test_RetrieveBackendsFromHCL(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "should glob every workspace of s3 backend",
+			dir:           "testdata/backend/s3",
+			allWorkspaces: true,
+			expected: []config.SupplierConfig{
+				{
+					Key:     state.TerraformStateReaderSupplier,
+					Backend: backend.BackendKeyS3,
+					Path:    "terraform-state-prod/{network/terraform.tfstate,env:/*/network/terraform.tfstate}",
+				},
+			},
+		},
 		{
 			name:     "should not find any match and return empty slice",
 			dir:      "testdata/backend",
@@ -185,9 +243,114 @@ func Test_RetrieveBackendsFromHCL(t *testing.T) {
 
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
-			configs, err := retrieveBackendsFromHCL(tt.dir)
+			configs, err := retrieveBackendsFromHCL(tt.dir, tt.allWorkspaces)
 			assert.Equal(t, tt.wantErr, err)
 			assert.Equal(t, tt.expected, configs)
 		})
 	}
 }
+
+func Test_DiscoverBackends(t *testing.T) {
+	configs, err := discoverBackends("testdata/backend", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []config.SupplierConfig{
+		{
+			Key:     state.TerraformStateReaderSupplier,
+			Backend: backend.BackendKeyS3,
+			Path:    "terraform-state-prod/network/terraform.tfstate",
+		},
+	}, configs)
+
+	_, err = discoverBackends("testdata/fmt", false)
+	assert.EqualError(t, err, "no Terraform state configuration was found in testdata/fmt")
+}
+
+func Test_countDuplicateOwnership(t *testing.T) {
+	analysis := analyser.NewAnalysis()
+	assert.Equal(t, 0, countDuplicateOwnership(analysis))
+
+	bucket := &resource.Resource{Id: "bucket", Type: "aws_s3_bucket"}
+	analysis.SetAlerts(alerter.Alerts{
+		"": {
+			state.NewStateReadingAlert("tfstate://app.tfstate", errors.New("not found")),
+			state.NewDuplicateOwnershipAlert(bucket, []state.ResourceOwner{
+				{State: "tfstate://app.tfstate", Address: "aws_s3_bucket.assets"},
+				{State: "tfstate://cdn.tfstate", Address: "aws_s3_bucket.assets"},
+			}),
+		},
+		"aws_iam_role": {
+			&alerter.FakeAlert{Msg: "unrelated"},
+		},
+	})
+	assert.Equal(t, 1, countDuplicateOwnership(analysis))
+}
+
+func Test_runScan_Interrupted(t *testing.T) {
+	resultPath := filepath.Join(t.TempDir(), "result.json")
+	opts := &pkg.ScanOptions{
+		To:               common.RemoteAWSTerraform,
+		Output:           []output.OutputConfig{{Key: output.JSONOutputType, Path: resultPath}},
+		DisableTelemetry: true,
+	}
+
+	testFilter := &filter.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+	testFilter.On("IsResourceIgnored", mock.Anything).Return(false)
+
+	// The enumeration only ends once the scan context is canceled
+	started := make(chan struct{})
+	enumerator := &common.MockEnumerator{}
+	enumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+	enumerator.On("Enumerate", mock.Anything).Return(func(ctx context.Context) ([]*resource.Resource, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(enumerator)
+
+	testAlerter := alerter.NewAlerter()
+	scanner := remote.NewScanner(remoteLibrary, testAlerter, testFilter)
+
+	iacSupplier := &dctlresource.MockIaCSupplier{}
+	iacSupplier.On("Resources", mock.Anything).Return([]*resource.Resource{}, nil)
+	iacSupplier.On("SourceCount").Return(uint(1))
+
+	scanProgress := &globaloutput.MockProgress{}
+	scanProgress.On("Start").Return()
+	scanProgress.On("Stop").Return()
+	iacProgress := &globaloutput.MockProgress{}
+	iacProgress.On("Start").Return()
+	iacProgress.On("Stop").Return()
+
+	repo := schemas.NewSchemaRepository()
+	store := memstore.New()
+	ctl := pkg.NewDriftCTL(
+		scanner,
+		iacSupplier,
+		testAlerter,
+		analyser.NewAnalyzer(testAlerter, testFilter),
+		dctlresource.NewDriftctlResourceFactory(repo),
+		opts,
+		scanProgress,
+		iacProgress,
+		repo,
+		store,
+	)
+
+	ctx, stop := interruptContext(context.Background())
+	defer stop()
+	go func() {
+		<-started
+		_ = syscall.Kill(os.Getpid(), syscall.SIGINT)
+	}()
+
+	err := runScan(ctx, ctl, opts, store)
+	assert.Equal(t, cmderrors.PartialScan{}, err)
+
+	result, err := os.ReadFile(resultPath)
+	assert.NoError(t, err)
+	analysis := analyser.Analysis{}
+	assert.NoError(t, json.Unmarshal(result, &analysis))
+	assert.True(t, analysis.IsPartial())
+}
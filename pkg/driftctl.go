@@ -1,17 +1,23 @@
 package pkg
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/cmd/scan/output"
 	"github.com/snyk/driftctl/pkg/filter"
 	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/snyk/driftctl/pkg/memstore"
 	"github.com/snyk/driftctl/pkg/middlewares"
@@ -38,6 +44,35 @@ type ScanOptions struct {
 	ConfigDir        string
 	DriftignorePath  string
 	Driftignores     []string
+	// Timeout is the maximum duration of the whole scan, zero means no limit
+	Timeout time.Duration
+	// EnumeratorTimeout is the maximum duration to list a single resource type, zero means no limit
+	EnumeratorTimeout time.Duration
+	// Profile prints what listing each resource type cost at the end of the scan
+	Profile bool
+	// RateLimits bounds the concurrency of the scan and the rate of cloud API requests
+	RateLimits ratelimit.Config
+	// Record is a directory to record the cloud API traffic of the scan into
+	Record string
+	// Replay is a directory of recorded cloud API traffic to scan instead of the cloud provider
+	Replay string
+	// MiddlewareRules is a file of declarative middlewares to run after the built-in ones
+	MiddlewareRules string
+	// DisabledMiddlewares are middlewares not to run, by name
+	DisabledMiddlewares []string
+	// EnabledMiddlewares are middlewares to run even though strict mode disables them, by name
+	EnabledMiddlewares []string
+	// MiddlewareTrace is a file to write what each middleware added, removed or changed to
+	MiddlewareTrace string
+	// Discover is a directory to walk for terraform root modules and terragrunt modules, their
+	// states are scanned in addition to the --from ones
+	Discover string
+	// AllWorkspaces globs the states of every workspace of the backends found in Terraform files
+	AllWorkspaces bool
+	// EncryptionDir holds the Terraform files with the encryption block of encrypted states
+	EncryptionDir string
+	// FailOnDuplicateOwnership fails the scan when a resource is managed by several states
+	FailOnDuplicateOwnership bool
 }
 
 type DriftCTL struct {
@@ -51,6 +86,7 @@ type DriftCTL struct {
 	resourceSchemaRepository dctlresource.SchemaRepositoryInterface
 	opts                     *ScanOptions
 	store                    memstore.Store
+	extraMiddlewares         []middlewares.Middleware
 }
 
 func NewDriftCTL(remoteSupplier resource.Supplier,
@@ -74,79 +110,48 @@ func NewDriftCTL(remoteSupplier resource.Supplier,
 		resourceSchemaRepository,
 		opts,
 		store,
+		nil,
 	}
 }
 
-func (d DriftCTL) Run() (*analyser.Analysis, error) {
+// AddMiddlewares appends middlewares brought by plugins or rules files, they run after the built-in ones
+func (d *DriftCTL) AddMiddlewares(m ...middlewares.Middleware) {
+	d.extraMiddlewares = append(d.extraMiddlewares, m...)
+}
+
+func (d DriftCTL) Run(ctx context.Context) (*analyser.Analysis, error) {
 	start := time.Now()
-	remoteResources, resourcesFromState, err := d.scan()
+	middleware, err := d.middlewares()
 	if err != nil {
 		return nil, err
 	}
 
-	middleware := middlewares.NewChain(
-		middlewares.NewRoute53RecordIDReconcilier(),
-		middlewares.NewRoute53DefaultZoneRecordSanitizer(),
-		middlewares.NewS3BucketAcl(),
-		middlewares.NewAwsInstanceBlockDeviceResourceMapper(d.resourceFactory),
-		middlewares.NewAwsDefaultSecurityGroupRule(),
-		middlewares.NewVPCDefaultSecurityGroupSanitizer(),
-		middlewares.NewVPCSecurityGroupRuleSanitizer(d.resourceFactory),
-		middlewares.NewIamPolicyAttachmentTransformer(d.resourceFactory),
-		middlewares.NewIamPolicyAttachmentExpander(d.resourceFactory),
-		middlewares.AwsInstanceEIP{},
-		middlewares.NewAwsDefaultInternetGatewayRoute(),
-		middlewares.NewAwsDefaultInternetGateway(),
-		middlewares.NewAwsDefaultVPC(),
-		middlewares.NewAwsDefaultSubnet(),
-		middlewares.NewAwsRouteTableExpander(d.alerter, d.resourceFactory),
-		middlewares.NewAwsDefaultRouteTable(),
-		middlewares.NewAwsDefaultRoute(),
-		middlewares.NewAwsDefaultNetworkACL(),
-		middlewares.NewAwsDefaultNetworkACLRule(),
-		middlewares.NewAwsNetworkACLExpander(d.resourceFactory),
-		middlewares.NewAwsBucketPolicyExpander(d.resourceFactory),
-		middlewares.NewAwsSQSQueuePolicyExpander(d.resourceFactory, d.resourceSchemaRepository),
-		middlewares.NewAwsDefaultSQSQueuePolicy(),
-		middlewares.NewAwsSNSTopicPolicyExpander(d.resourceFactory, d.resourceSchemaRepository),
-		middlewares.NewAwsRoleManagedPolicyExpander(d.resourceFactory),
-		middlewares.NewTagsAllManager(),
-		middlewares.NewEipAssociationExpander(d.resourceFactory),
-		middlewares.NewAwsNatGatewayEipAssoc(),
-		middlewares.NewRDSClusterInstanceExpander(d.resourceFactory),
-		middlewares.NewAwsApiGatewayDeploymentExpander(d.resourceFactory),
-		middlewares.NewAwsApiGatewayResourceExpander(d.resourceFactory),
-		middlewares.NewAwsApiGatewayApiExpander(d.resourceFactory),
-		middlewares.NewAwsApiGatewayRestApiPolicyExpander(d.resourceFactory),
-		middlewares.NewAwsConsoleApiGatewayGatewayResponse(),
-		middlewares.NewAwsApiGatewayDomainNamesReconciler(),
-		middlewares.NewAwsApiGatewayBasePathMappingReconciler(),
-		middlewares.NewAwsEbsEncryptionByDefaultReconciler(d.resourceFactory),
-		middlewares.NewAwsALBTransformer(d.resourceFactory),
-		middlewares.NewAwsALBListenerTransformer(d.resourceFactory),
-
-		middlewares.NewGoogleIAMBindingTransformer(d.resourceFactory),
-		middlewares.NewGoogleIAMPolicyTransformer(d.resourceFactory),
-		middlewares.NewGoogleComputeInstanceGroupManagerReconciler(),
-
-		middlewares.NewAzurermRouteExpander(d.resourceFactory),
-		middlewares.NewAzurermSubnetExpander(d.resourceFactory),
-		middlewares.NewAwsS3BucketPublicAccessBlockReconciler(),
-	)
-
-	if !d.opts.StrictMode {
-		middleware = append(middleware,
-			middlewares.NewAwsDefaults(),
-			middlewares.NewGoogleLegacyBucketIAMMember(),
-			middlewares.NewGoogleDefaultIAMMember(),
-			middlewares.NewAwsDefaultApiGatewayAccount(),
-		)
+	remoteResources, resourcesFromState, err := d.scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// An interrupted scan still reports the resources already listed, so middlewares calling out
+	// of driftctl only keep the values of the scan context
+	middlewareCtx := ctx
+	if ctx.Err() != nil {
+		middlewareCtx = context.WithoutCancel(ctx)
 	}
 
 	logrus.Debug("Ready to run middlewares")
-	err = middleware.Execute(&remoteResources, &resourcesFromState)
-	if err != nil {
-		return nil, err
+	if d.opts.MiddlewareTrace != "" {
+		trace, err := middleware.ExecuteWithTrace(middlewareCtx, &remoteResources, &resourcesFromState)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeMiddlewareTrace(d.opts.MiddlewareTrace, trace); err != nil {
+			return nil, err
+		}
+	} else {
+		err = middleware.ExecuteContext(middlewareCtx, &remoteResources, &resourcesFromState)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if d.opts.Filter != nil {
@@ -161,12 +166,26 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		}
 	}
 
+	// Only resources left to analyse can be reported as managed by several states
+	analysed := make([]*resource.Resource, 0, len(resourcesFromState))
+	for _, res := range resourcesFromState {
+		if !d.analyzer.IsResourceIgnored(res) {
+			analysed = append(analysed, res)
+		}
+	}
+	for _, alert := range state.FindDuplicateOwnership(analysed) {
+		d.alerter.SendAlert("", alert)
+	}
+
 	analysis, err := d.analyzer.Analyze(remoteResources, resourcesFromState)
 	if err != nil {
 		return nil, err
 	}
 
 	analysis.SetIaCSourceCount(d.iacSupplier.SourceCount())
+	if profiledSupplier, ok := d.remoteSupplier.(resource.ProfiledSupplier); ok {
+		analysis.SetTimings(profiledSupplier.Profile())
+	}
 	analysis.Duration = time.Since(start)
 	analysis.Date = time.Now()
 
@@ -178,6 +197,152 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 	return &analysis, nil
 }
 
+// builtinMiddlewares returns the middlewares that always run and the ones that hide default
+// resources of cloud providers, which are disabled in strict mode
+func builtinMiddlewares(alerter alerter.AlerterInterface, resourceFactory resource.ResourceFactory, resourceSchemaRepository dctlresource.SchemaRepositoryInterface) (middlewares.Chain, middlewares.Chain) {
+	return middlewares.NewChain(
+			middlewares.NewRoute53RecordIDReconcilier(),
+			middlewares.NewRoute53DefaultZoneRecordSanitizer(),
+			middlewares.NewS3BucketAcl(),
+			middlewares.NewAwsInstanceBlockDeviceResourceMapper(resourceFactory),
+			middlewares.NewAwsDefaultSecurityGroupRule(),
+			middlewares.NewVPCDefaultSecurityGroupSanitizer(),
+			middlewares.NewVPCSecurityGroupRuleSanitizer(resourceFactory),
+			middlewares.NewIamPolicyAttachmentTransformer(resourceFactory),
+			middlewares.NewIamPolicyAttachmentExpander(resourceFactory),
+			middlewares.AwsInstanceEIP{},
+			middlewares.NewAwsDefaultInternetGatewayRoute(),
+			middlewares.NewAwsDefaultInternetGateway(),
+			middlewares.NewAwsDefaultVPC(),
+			middlewares.NewAwsDefaultSubnet(),
+			middlewares.NewAwsRouteTableExpander(alerter, resourceFactory),
+			middlewares.NewAwsDefaultRouteTable(),
+			middlewares.NewAwsDefaultRoute(),
+			middlewares.NewAwsDefaultNetworkACL(),
+			middlewares.NewAwsDefaultNetworkACLRule(),
+			middlewares.NewAwsNetworkACLExpander(resourceFactory),
+			middlewares.NewAwsBucketPolicyExpander(resourceFactory),
+			middlewares.NewAwsSQSQueuePolicyExpander(resourceFactory, resourceSchemaRepository),
+			middlewares.NewAwsDefaultSQSQueuePolicy(),
+			middlewares.NewAwsSNSTopicPolicyExpander(resourceFactory, resourceSchemaRepository),
+			middlewares.NewAwsRoleManagedPolicyExpander(resourceFactory),
+			middlewares.NewTagsAllManager(),
+			middlewares.NewEipAssociationExpander(resourceFactory),
+			middlewares.NewAwsNatGatewayEipAssoc(),
+			middlewares.NewRDSClusterInstanceExpander(resourceFactory),
+			middlewares.NewAwsApiGatewayDeploymentExpander(resourceFactory),
+			middlewares.NewAwsApiGatewayResourceExpander(resourceFactory),
+			middlewares.NewAwsApiGatewayApiExpander(resourceFactory),
+			middlewares.NewAwsApiGatewayRestApiPolicyExpander(resourceFactory),
+			middlewares.NewAwsConsoleApiGatewayGatewayResponse(),
+			middlewares.NewAwsApiGatewayDomainNamesReconciler(),
+			middlewares.NewAwsApiGatewayBasePathMappingReconciler(),
+			middlewares.NewAwsEbsEncryptionByDefaultReconciler(resourceFactory),
+			middlewares.NewAwsALBTransformer(resourceFactory),
+			middlewares.NewAwsALBListenerTransformer(resourceFactory),
+
+			middlewares.NewGoogleIAMBindingTransformer(resourceFactory),
+			middlewares.NewGoogleIAMPolicyTransformer(resourceFactory),
+			middlewares.NewGoogleComputeInstanceGroupManagerReconciler(),
+
+			middlewares.NewAzurermRouteExpander(resourceFactory),
+			middlewares.NewAzurermSubnetExpander(resourceFactory),
+			middlewares.NewAzurermKubernetesDefaultNodePool(),
+			middlewares.NewAwsS3BucketPublicAccessBlockReconciler(),
+
+			middlewares.NewKubernetesV1ResourceTransformer(resourceFactory),
+		), middlewares.NewChain(
+			middlewares.NewAwsDefaults(),
+			middlewares.NewGoogleLegacyBucketIAMMember(),
+			middlewares.NewGoogleDefaultIAMMember(),
+			middlewares.NewGoogleDefaultServiceAccount(),
+			middlewares.NewAwsDefaultApiGatewayAccount(),
+			middlewares.NewAzurermKubernetesNodeResourceGroup(),
+			middlewares.NewKubernetesSystemObjects(),
+		)
+}
+
+type MiddlewareInfo struct {
+	Name string
+	// DisabledInStrictMode is true for the middlewares that hide default resources
+	DisabledInStrictMode bool
+}
+
+// ListMiddlewares returns the built-in middlewares in the order they run
+func ListMiddlewares() []MiddlewareInfo {
+	base, defaults := builtinMiddlewares(nil, nil, nil)
+	results := make([]MiddlewareInfo, 0, len(base)+len(defaults))
+	for _, m := range base {
+		results = append(results, MiddlewareInfo{Name: middlewares.Name(m)})
+	}
+	for _, m := range defaults {
+		results = append(results, MiddlewareInfo{Name: middlewares.Name(m), DisabledInStrictMode: true})
+	}
+	return results
+}
+
+// middlewares returns the chain to run, built-in middlewares first then plugin and rule ones,
+// without the ones disabled by options
+func (d DriftCTL) middlewares() (middlewares.Chain, error) {
+	base, defaults := builtinMiddlewares(d.alerter, d.resourceFactory, d.resourceSchemaRepository)
+
+	known := make(map[string]struct{})
+	for _, m := range append(append(append(middlewares.Chain{}, base...), defaults...), d.extraMiddlewares...) {
+		known[middlewares.Name(m)] = struct{}{}
+	}
+	enabled := make(map[string]struct{}, len(d.opts.EnabledMiddlewares))
+	for _, name := range d.opts.EnabledMiddlewares {
+		if _, exist := known[name]; !exist {
+			return nil, errors.Errorf("unknown middleware %s, run driftctl middlewares to list them", name)
+		}
+		enabled[name] = struct{}{}
+	}
+	disabled := make(map[string]struct{}, len(d.opts.DisabledMiddlewares))
+	for _, name := range d.opts.DisabledMiddlewares {
+		if _, exist := known[name]; !exist {
+			return nil, errors.Errorf("unknown middleware %s, run driftctl middlewares to list them", name)
+		}
+		disabled[name] = struct{}{}
+	}
+
+	chain := make(middlewares.Chain, 0, len(known))
+	add := func(m middlewares.Middleware, enabledByDefault bool) {
+		name := middlewares.Name(m)
+		if _, exist := disabled[name]; exist {
+			logrus.WithField("middleware", name).Debug("Middleware disabled")
+			return
+		}
+		if _, exist := enabled[name]; !enabledByDefault && !exist {
+			return
+		}
+		chain = append(chain, m)
+	}
+	for _, m := range base {
+		add(m, true)
+	}
+	for _, m := range defaults {
+		add(m, !d.opts.StrictMode)
+	}
+	for _, m := range d.extraMiddlewares {
+		add(m, true)
+	}
+	return chain, nil
+}
+
+func writeMiddlewareTrace(path string, trace []middlewares.Trace) error {
+	if path == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(trace, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		return errors.Wrap(err, "unable to write middleware trace")
+	}
+	return nil
+}
+
 func (d DriftCTL) Stop() {
 	stoppableSupplier, ok := d.remoteSupplier.(resource.StoppableSupplier)
 	if ok {
@@ -193,10 +358,10 @@ func (d DriftCTL) Stop() {
 	}
 }
 
-func (d DriftCTL) scan() (remoteResources []*resource.Resource, resourcesFromState []*resource.Resource, err error) {
+func (d DriftCTL) scan(ctx context.Context) (remoteResources []*resource.Resource, resourcesFromState []*resource.Resource, err error) {
 	logrus.Info("Start reading IaC")
 	d.iacProgress.Start()
-	resourcesFromState, err = d.iacSupplier.Resources()
+	resourcesFromState, err = d.iacSupplier.Resources(ctx)
 	d.iacProgress.Stop()
 	if err != nil {
 		return nil, nil, err
@@ -205,7 +370,7 @@ func (d DriftCTL) scan() (remoteResources []*resource.Resource, resourcesFromSta
 	logrus.Info("Start scanning cloud provider")
 	d.scanProgress.Start()
 	defer d.scanProgress.Stop()
-	remoteResources, err = d.remoteSupplier.Resources()
+	remoteResources, err = d.remoteSupplier.Resources(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
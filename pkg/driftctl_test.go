@@ -1,6 +1,7 @@
 package pkg_test
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path"
@@ -12,6 +13,7 @@ import (
 	"github.com/snyk/driftctl/pkg"
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state"
 	"github.com/snyk/driftctl/pkg/memstore"
 	"github.com/snyk/driftctl/pkg/output"
 	dctlresource "github.com/snyk/driftctl/pkg/resource"
@@ -61,7 +63,7 @@ func runTest(t *testing.T, cases TestCases) {
 			}
 
 			stateSupplier := &dctlresource.MockIaCSupplier{}
-			stateSupplier.On("Resources").Return(c.stateResources, nil)
+			stateSupplier.On("Resources", mock.Anything).Return(c.stateResources, nil)
 			stateSupplier.On("SourceCount").Return(uint(2))
 
 			if c.remoteResources == nil {
@@ -73,7 +75,7 @@ func runTest(t *testing.T, cases TestCases) {
 				res.Sch = schema
 			}
 			remoteSupplier := &resource.MockSupplier{}
-			remoteSupplier.On("Resources").Return(c.remoteResources, nil)
+			remoteSupplier.On("Resources", mock.Anything).Return(c.remoteResources, nil)
 
 			var resourceFactory resource.ResourceFactory = dctlresource.NewDriftctlResourceFactory(repo)
 
@@ -97,7 +99,7 @@ func runTest(t *testing.T, cases TestCases) {
 			store := memstore.New()
 			driftctl := pkg.NewDriftCTL(remoteSupplier, stateSupplier, testAlerter, analyzer, resourceFactory, c.options, scanProgress, iacProgress, repo, store)
 
-			analysis, err := driftctl.Run()
+			analysis, err := driftctl.Run(context.Background())
 
 			c.assert(t, test.NewScanResult(t, analysis), err)
 			if c.assertStore != nil {
@@ -540,6 +542,42 @@ func TestDriftctlRun_BasicFilter(t *testing.T) {
 
 func TestDriftctlRun_Middlewares(t *testing.T) {
 	cases := TestCases{
+		{
+			name: "test disabled bucket policy expander middleware",
+			stateResources: []*resource.Resource{
+				&resource.Resource{
+					Id:   "foo",
+					Type: aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{
+						"bucket": "foo",
+						"policy": "{\"Id\":\"foo\"}",
+					},
+				},
+			},
+			remoteResources: []*resource.Resource{
+				&resource.Resource{
+					Id:   "foo",
+					Type: aws.AwsS3BucketPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"id":     "foo",
+						"bucket": "foo",
+						"policy": "{\"Id\":\"bar\"}",
+					},
+				},
+			},
+			assert: func(t *testing.T, result *test.ScanResult, err error) {
+				result.AssertManagedCount(0)
+			},
+			options: func(t *testing.T) *pkg.ScanOptions {
+				filterStr := "Type=='aws_s3_bucket_policy' && Attr.bucket=='foo'"
+				f, err := filter.BuildExpression(filterStr)
+				if err != nil {
+					t.Fatalf("Unable to build filter expression: %s\n%s", filterStr, err)
+				}
+
+				return &pkg.ScanOptions{Filter: f, DisabledMiddlewares: []string{"AwsBucketPolicyExpander"}}
+			}(t),
+		},
 		{
 			name: "test bucket policy expander middleware",
 			stateResources: []*resource.Resource{
@@ -1209,10 +1247,12 @@ func TestDriftctlRun_TestResourcesNormalization(t *testing.T) {
 	}
 
 	defaultProviderVersions := map[string]string{
-		"aws":     "3.19.0",
-		"github":  "4.4.0",
-		"google":  "3.78.0",
-		"azurerm": "2.71.0",
+		"aws":        "3.19.0",
+		"github":     "4.4.0",
+		"google":     "3.78.0",
+		"azurerm":    "2.71.0",
+		"kubernetes": "2.13.1",
+		"cloudflare": "3.35.0",
 	}
 
 	cases := []normalizationTestCase{}
@@ -1327,10 +1367,10 @@ func TestDriftctlRun_TestResourcesNormalization(t *testing.T) {
 			analyzer := analyser.NewAnalyzer(testAlerter, testFilter)
 
 			stateSupplier := &dctlresource.MockIaCSupplier{}
-			stateSupplier.On("Resources").Return(expectedResources, nil)
+			stateSupplier.On("Resources", mock.Anything).Return(expectedResources, nil)
 			stateSupplier.On("SourceCount").Return(uint(1))
 			remoteSupplier := &resource.MockSupplier{}
-			remoteSupplier.On("Resources").Return(inputResources, nil)
+			remoteSupplier.On("Resources", mock.Anything).Return(inputResources, nil)
 
 			driftctl := pkg.NewDriftCTL(
 				remoteSupplier,
@@ -1345,7 +1385,7 @@ func TestDriftctlRun_TestResourcesNormalization(t *testing.T) {
 				store,
 			)
 
-			analysis, err := driftctl.Run()
+			analysis, err := driftctl.Run(context.Background())
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1355,3 +1395,61 @@ func TestDriftctlRun_TestResourcesNormalization(t *testing.T) {
 	}
 
 }
+
+func TestDriftctlRun_DuplicateOwnership(t *testing.T) {
+	duplicates := func() []*resource.Resource {
+		return []*resource.Resource{
+			{
+				Id:     "bucket",
+				Type:   "aws_s3_bucket",
+				Attrs:  &resource.Attributes{},
+				Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "", "assets"),
+			},
+			{
+				Id:     "bucket",
+				Type:   "aws_s3_bucket",
+				Attrs:  &resource.Attributes{},
+				Source: resource.NewTerraformStateSource("tfstate://cdn.tfstate", "", "assets"),
+			},
+		}
+	}
+	countAlerts := func(result *test.ScanResult) int {
+		count := 0
+		for _, alert := range result.Alerts()[""] {
+			if _, ok := alert.(*state.DuplicateOwnershipAlert); ok {
+				count++
+			}
+		}
+		return count
+	}
+
+	cases := TestCases{
+		{
+			name:           "resource managed by several states",
+			provider:       &TestProvider{Name: "aws", Version: "3.62.0"},
+			stateResources: duplicates(),
+			assert: func(t *testing.T, result *test.ScanResult, err error) {
+				result.NoError(err)
+				result.Equal(1, countAlerts(result))
+			},
+		},
+		{
+			name:           "resource managed by several states left out by the filter",
+			provider:       &TestProvider{Name: "aws", Version: "3.62.0"},
+			stateResources: duplicates(),
+			assert: func(t *testing.T, result *test.ScanResult, err error) {
+				result.NoError(err)
+				result.Equal(0, countAlerts(result))
+			},
+			options: func(t *testing.T) *pkg.ScanOptions {
+				f, err := filter.BuildExpression("Type=='aws_iam_role'")
+				if err != nil {
+					t.Fatal(err)
+				}
+				return &pkg.ScanOptions{Filter: f}
+			}(t),
+		},
+	}
+
+	runTest(t, cases)
+}
@@ -0,0 +1,92 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// CachedEnumerator lists resources once and hands a copy of them to every analysis, as middlewares alter
+// the resources they are given. An enumeration interrupted by its context is not cached so that the next
+// analysis tries again.
+type CachedEnumerator struct {
+	enumerator common.Enumerator
+	lock       sync.Mutex
+	done       bool
+	resources  []*resource.Resource
+	err        error
+}
+
+func NewCachedEnumerator(enumerator common.Enumerator) *CachedEnumerator {
+	return &CachedEnumerator{enumerator: enumerator}
+}
+
+// NewCachedLibrary returns a library with the enumerators of library, each of them caching its resources
+func NewCachedLibrary(library *common.RemoteLibrary) *common.RemoteLibrary {
+	cached := common.NewRemoteLibrary()
+	for _, enumerator := range library.Enumerators() {
+		cached.AddEnumerator(NewCachedEnumerator(enumerator))
+	}
+	return cached
+}
+
+func (e *CachedEnumerator) SupportedType() resource.ResourceType {
+	return e.enumerator.SupportedType()
+}
+
+func (e *CachedEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if !e.done {
+		resources, err := e.enumerator.Enumerate(ctx)
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		e.resources, e.err, e.done = resources, err, true
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	resources := make([]*resource.Resource, 0, len(e.resources))
+	for _, res := range e.resources {
+		if res == nil {
+			continue
+		}
+		resources = append(resources, copyResource(res))
+	}
+	return resources, nil
+}
+
+func copyResource(res *resource.Resource) *resource.Resource {
+	copied := *res
+	if res.Attrs != nil {
+		attrs := copyValue(map[string]interface{}(*res.Attrs)).(map[string]interface{})
+		copied.Attrs = (*resource.Attributes)(&attrs)
+	}
+	return &copied
+}
+
+// copyValue deep copies attribute values, Attributes.Copy only copies the first level
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			copied[key] = copyValue(val)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, val := range v {
+			copied[i] = copyValue(val)
+		}
+		return copied
+	default:
+		return value
+	}
+}
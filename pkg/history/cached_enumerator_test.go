@@ -0,0 +1,105 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCachedEnumerator_Enumerate(t *testing.T) {
+	remoteResources := []*resource.Resource{
+		{
+			Id:   "bucket",
+			Type: "aws_s3_bucket",
+			Attrs: &resource.Attributes{
+				"tags":  map[string]interface{}{"env": "prod"},
+				"rules": []interface{}{map[string]interface{}{"id": "expire"}},
+			},
+		},
+	}
+	enumerator := &common.MockEnumerator{}
+	enumerator.On("Enumerate", context.Background()).Return(remoteResources, nil).Once()
+
+	cached := NewCachedEnumerator(enumerator)
+
+	first, err := cached.Enumerate(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, remoteResources, first)
+
+	// Middlewares alter the resources of an analysis, it must not leak to the next one
+	(*first[0].Attrs)["tags"].(map[string]interface{})["env"] = "dev"
+	(*first[0].Attrs)["rules"].([]interface{})[0].(map[string]interface{})["id"] = "archive"
+	first[0].Id = "altered"
+
+	second, err := cached.Enumerate(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, remoteResources, second)
+	assert.Equal(t, "prod", (*remoteResources[0].Attrs)["tags"].(map[string]interface{})["env"])
+
+	enumerator.AssertExpectations(t)
+}
+
+func TestCachedEnumerator_Error(t *testing.T) {
+	enumerator := &common.MockEnumerator{}
+	enumerator.On("Enumerate", context.Background()).Return(nil, errors.New("enumeration failed")).Once()
+
+	cached := NewCachedEnumerator(enumerator)
+
+	_, err := cached.Enumerate(context.Background())
+	assert.EqualError(t, err, "enumeration failed")
+	_, err = cached.Enumerate(context.Background())
+	assert.EqualError(t, err, "enumeration failed")
+
+	enumerator.AssertExpectations(t)
+}
+
+func TestCachedEnumerator_Interrupted(t *testing.T) {
+	remoteResources := []*resource.Resource{{Id: "bucket", Type: "aws_s3_bucket"}}
+	enumerator := &common.MockEnumerator{}
+	enumerator.On("Enumerate", context.Background()).Return(nil, context.DeadlineExceeded).Once()
+	enumerator.On("Enumerate", context.Background()).Return(remoteResources, nil).Once()
+
+	cached := NewCachedEnumerator(enumerator)
+
+	_, err := cached.Enumerate(context.Background())
+	assert.Equal(t, context.DeadlineExceeded, err)
+	got, err := cached.Enumerate(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, remoteResources, got)
+
+	enumerator.AssertExpectations(t)
+}
+
+func TestNewCachedLibrary(t *testing.T) {
+	err := errors.New("connection reset")
+	enumerator := &common.MockEnumerator{}
+	enumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+	enumerator.On("Enumerate", mock.Anything).Return(nil, err).Once()
+	library := common.NewRemoteLibrary()
+	library.AddEnumerator(enumerator)
+
+	testFilter := &filter.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	// Every analysis is told about the failed enumeration, which only ran once
+	cached := NewCachedLibrary(library)
+	for i := 0; i < 2; i++ {
+		revisionAlerter := alerter.NewAlerter()
+		_, scanErr := remote.NewScanner(cached, revisionAlerter, testFilter).Resources(context.Background())
+		assert.NoError(t, scanErr)
+		assert.Equal(t, alerter.Alerts{
+			"aws_s3_bucket": {alerts.NewIncompleteEnumerationAlert("aws_s3_bucket", err)},
+		}, revisionAlerter.Retrieve())
+	}
+
+	enumerator.AssertExpectations(t)
+}
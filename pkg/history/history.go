@@ -0,0 +1,107 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+)
+
+// Revision is a commit that changed the state, newest first in a Report
+type Revision struct {
+	Hash    string
+	When    time.Time
+	Author  string
+	Message string
+}
+
+// Disappearance tells when a resource missing from the state left it
+type Disappearance struct {
+	Resource *resource.Resource
+	// RemovedIn is the first revision without the resource, zero when LastSeen is nil since no
+	// analysed revision tells when the resource was removed
+	RemovedIn Revision
+	// LastSeen is the last revision managing the resource, nil when no analysed revision did
+	LastSeen *Revision
+}
+
+type Report struct {
+	Revisions      []Revision
+	Disappearances []Disappearance
+	// Analysis of the newest revision
+	Analysis *analyser.Analysis
+}
+
+// AnalyseFunc runs the analysis against the state at a given revision
+type AnalyseFunc func(ctx context.Context, revision Revision) (*analyser.Analysis, error)
+
+// Run analyses revisions, newest first, until every resource unmanaged in the newest one is found managed in an older
+// one or revisions are exhausted
+func Run(ctx context.Context, revisions []Revision, analyse AnalyseFunc) (*Report, error) {
+	report := &Report{}
+	if len(revisions) == 0 {
+		return report, nil
+	}
+
+	analysis, err := analyse(ctx, revisions[0])
+	if err != nil {
+		return nil, err
+	}
+	report.Analysis = analysis
+	report.Revisions = append(report.Revisions, revisions[0])
+
+	pending := analysis.Unmanaged()
+	found := make(map[*resource.Resource]Disappearance, len(pending))
+
+	for i := 1; i < len(revisions) && len(found) < len(pending); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		revision := revisions[i]
+		analysis, err := analyse(ctx, revision)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"revision": revision.Hash,
+				"error":    err,
+			}).Warn("Unable to analyse revision, skipping")
+			continue
+		}
+		report.Revisions = append(report.Revisions, revision)
+
+		for _, res := range pending {
+			if _, exist := found[res]; exist {
+				continue
+			}
+			if !containsResource(analysis.Managed(), res) {
+				continue
+			}
+			lastSeen := revision
+			found[res] = Disappearance{
+				Resource:  res,
+				RemovedIn: report.Revisions[len(report.Revisions)-2],
+				LastSeen:  &lastSeen,
+			}
+		}
+	}
+
+	for _, res := range pending {
+		disappearance, exist := found[res]
+		if !exist {
+			disappearance = Disappearance{Resource: res}
+		}
+		report.Disappearances = append(report.Disappearances, disappearance)
+	}
+
+	return report, nil
+}
+
+func containsResource(resources []*resource.Resource, res *resource.Resource) bool {
+	for _, r := range resources {
+		if r.Equal(res) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/stretchr/testify/assert"
+)
+
+func analysisOf(managed []*resource.Resource, unmanaged []*resource.Resource) *analyser.Analysis {
+	analysis := &analyser.Analysis{}
+	analysis.AddManaged(managed...)
+	analysis.AddUnmanaged(unmanaged...)
+	return analysis
+}
+
+func TestRun(t *testing.T) {
+	bucket := &resource.Resource{Id: "bucket", Type: "aws_s3_bucket"}
+	role := &resource.Resource{Id: "role", Type: "aws_iam_role"}
+	user := &resource.Resource{Id: "user", Type: "aws_iam_user"}
+
+	revisions := []Revision{
+		{Hash: "c3", When: time.Unix(3, 0)},
+		{Hash: "c2", When: time.Unix(2, 0)},
+		{Hash: "c1", When: time.Unix(1, 0)},
+		{Hash: "c0", When: time.Unix(0, 0)},
+	}
+	analyses := map[string]*analyser.Analysis{
+		"c3": analysisOf(nil, []*resource.Resource{bucket, role, user}),
+		"c2": analysisOf([]*resource.Resource{bucket}, []*resource.Resource{role, user}),
+		"c0": analysisOf([]*resource.Resource{bucket, role}, []*resource.Resource{user}),
+	}
+	analysed := make([]string, 0)
+
+	report, err := Run(context.Background(), revisions, func(ctx context.Context, revision Revision) (*analyser.Analysis, error) {
+		analysed = append(analysed, revision.Hash)
+		analysis, exist := analyses[revision.Hash]
+		if !exist {
+			return nil, errors.New("state not found")
+		}
+		return analysis, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c3", "c2", "c1", "c0"}, analysed)
+	assert.Equal(t, analyses["c3"], report.Analysis)
+	assert.Equal(t, []Revision{revisions[0], revisions[1], revisions[3]}, report.Revisions)
+	assert.Equal(t, []Disappearance{
+		{Resource: bucket, RemovedIn: revisions[0], LastSeen: &revisions[1]},
+		{Resource: role, RemovedIn: revisions[1], LastSeen: &revisions[3]},
+		{Resource: user},
+	}, report.Disappearances)
+}
+
+func TestRun_StopWhenEveryResourceIsFound(t *testing.T) {
+	bucket := &resource.Resource{Id: "bucket", Type: "aws_s3_bucket"}
+	revisions := []Revision{{Hash: "c2"}, {Hash: "c1"}, {Hash: "c0"}}
+	analysed := make([]string, 0)
+
+	report, err := Run(context.Background(), revisions, func(ctx context.Context, revision Revision) (*analyser.Analysis, error) {
+		analysed = append(analysed, revision.Hash)
+		if revision.Hash == "c2" {
+			return analysisOf(nil, []*resource.Resource{bucket}), nil
+		}
+		return analysisOf([]*resource.Resource{bucket}, nil), nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c2", "c1"}, analysed)
+	assert.Len(t, report.Disappearances, 1)
+	assert.Equal(t, "c2", report.Disappearances[0].RemovedIn.Hash)
+}
+
+func TestRun_NewestRevisionError(t *testing.T) {
+	_, err := Run(context.Background(), []Revision{{Hash: "c1"}}, func(ctx context.Context, revision Revision) (*analyser.Analysis, error) {
+		return nil, errors.New("state not found")
+	})
+	assert.EqualError(t, err, "state not found")
+}
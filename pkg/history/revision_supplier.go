@@ -0,0 +1,69 @@
+package history
+
+import (
+	"context"
+	"sync"
+
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+type recordedAlert struct {
+	key   string
+	alert alerter.Alert
+}
+
+// AlertRecorder keeps the alerts enumerators send, cached enumerators only run for the first
+// analysis so their alerts are replayed to the alerter of every analysis
+type AlertRecorder struct {
+	lock   sync.Mutex
+	alerts []recordedAlert
+}
+
+func NewAlertRecorder() *AlertRecorder {
+	return &AlertRecorder{}
+}
+
+func (r *AlertRecorder) SendAlert(key string, alert alerter.Alert) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.alerts = append(r.alerts, recordedAlert{key: key, alert: alert})
+}
+
+// Replay sends every recorded alert to alerter
+func (r *AlertRecorder) Replay(alerter alerter.AlerterInterface) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, recorded := range r.alerts {
+		alerter.SendAlert(recorded.key, recorded.alert)
+	}
+}
+
+// RevisionSupplier lists the remote resources of an analysis, then sends the analysis alerter
+// the alerts enumerators recorded
+type RevisionSupplier struct {
+	supplier resource.Supplier
+	recorder *AlertRecorder
+	alerter  alerter.AlerterInterface
+}
+
+func NewRevisionSupplier(supplier resource.Supplier, recorder *AlertRecorder, alerter alerter.AlerterInterface) *RevisionSupplier {
+	return &RevisionSupplier{supplier: supplier, recorder: recorder, alerter: alerter}
+}
+
+func (s *RevisionSupplier) Resources(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := s.supplier.Resources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.recorder.Replay(s.alerter)
+	return resources, nil
+}
+
+func (s *RevisionSupplier) Profile() *profile.Report {
+	if profiled, ok := s.supplier.(resource.ProfiledSupplier); ok {
+		return profiled.Profile()
+	}
+	return nil
+}
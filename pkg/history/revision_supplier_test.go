@@ -0,0 +1,43 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevisionSupplier_Resources(t *testing.T) {
+	remote := []*resource.Resource{{Id: "bucket", Type: "aws_s3_bucket"}}
+	recorder := NewAlertRecorder()
+	alert := &alerter.FakeAlert{Msg: "unable to list bucket policies"}
+
+	supplier := &resource.MockSupplier{}
+	supplier.On("Resources", context.Background()).Run(func(_ mock.Arguments) {
+		recorder.SendAlert("aws_s3_bucket_policy", alert)
+	}).Return(remote, nil).Once()
+	supplier.On("Resources", context.Background()).Return(remote, nil).Once()
+
+	// Each analysis gets the recorded alerts, even though enumerators only ran for the first one
+	for i := 0; i < 2; i++ {
+		revisionAlerter := alerter.NewAlerter()
+		got, err := NewRevisionSupplier(supplier, recorder, revisionAlerter).Resources(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, remote, got)
+		assert.Equal(t, alerter.Alerts{"aws_s3_bucket_policy": {alert}}, revisionAlerter.Retrieve())
+	}
+
+	supplier.AssertExpectations(t)
+}
+
+func TestRevisionSupplier_Error(t *testing.T) {
+	supplier := &resource.MockSupplier{}
+	supplier.On("Resources", context.Background()).Return(nil, errors.New("enumeration failed"))
+
+	_, err := NewRevisionSupplier(supplier, NewAlertRecorder(), alerter.NewAlerter()).Resources(context.Background())
+	assert.EqualError(t, err, "enumeration failed")
+}
@@ -13,13 +13,10 @@ import (
 
 type IacChainSupplier struct {
 	suppliers []resource2.IaCSupplier
-	runner    *parallel.ParallelRunner
 }
 
 func NewIacChainSupplier() *IacChainSupplier {
-	return &IacChainSupplier{
-		runner: parallel.NewParallelRunner(context.TODO(), int64(runtime.NumCPU())),
-	}
+	return &IacChainSupplier{}
 }
 
 func (r *IacChainSupplier) SourceCount() uint {
@@ -34,12 +31,13 @@ func (r *IacChainSupplier) AddSupplier(supplier resource2.IaCSupplier) {
 	r.suppliers = append(r.suppliers, supplier)
 }
 
-func (r *IacChainSupplier) Resources() ([]*resource.Resource, error) {
+func (r *IacChainSupplier) Resources(ctx context.Context) ([]*resource.Resource, error) {
+	runner := parallel.NewParallelRunner(ctx, int64(runtime.NumCPU()))
 
 	for _, supplier := range r.suppliers {
 		sup := supplier
-		r.runner.Run(func() (interface{}, error) {
-			resources, err := sup.Resources()
+		runner.Run(func() (interface{}, error) {
+			resources, err := sup.Resources(ctx)
 			return &result{err, resources}, nil
 		})
 	}
@@ -50,7 +48,7 @@ func (r *IacChainSupplier) Resources() ([]*resource.Resource, error) {
 ReadLoop:
 	for {
 		select {
-		case supplierResult, ok := <-r.runner.Read():
+		case supplierResult, ok := <-runner.Read():
 			if !ok || supplierResult == nil {
 				break ReadLoop
 			}
@@ -64,13 +62,17 @@ ReadLoop:
 			}
 			isSuccess = true
 			results = append(results, result.res...)
-		case <-r.runner.DoneChan():
+		case <-runner.DoneChan():
 			break ReadLoop
 		}
 	}
 
-	if r.runner.Err() != nil {
-		return nil, r.runner.Err()
+	if runner.Err() != nil {
+		return nil, runner.Err()
+	}
+	// The runner can be done before it reports the error of a canceled context
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
 	if !isSuccess {
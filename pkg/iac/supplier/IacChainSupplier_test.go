@@ -1,12 +1,14 @@
 package supplier
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/snyk/driftctl/enumeration/resource"
 	resource2 "github.com/snyk/driftctl/pkg/resource"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestIacChainSupplier_Resources(t *testing.T) {
@@ -20,15 +22,15 @@ func TestIacChainSupplier_Resources(t *testing.T) {
 			name: "All failed",
 			initSuppliers: func(suppliers *[]resource2.IaCSupplier) {
 				sup := &resource2.MockIaCSupplier{}
-				sup.On("Resources").Return(nil, errors.New("1"))
+				sup.On("Resources", mock.Anything).Return(nil, errors.New("1"))
 				*suppliers = append(*suppliers, sup)
 
 				sup = &resource2.MockIaCSupplier{}
-				sup.On("Resources").Return(nil, errors.New("2"))
+				sup.On("Resources", mock.Anything).Return(nil, errors.New("2"))
 				*suppliers = append(*suppliers, sup)
 
 				sup = &resource2.MockIaCSupplier{}
-				sup.On("Resources").Return(nil, errors.New("3"))
+				sup.On("Resources", mock.Anything).Return(nil, errors.New("3"))
 				*suppliers = append(*suppliers, sup)
 			},
 			want:    nil,
@@ -38,15 +40,15 @@ func TestIacChainSupplier_Resources(t *testing.T) {
 			name: "Partial failed",
 			initSuppliers: func(suppliers *[]resource2.IaCSupplier) {
 				sup := &resource2.MockIaCSupplier{}
-				sup.On("Resources").Return(nil, errors.New("1"))
+				sup.On("Resources", mock.Anything).Return(nil, errors.New("1"))
 				*suppliers = append(*suppliers, sup)
 
 				sup = &resource2.MockIaCSupplier{}
-				sup.On("Resources").Return(nil, errors.New("2"))
+				sup.On("Resources", mock.Anything).Return(nil, errors.New("2"))
 				*suppliers = append(*suppliers, sup)
 
 				sup = &resource2.MockIaCSupplier{}
-				sup.On("Resources").Return([]*resource.Resource{
+				sup.On("Resources", mock.Anything).Return([]*resource.Resource{
 					&resource.Resource{
 						Id:    "ID",
 						Type:  "TYPE",
@@ -75,7 +77,7 @@ func TestIacChainSupplier_Resources(t *testing.T) {
 				r.AddSupplier(supplier)
 			}
 
-			got, err := r.Resources()
+			got, err := r.Resources(context.Background())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Resources() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -117,6 +117,11 @@ func TestGetSupportedSchemes(t *testing.T) {
 		"tfstate+tfcloud://",
 		"tfstate+gs://",
 		"tfstate+azurerm://",
+		"tfstate+consul://",
+		"tfstate+pg://",
+		"tfstate+kubernetes://",
+		"tfstate+exec://",
+		"tfstate+git://",
 	}
 
 	if got := GetSupportedSchemes(); !reflect.DeepEqual(got, want) {
@@ -14,11 +14,12 @@ import (
 const BackendKeyAzureRM = "azurerm"
 
 type AzureRMBackend struct {
+	ctx           context.Context
 	reader        io.ReadCloser
 	storageClient azblob.BlockBlobClient
 }
 
-func NewAzureRMReader(path string, opts options.AzureRMBackendOptions) (*AzureRMBackend, error) {
+func NewAzureRMReader(ctx context.Context, path string, opts options.AzureRMBackendOptions) (*AzureRMBackend, error) {
 	bucketPath := strings.Split(path, "/")
 	if len(bucketPath) < 2 || bucketPath[1] == "" {
 		return nil, errors.Errorf("Unable to parse azurerm backend storage path: %s. Must be CONTAINER/PATH/TO/OBJECT", path)
@@ -46,14 +47,14 @@ func NewAzureRMReader(path string, opts options.AzureRMBackendOptions) (*AzureRM
 	}
 
 	return &AzureRMBackend{
+		ctx:           ctx,
 		storageClient: blobClient,
 	}, nil
 }
 
 func (s *AzureRMBackend) Read(p []byte) (int, error) {
 	if s.reader == nil {
-		ctx := context.Background()
-		data, err := s.storageClient.Download(ctx, nil)
+		data, err := s.storageClient.Download(s.ctx, nil)
 		if err != nil {
 			return 0, err
 		}
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/encryption"
 )
 
 var supportedBackends = []string{
@@ -18,6 +20,11 @@ var supportedBackends = []string{
 	BackendKeyTFCloud,
 	BackendKeyGS,
 	BackendKeyAzureRM,
+	BackendKeyConsul,
+	BackendKeyPostgres,
+	BackendKeyKubernetes,
+	BackendKeyExec,
+	BackendKeyGit,
 }
 
 type Backend io.ReadCloser
@@ -26,6 +33,14 @@ type Options struct {
 	Headers         map[string]string
 	TFCloudToken    string
 	TFCloudEndpoint string
+	// TFCloudWorkspaceTags only keeps the workspaces having all of these tags when listing an organization
+	TFCloudWorkspaceTags []string
+	// TFCloudProject only keeps the workspaces of this project ID when listing an organization
+	TFCloudProject string
+	// TerraformBinary is the terraform or tofu binary the exec backend pulls states with
+	TerraformBinary string
+	// Encryption decrypts states encrypted by OpenTofu, nil when none is configured
+	Encryption *encryption.Config
 	options.AzureRMBackendOptions
 }
 
@@ -39,7 +54,7 @@ func IsSupported(backend string) bool {
 	return false
 }
 
-func GetBackend(config config.SupplierConfig, opts *Options) (Backend, error) {
+func GetBackend(ctx context.Context, config config.SupplierConfig, opts *Options) (Backend, error) {
 	backend := config.Backend
 
 	if !IsSupported(backend) {
@@ -50,17 +65,27 @@ func GetBackend(config config.SupplierConfig, opts *Options) (Backend, error) {
 	case BackendKeyFile:
 		return NewFileReader(config.Path)
 	case BackendKeyS3:
-		return NewS3Reader(config.Path)
+		return NewS3Reader(ctx, config.Path)
 	case BackendKeyHTTP:
 		fallthrough
 	case BackendKeyHTTPS:
-		return NewHTTPReader(&http.Client{}, fmt.Sprintf("%s://%s", config.Backend, config.Path), opts)
+		return NewHTTPReader(ctx, &http.Client{}, fmt.Sprintf("%s://%s", config.Backend, config.Path), opts)
 	case BackendKeyTFCloud:
-		return NewTFCloudReader(config.Path, opts), nil
+		return NewTFCloudReader(ctx, config.Path, opts), nil
 	case BackendKeyGS:
-		return NewGSReader(config.Path)
+		return NewGSReader(ctx, config.Path)
 	case BackendKeyAzureRM:
-		return NewAzureRMReader(config.Path, opts.AzureRMBackendOptions)
+		return NewAzureRMReader(ctx, config.Path, opts.AzureRMBackendOptions)
+	case BackendKeyConsul:
+		return NewConsulReader(ctx, config.Path)
+	case BackendKeyPostgres:
+		return NewPGReader(ctx, config.Path)
+	case BackendKeyKubernetes:
+		return NewKubernetesReader(ctx, config.Path)
+	case BackendKeyExec:
+		return NewExecReader(ctx, config.Path, opts)
+	case BackendKeyGit:
+		return NewGitReader(config.Path)
 	default:
 		return nil, errors.Errorf("Unsupported backend '%s'", backend)
 	}
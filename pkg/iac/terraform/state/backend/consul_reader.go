@@ -0,0 +1,155 @@
+package backend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const BackendKeyConsul = "consul"
+
+// ConsulClient reads the Consul KV store, it is configured like the terraform consul backend
+// through CONSUL_HTTP_ADDR, CONSUL_HTTP_TOKEN and CONSUL_HTTP_SSL
+type ConsulClient struct {
+	client  *http.Client
+	address string
+	token   string
+}
+
+func NewConsulClient(client *http.Client) *ConsulClient {
+	address := os.Getenv("CONSUL_HTTP_ADDR")
+	if address == "" {
+		address = "127.0.0.1:8500"
+	}
+	if !strings.Contains(address, "://") {
+		scheme := "http"
+		if os.Getenv("CONSUL_HTTP_SSL") == "true" {
+			scheme = "https"
+		}
+		address = fmt.Sprintf("%s://%s", scheme, address)
+	}
+	return &ConsulClient{
+		client:  client,
+		address: strings.TrimSuffix(address, "/"),
+		token:   os.Getenv("CONSUL_HTTP_TOKEN"),
+	}
+}
+
+func (c *ConsulClient) get(ctx context.Context, key, query string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/kv/%s?%s", c.address, strings.TrimPrefix(key, "/"), query), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, errors.Errorf("key %s not found in consul", key)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to read consul key %s: %s", key, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
+
+// Get returns the value of a key
+func (c *ConsulClient) Get(ctx context.Context, key string) ([]byte, error) {
+	return c.get(ctx, key, "raw")
+}
+
+// Keys returns the keys starting with prefix
+func (c *ConsulClient) Keys(ctx context.Context, prefix string) ([]string, error) {
+	payload, err := c.get(ctx, prefix, url.Values{"keys": []string{""}}.Encode())
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	if err := json.Unmarshal(payload, &keys); err != nil {
+		return nil, errors.Wrap(err, "unable to parse consul keys")
+	}
+	return keys, nil
+}
+
+// consulChunks is stored by terraform in place of states too large for a single Consul key
+type consulChunks struct {
+	Hash   string   `json:"hash"`
+	Chunks []string `json:"chunks"`
+}
+
+type ConsulBackend struct {
+	ctx    context.Context
+	client *ConsulClient
+	key    string
+	reader io.ReadCloser
+}
+
+func NewConsulReader(ctx context.Context, path string) (*ConsulBackend, error) {
+	if path == "" {
+		return nil, errors.New("Unable to parse consul backend path, it must be the key of the state")
+	}
+	return &ConsulBackend{ctx: ctx, client: NewConsulClient(&http.Client{}), key: path}, nil
+}
+
+func (c *ConsulBackend) Read(p []byte) (int, error) {
+	if c.reader == nil {
+		payload, err := c.client.Get(c.ctx, c.key)
+		if err != nil {
+			return 0, err
+		}
+
+		chunks := consulChunks{}
+		if json.Unmarshal(payload, &chunks) == nil && len(chunks.Chunks) > 0 {
+			var buf bytes.Buffer
+			for _, chunk := range chunks.Chunks {
+				data, err := c.client.Get(c.ctx, chunk)
+				if err != nil {
+					return 0, err
+				}
+				buf.Write(data)
+			}
+			payload = buf.Bytes()
+		}
+
+		payload, err = gunzipState(payload)
+		if err != nil {
+			return 0, err
+		}
+		c.reader = io.NopCloser(bytes.NewReader(payload))
+	}
+	return c.reader.Read(p)
+}
+
+func (c *ConsulBackend) Close() error {
+	if c.reader != nil {
+		return c.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
+
+// gunzipState decompresses states that terraform backends stored gzipped, other states are
+// returned as is
+func gunzipState(payload []byte) ([]byte, error) {
+	if len(payload) < 2 || payload[0] != 0x1f || payload[1] != 0x8b {
+		return payload, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decompress state")
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
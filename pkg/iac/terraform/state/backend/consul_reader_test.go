@@ -0,0 +1,100 @@
+package backend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gzipped(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+	return buf.Bytes()
+}
+
+func TestConsulBackend_Read(t *testing.T) {
+	compressed := gzipped(t, `{"version": 4}`)
+
+	tests := []struct {
+		name     string
+		key      string
+		kv       map[string][]byte
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "plain state",
+			key:      "terraform/network",
+			kv:       map[string][]byte{"terraform/network": []byte(`{"version": 4}`)},
+			expected: `{"version": 4}`,
+		},
+		{
+			name:     "gzipped state",
+			key:      "terraform/network",
+			kv:       map[string][]byte{"terraform/network": compressed},
+			expected: `{"version": 4}`,
+		},
+		{
+			name: "chunked gzipped state",
+			key:  "terraform/network",
+			kv: map[string][]byte{
+				"terraform/network":               []byte(`{"hash": "abc", "chunks": ["terraform/network/tfstate.abc/0", "terraform/network/tfstate.abc/1"]}`),
+				"terraform/network/tfstate.abc/0": compressed[:10],
+				"terraform/network/tfstate.abc/1": compressed[10:],
+			},
+			expected: `{"version": 4}`,
+		},
+		{
+			name:    "missing key",
+			key:     "terraform/missing",
+			kv:      map[string][]byte{},
+			wantErr: "key terraform/missing not found in consul",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "secret", r.Header.Get("X-Consul-Token"))
+				value, exist := tt.kv[r.URL.Path[len("/v1/kv/"):]]
+				if !exist {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write(value)
+			}))
+			defer server.Close()
+			t.Setenv("CONSUL_HTTP_ADDR", server.URL)
+			t.Setenv("CONSUL_HTTP_TOKEN", "secret")
+
+			reader, err := NewConsulReader(context.Background(), tt.key)
+			assert.NoError(t, err)
+			got, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+			assert.NoError(t, reader.Close())
+		})
+	}
+}
+
+func TestNewConsulClient_Address(t *testing.T) {
+	t.Setenv("CONSUL_HTTP_ADDR", "")
+	assert.Equal(t, "http://127.0.0.1:8500", NewConsulClient(http.DefaultClient).address)
+
+	t.Setenv("CONSUL_HTTP_ADDR", "consul.example.com:8501")
+	t.Setenv("CONSUL_HTTP_SSL", "true")
+	assert.Equal(t, "https://consul.example.com:8501", NewConsulClient(http.DefaultClient).address)
+}
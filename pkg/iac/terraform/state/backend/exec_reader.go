@@ -0,0 +1,107 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const BackendKeyExec = "exec"
+
+// execBinaries are looked up on PATH in this order when no binary is configured
+var execBinaries = []string{"terraform", "tofu"}
+
+// ExecBackend reads the state of a root module by running state pull, so every backend
+// Terraform or OpenTofu supports can be read with the credentials they already use.
+// The command is killed when the scan context is done.
+type ExecBackend struct {
+	ctx    context.Context
+	dir    string
+	binary string
+	cmd    *exec.Cmd
+	stdout io.ReadCloser
+	stderr bytes.Buffer
+	done   bool
+}
+
+func NewExecReader(ctx context.Context, dir string, opts *Options) (*ExecBackend, error) {
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, errors.Errorf("Unable to read state from %s, it must be the directory of a Terraform root module", dir)
+	}
+
+	binary := opts.TerraformBinary
+	if binary == "" {
+		for _, name := range execBinaries {
+			if path, err := exec.LookPath(name); err == nil {
+				binary = path
+				break
+			}
+		}
+	}
+	if binary == "" {
+		return nil, errors.Errorf("Unable to find %s on PATH, set --tf-binary to the binary to pull states with", strings.Join(execBinaries, " or "))
+	}
+
+	return &ExecBackend{ctx: ctx, dir: dir, binary: binary}, nil
+}
+
+func (e *ExecBackend) start() error {
+	e.cmd = exec.CommandContext(e.ctx, e.binary, "state", "pull")
+	e.cmd.Dir = e.dir
+	e.cmd.Env = append(os.Environ(), "TF_IN_AUTOMATION=1", "TF_INPUT=0")
+	e.cmd.Stderr = &e.stderr
+
+	stdout, err := e.cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	e.stdout = stdout
+
+	logrus.WithFields(logrus.Fields{
+		"binary": e.binary,
+		"dir":    e.dir,
+	}).Debug("Pulling state")
+	return e.cmd.Start()
+}
+
+func (e *ExecBackend) Read(p []byte) (int, error) {
+	if e.cmd == nil {
+		if err := e.start(); err != nil {
+			return 0, errors.Wrapf(err, "unable to run %s", e.binary)
+		}
+	}
+
+	n, err := e.stdout.Read(p)
+	if err == io.EOF && !e.done {
+		e.done = true
+		if waitErr := e.cmd.Wait(); waitErr != nil {
+			return n, errors.Errorf("%s state pull failed in %s: %s", e.binary, e.dir, e.failure(waitErr))
+		}
+	}
+	return n, err
+}
+
+func (e *ExecBackend) failure(err error) string {
+	if stderr := strings.TrimSpace(e.stderr.String()); stderr != "" {
+		return stderr
+	}
+	return err.Error()
+}
+
+func (e *ExecBackend) Close() error {
+	if e.cmd == nil {
+		return errors.New("Unable to close reader as nothing was opened")
+	}
+	if !e.done {
+		e.done = true
+		_ = e.cmd.Process.Kill()
+		_ = e.cmd.Wait()
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package backend
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeBinary writes a shell script standing for terraform in a directory put on PATH
+func fakeBinary(t *testing.T, name, script string) string {
+	dir := t.TempDir()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+	return path
+}
+
+func TestExecBackend_Read(t *testing.T) {
+	tests := []struct {
+		name     string
+		binary   string
+		script   string
+		opts     *Options
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "pull state with terraform",
+			binary:   "terraform",
+			script:   `[ "$1 $2" = "state pull" ] && [ "$TF_IN_AUTOMATION" = "1" ] && echo "{\"version\": 4, \"dir\": \"${PWD##*/}\"}"`,
+			opts:     &Options{},
+			expected: "{\"version\": 4, \"dir\": \"network\"}\n",
+		},
+		{
+			name:     "pull state with tofu",
+			binary:   "tofu",
+			script:   `echo '{"version": 4}'`,
+			opts:     &Options{},
+			expected: "{\"version\": 4}\n",
+		},
+		{
+			name:    "state pull failure",
+			binary:  "terraform",
+			script:  "echo 'Error: Backend initialization required' >&2; exit 1",
+			opts:    &Options{},
+			wantErr: "state pull failed in testdata/network: Error: Backend initialization required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binary := fakeBinary(t, tt.binary, tt.script)
+
+			reader, err := NewExecReader(context.Background(), "testdata/network", tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, binary, reader.binary)
+
+			got, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, binary+" "+tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+			assert.NoError(t, reader.Close())
+		})
+	}
+}
+
+func TestExecBackend_Read_Canceled(t *testing.T) {
+	fakeBinary(t, "terraform", "exec /bin/sleep 60")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	reader, err := NewExecReader(ctx, "testdata/network", &Options{})
+	assert.NoError(t, err)
+
+	start := time.Now()
+	_, err = io.ReadAll(reader)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 30*time.Second)
+	assert.NoError(t, reader.Close())
+}
+
+func TestNewExecReader(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := NewExecReader(context.Background(), "testdata/network", &Options{})
+	assert.EqualError(t, err, "Unable to find terraform or tofu on PATH, set --tf-binary to the binary to pull states with")
+
+	reader, err := NewExecReader(context.Background(), "testdata/network", &Options{TerraformBinary: "/opt/tofu/bin/tofu"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/opt/tofu/bin/tofu", reader.binary)
+
+	_, err = NewExecReader(context.Background(), "testdata/missing", &Options{})
+	assert.EqualError(t, err, "Unable to read state from testdata/missing, it must be the directory of a Terraform root module")
+}
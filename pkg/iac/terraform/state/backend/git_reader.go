@@ -0,0 +1,131 @@
+package backend
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/pkg/errors"
+)
+
+const BackendKeyGit = "git"
+
+// GitLocation is a state file committed in a local git repository, written REPOSITORY//PATH@REF
+type GitLocation struct {
+	Repository string
+	Path       string
+	// Ref is a branch, tag or commit, HEAD when empty
+	Ref string
+}
+
+func ParseGitPath(path string) (*GitLocation, error) {
+	i := strings.LastIndex(path, "//")
+	if i <= 0 || i+2 == len(path) {
+		return nil, errors.Errorf("Unable to parse git path: %s. Must be REPOSITORY//PATH[@REF]", path)
+	}
+	location := &GitLocation{Repository: path[:i], Path: path[i+2:]}
+	if at := strings.LastIndex(location.Path, "@"); at != -1 {
+		location.Path, location.Ref = location.Path[:at], location.Path[at+1:]
+	}
+	if location.Path == "" {
+		return nil, errors.Errorf("Unable to parse git path: %s. Must be REPOSITORY//PATH[@REF]", path)
+	}
+	return location, nil
+}
+
+func (l GitLocation) String() string {
+	if l.Ref == "" {
+		return fmt.Sprintf("%s//%s", l.Repository, l.Path)
+	}
+	return fmt.Sprintf("%s//%s@%s", l.Repository, l.Path, l.Ref)
+}
+
+// Commit resolves the ref of the location in its repository
+func (l GitLocation) Commit() (*git.Repository, *object.Commit, error) {
+	repository, err := git.PlainOpenWithOptions(l.Repository, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to open git repository %s", l.Repository)
+	}
+	ref := l.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	hash, err := repository.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to resolve %s in %s", ref, l.Repository)
+	}
+	commit, err := repository.CommitObject(*hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return repository, commit, nil
+}
+
+// Log returns up to max commits that changed the state file, from the location ref backwards
+func (l GitLocation) Log(max int) ([]*object.Commit, error) {
+	repository, commit, err := l.Commit()
+	if err != nil {
+		return nil, err
+	}
+	path := l.Path
+	iter, err := repository.Log(&git.LogOptions{From: commit.Hash, FileName: &path})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	commits := make([]*object.Commit, 0)
+	err = iter.ForEach(func(c *object.Commit) error {
+		if max > 0 && len(commits) == max {
+			return io.EOF
+		}
+		commits = append(commits, c)
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return commits, nil
+}
+
+type GitBackend struct {
+	location *GitLocation
+	reader   io.ReadCloser
+}
+
+func NewGitReader(path string) (*GitBackend, error) {
+	location, err := ParseGitPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return &GitBackend{location: location}, nil
+}
+
+func (g *GitBackend) Read(p []byte) (int, error) {
+	if g.reader == nil {
+		_, commit, err := g.location.Commit()
+		if err != nil {
+			return 0, err
+		}
+		file, err := commit.File(g.location.Path)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to read %s at %s", g.location.Path, commit.Hash)
+		}
+		reader, err := file.Reader()
+		if err != nil {
+			return 0, err
+		}
+		g.reader = reader
+	}
+	return g.reader.Read(p)
+}
+
+func (g *GitBackend) Close() error {
+	if g.reader != nil {
+		return g.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
@@ -0,0 +1,148 @@
+package backend
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+// commitStates creates a repository where each content is committed in turn as states/terraform.tfstate
+func commitStates(t *testing.T, contents ...string) (string, []plumbing.Hash) {
+	dir := t.TempDir()
+	repository, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	worktree, err := repository.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "states"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	hashes := make([]plumbing.Hash, 0, len(contents))
+	for i, content := range contents {
+		if err := os.WriteFile(filepath.Join(dir, "states", "terraform.tfstate"), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := worktree.Add("states/terraform.tfstate"); err != nil {
+			t.Fatal(err)
+		}
+		hash, err := worktree.Commit("update state", &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Unix(int64(i), 0)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, hash)
+	}
+	return dir, hashes
+}
+
+func TestParseGitPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected *GitLocation
+		wantErr  string
+	}{
+		{
+			path:     "/home/infra//states/terraform.tfstate",
+			expected: &GitLocation{Repository: "/home/infra", Path: "states/terraform.tfstate"},
+		},
+		{
+			path:     ".//terraform.tfstate@v1.2.0",
+			expected: &GitLocation{Repository: ".", Path: "terraform.tfstate", Ref: "v1.2.0"},
+		},
+		{
+			path:    "/home/infra/terraform.tfstate",
+			wantErr: "Unable to parse git path: /home/infra/terraform.tfstate. Must be REPOSITORY//PATH[@REF]",
+		},
+		{
+			path:    ".//@main",
+			wantErr: "Unable to parse git path: .//@main. Must be REPOSITORY//PATH[@REF]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := ParseGitPath(tt.path)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, tt.path, got.String())
+		})
+	}
+}
+
+func TestGitBackend_Read(t *testing.T) {
+	dir, hashes := commitStates(t, `{"serial": 1}`, `{"serial": 2}`)
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "read state at HEAD",
+			path:     dir + "//states/terraform.tfstate",
+			expected: `{"serial": 2}`,
+		},
+		{
+			name:     "read state at a commit",
+			path:     dir + "//states/terraform.tfstate@" + hashes[0].String(),
+			expected: `{"serial": 1}`,
+		},
+		{
+			name:    "missing file",
+			path:    dir + "//terraform.tfstate",
+			wantErr: "unable to read terraform.tfstate at " + hashes[1].String() + ": file not found",
+		},
+		{
+			name:    "unknown ref",
+			path:    dir + "//states/terraform.tfstate@unknown",
+			wantErr: "unable to resolve unknown in " + dir + ": reference not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := NewGitReader(tt.path)
+			assert.NoError(t, err)
+
+			got, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+			assert.NoError(t, reader.Close())
+		})
+	}
+}
+
+func TestGitLocation_Log(t *testing.T) {
+	dir, hashes := commitStates(t, `{"serial": 1}`, `{"serial": 2}`, `{"serial": 3}`)
+	location := GitLocation{Repository: dir, Path: "states/terraform.tfstate"}
+
+	commits, err := location.Log(2)
+	assert.NoError(t, err)
+	assert.Len(t, commits, 2)
+	assert.Equal(t, hashes[2], commits[0].Hash)
+	assert.Equal(t, hashes[1], commits[1].Hash)
+
+	location.Ref = hashes[1].String()
+	commits, err = location.Log(0)
+	assert.NoError(t, err)
+	assert.Len(t, commits, 2)
+	assert.Equal(t, hashes[0], commits[1].Hash)
+}
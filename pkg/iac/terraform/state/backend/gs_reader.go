@@ -12,13 +12,14 @@ import (
 const BackendKeyGS = "gs"
 
 type GSBackend struct {
+	ctx           context.Context
 	bucketName    string
 	path          string
 	reader        io.ReadCloser
 	storageClient *storage.Client
 }
 
-func NewGSReader(path string) (*GSBackend, error) {
+func NewGSReader(ctx context.Context, path string) (*GSBackend, error) {
 	bucketPath := strings.Split(path, "/")
 	if len(bucketPath) < 2 {
 		return nil, errors.Errorf("Unable to parse Google Storage path: %s. Must be BUCKET_NAME/PATH/TO/OBJECT", path)
@@ -27,6 +28,7 @@ func NewGSReader(path string) (*GSBackend, error) {
 	key := strings.Join(bucketPath[1:], "/")
 
 	return &GSBackend{
+		ctx:        ctx,
 		bucketName: bucketName,
 		path:       key,
 	}, nil
@@ -35,15 +37,14 @@ func NewGSReader(path string) (*GSBackend, error) {
 func (s *GSBackend) Read(p []byte) (int, error) {
 	if s.reader == nil {
 		if s.storageClient == nil {
-			client, err := storage.NewClient(context.Background())
+			client, err := storage.NewClient(s.ctx)
 			if err != nil {
 				return 0, err
 			}
 			s.storageClient = client
 		}
 
-		ctx := context.Background()
-		rc, err := s.storageClient.Bucket(s.bucketName).Object(s.path).NewReader(ctx)
+		rc, err := s.storageClient.Bucket(s.bucketName).Object(s.path).NewReader(s.ctx)
 		if err != nil {
 			return 0, err
 		}
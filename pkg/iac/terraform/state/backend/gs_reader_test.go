@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -29,6 +30,7 @@ func TestGSBackend_NewGSReader(t *testing.T) {
 				path: "bucket-1/path/to/terraform.tfstate",
 			},
 			want: &GSBackend{
+				ctx:        context.Background(),
 				bucketName: "bucket-1",
 				path:       "path/to/terraform.tfstate",
 			},
@@ -44,7 +46,7 @@ func TestGSBackend_NewGSReader(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewGSReader(tt.args.path)
+			got, err := NewGSReader(context.Background(), tt.args.path)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 				return
@@ -108,6 +110,7 @@ func TestGSBackend_Read(t *testing.T) {
 			defer server.Close()
 
 			reader := &GSBackend{
+				ctx:           context.Background(),
 				bucketName:    tt.args.bucketName,
 				path:          tt.args.path,
 				storageClient: client,
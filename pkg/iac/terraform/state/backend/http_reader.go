@@ -1,6 +1,8 @@
 package backend
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	pkghttp "github.com/snyk/driftctl/pkg/http"
@@ -18,8 +20,8 @@ type HTTPBackend struct {
 	reader  io.ReadCloser
 }
 
-func NewHTTPReader(client pkghttp.HTTPClient, rawURL string, opts *Options) (*HTTPBackend, error) {
-	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+func NewHTTPReader(ctx context.Context, client pkghttp.HTTPClient, rawURL string, opts *Options) (*HTTPBackend, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
 	if err != nil {
 		return nil, err
 	}
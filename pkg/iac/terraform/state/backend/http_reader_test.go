@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -96,7 +97,7 @@ func TestHTTPBackend_Read(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			reader, err := NewHTTPReader(tt.httpClient, tt.args.url, tt.args.options)
+			reader, err := NewHTTPReader(context.Background(), tt.httpClient, tt.args.url, tt.args.options)
 			assert.NoError(t, err)
 
 			got := make([]byte, len(tt.expected))
@@ -113,6 +114,17 @@ func TestHTTPBackend_Read(t *testing.T) {
 	}
 }
 
+func TestHTTPBackend_Read_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reader, err := NewHTTPReader(ctx, &http.Client{}, "https://example.com/terraform.tfstate", &Options{})
+	assert.NoError(t, err)
+
+	_, err = reader.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestHTTPBackend_Close(t *testing.T) {
 	type fields struct {
 		req    *http.Request
@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const BackendKeyKubernetes = "kubernetes"
+
+// KubernetesStateLabelSelector selects the secrets the terraform kubernetes backend stores states in
+const KubernetesStateLabelSelector = "tfstate=true"
+
+// NewKubernetesClient is configured like the kubernetes remote, through KUBE_CONFIG_PATH or
+// KUBECONFIG and KUBE_CTX
+func NewKubernetesClient() (kubernetes.Interface, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = os.Getenv("KUBE_CONFIG_PATH")
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: os.Getenv("KUBE_CTX")}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load a kubeconfig")
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+type KubernetesBackend struct {
+	ctx       context.Context
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	reader    io.ReadCloser
+}
+
+// NewKubernetesReader reads a state secret, path is NAMESPACE/SECRET_NAME where terraform names
+// secrets tfstate-{workspace}-{secret_suffix}
+func NewKubernetesReader(ctx context.Context, path string) (*KubernetesBackend, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("Unable to parse kubernetes backend path: %s. Must be NAMESPACE/SECRET_NAME", path)
+	}
+	return &KubernetesBackend{ctx: ctx, namespace: parts[0], name: parts[1]}, nil
+}
+
+func (k *KubernetesBackend) Read(p []byte) (int, error) {
+	if k.reader == nil {
+		if k.client == nil {
+			client, err := NewKubernetesClient()
+			if err != nil {
+				return 0, err
+			}
+			k.client = client
+		}
+
+		secret, err := k.client.CoreV1().Secrets(k.namespace).Get(k.ctx, k.name, metav1.GetOptions{})
+		if err != nil {
+			return 0, errors.Wrap(err, "unable to read state secret")
+		}
+		data, exist := secret.Data["tfstate"]
+		if !exist {
+			return 0, errors.Errorf("secret %s/%s has no tfstate key", k.namespace, k.name)
+		}
+		data, err = gunzipState(data)
+		if err != nil {
+			return 0, err
+		}
+		k.reader = io.NopCloser(bytes.NewReader(data))
+	}
+	return k.reader.Read(p)
+}
+
+func (k *KubernetesBackend) Close() error {
+	if k.reader != nil {
+		return k.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
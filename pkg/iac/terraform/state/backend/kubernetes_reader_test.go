@@ -0,0 +1,68 @@
+package backend
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestKubernetesBackend_Read(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tfstate-default-network", Namespace: "terraform"},
+			Data:       map[string][]byte{"tfstate": gzipped(t, `{"version": 4}`)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "terraform"},
+			Data:       map[string][]byte{"token": []byte("value")},
+		},
+	)
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "gzipped state",
+			path:     "terraform/tfstate-default-network",
+			expected: `{"version": 4}`,
+		},
+		{
+			name:    "missing secret",
+			path:    "terraform/tfstate-prod-network",
+			wantErr: `unable to read state secret: secrets "tfstate-prod-network" not found`,
+		},
+		{
+			name:    "secret without state",
+			path:    "terraform/other",
+			wantErr: "secret terraform/other has no tfstate key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := NewKubernetesReader(context.Background(), tt.path)
+			assert.NoError(t, err)
+			reader.client = client
+
+			got, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+		})
+	}
+}
+
+func TestNewKubernetesReader_InvalidPath(t *testing.T) {
+	_, err := NewKubernetesReader(context.Background(), "tfstate-default-network")
+	assert.EqualError(t, err, "Unable to parse kubernetes backend path: tfstate-default-network. Must be NAMESPACE/SECRET_NAME")
+}
@@ -0,0 +1,85 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const BackendKeyPostgres = "pg"
+
+// PostgresDefaultSchema is the schema the terraform pg backend uses when schema_name is not set
+const PostgresDefaultSchema = "terraform_remote_state"
+
+// OpenPostgres connects to the database of the terraform pg backend, the connection string is read
+// from PG_CONN_STR like terraform does
+func OpenPostgres() (*sql.DB, error) {
+	connStr := os.Getenv("PG_CONN_STR")
+	if connStr == "" {
+		return nil, errors.New("PG_CONN_STR must be set to read states from the pg backend")
+	}
+	return sql.Open("postgres", connStr)
+}
+
+// PostgresStatesTable returns the quoted name of the table holding the states of a schema
+func PostgresStatesTable(schema string) string {
+	return fmt.Sprintf("%s.states", pq.QuoteIdentifier(schema))
+}
+
+type PostgresBackend struct {
+	ctx       context.Context
+	db        *sql.DB
+	schema    string
+	workspace string
+	reader    io.ReadCloser
+}
+
+// NewPGReader reads the state of a workspace, path is SCHEMA/WORKSPACE
+func NewPGReader(ctx context.Context, path string) (*PostgresBackend, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("Unable to parse pg backend path: %s. Must be SCHEMA/WORKSPACE", path)
+	}
+	return &PostgresBackend{ctx: ctx, schema: parts[0], workspace: parts[1]}, nil
+}
+
+func (p *PostgresBackend) Read(b []byte) (int, error) {
+	if p.reader == nil {
+		if p.db == nil {
+			db, err := OpenPostgres()
+			if err != nil {
+				return 0, err
+			}
+			p.db = db
+		}
+
+		var data string
+		query := fmt.Sprintf("SELECT data FROM %s WHERE name = $1", PostgresStatesTable(p.schema))
+		err := p.db.QueryRowContext(p.ctx, query, p.workspace).Scan(&data)
+		if err == sql.ErrNoRows {
+			return 0, errors.Errorf("workspace %s not found in schema %s", p.workspace, p.schema)
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "unable to read state from postgres")
+		}
+		p.reader = io.NopCloser(bytes.NewReader([]byte(data)))
+	}
+	return p.reader.Read(b)
+}
+
+func (p *PostgresBackend) Close() error {
+	if p.db != nil {
+		p.db.Close()
+	}
+	if p.reader != nil {
+		return p.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
@@ -0,0 +1,49 @@
+package backend
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPGReader_InvalidPath(t *testing.T) {
+	_, err := NewPGReader(context.Background(), "terraform_remote_state")
+	assert.EqualError(t, err, "Unable to parse pg backend path: terraform_remote_state. Must be SCHEMA/WORKSPACE")
+}
+
+func TestPostgresBackend_Read(t *testing.T) {
+	db, dbMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbMock.ExpectQuery(`SELECT data FROM "terraform_remote_state".states WHERE name = \$1`).
+		WithArgs("prod").
+		WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(`{"version": 4}`))
+	dbMock.ExpectQuery(`SELECT data FROM "terraform_remote_state".states WHERE name = \$1`).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"data"}))
+
+	reader, err := NewPGReader(context.Background(), "terraform_remote_state/prod")
+	assert.NoError(t, err)
+	reader.db = db
+	got, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"version": 4}`, string(got))
+
+	reader, err = NewPGReader(context.Background(), "terraform_remote_state/missing")
+	assert.NoError(t, err)
+	reader.db = db
+	_, err = io.ReadAll(reader)
+	assert.EqualError(t, err, "workspace missing not found in schema terraform_remote_state")
+
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestOpenPostgres_MissingConnStr(t *testing.T) {
+	t.Setenv("PG_CONN_STR", "")
+	_, err := OpenPostgres()
+	assert.EqualError(t, err, "PG_CONN_STR must be set to read states from the pg backend")
+}
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"io"
 	"strings"
 
@@ -15,14 +16,15 @@ import (
 const BackendKeyS3 = "s3"
 
 type S3Backend struct {
+	ctx      context.Context
 	input    s3.GetObjectInput
 	reader   io.ReadCloser
 	S3Client s3iface.S3API
 }
 
-func NewS3Reader(path string) (*S3Backend, error) {
+func NewS3Reader(ctx context.Context, path string) (*S3Backend, error) {
 
-	backend := S3Backend{}
+	backend := S3Backend{ctx: ctx}
 	bucketPath := strings.Split(path, "/")
 	if len(bucketPath) < 2 {
 		return nil, errors.Errorf("Unable to parse S3 path: %s. Must be BUCKET_NAME/PATH/TO/OBJECT", path)
@@ -46,7 +48,7 @@ func NewS3Reader(path string) (*S3Backend, error) {
 
 func (s *S3Backend) Read(p []byte) (n int, err error) {
 	if s.reader == nil {
-		response, err := s.S3Client.GetObject(&s.input)
+		response, err := s.S3Client.GetObjectWithContext(s.ctx, &s.input)
 		if err != nil {
 			requestFailure, ok := err.(s3.RequestFailure)
 			if ok {
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -38,13 +39,13 @@ func TestNewS3ReaderInvalid(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewS3Reader(tt.args.path)
+			got, err := NewS3Reader(context.Background(), tt.args.path)
 			if err.Error() != tt.wantErr.Error() {
-				t.Errorf("NewS3Reader() error = '%s', wantErr '%s'", err, tt.wantErr)
+				t.Errorf("NewS3Reader(context.Background(), ) error = '%s', wantErr '%s'", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("NewS3Reader() got = %v, want %v", got, tt.want)
+				t.Errorf("NewS3Reader(context.Background(), ) got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -52,7 +53,7 @@ func TestNewS3ReaderInvalid(t *testing.T) {
 
 func TestNewS3Reader(t *testing.T) {
 	assert := assert.New(t)
-	reader, err := NewS3Reader("sample_bucket/path/to/state.tfstate")
+	reader, err := NewS3Reader(context.Background(), "sample_bucket/path/to/state.tfstate")
 	if err != nil {
 		t.Error(err)
 	}
@@ -71,11 +72,11 @@ func TestNewS3ReaderWithEnvProxy(t *testing.T) {
 	assert := assert.New(t)
 	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
 	os.Setenv("DCTL_S3_DEFAULT_REGION", "eu-west-3")
-	reader, err := NewS3Reader("sample_bucket/path/to/state.tfstate")
+	reader, err := NewS3Reader(context.Background(), "sample_bucket/path/to/state.tfstate")
 
 	got := reader.S3Client.(*s3.S3).Config.Region
 	if aws.StringValue(got) != "eu-west-3" {
-		t.Errorf("NewS3Reader().S3Client.Config.Region got = %v, want %v", aws.StringValue(got), "eu-west-3")
+		t.Errorf("NewS3Reader(context.Background(), ).S3Client.Config.Region got = %v, want %v", aws.StringValue(got), "eu-west-3")
 	}
 
 	if err != nil {
@@ -97,9 +98,9 @@ func TestS3Backend_ReadWithError(t *testing.T) {
 	fakeS3 := &awstest.MockFakeS3{}
 	fakeErr := &awstest.MockFakeRequestFailure{}
 	fakeErr.On("Message").Return("Request failed on aws side")
-	fakeS3.On("GetObject", mock.Anything).Return(nil, fakeErr)
+	fakeS3.On("GetObjectWithContext", mock.Anything, mock.Anything).Return(nil, fakeErr)
 
-	reader, err := NewS3Reader("foobar/path/to/state")
+	reader, err := NewS3Reader(context.Background(), "foobar/path/to/state")
 	if err != nil {
 		t.Error(err)
 	}
@@ -115,12 +116,12 @@ func TestS3Backend_Read(t *testing.T) {
 	fakeS3 := &awstest.MockFakeS3{}
 	fakeResponse, _ := os.Open("testdata/valid.tfstate")
 	defer fakeResponse.Close()
-	fakeS3.On("GetObject", &s3.GetObjectInput{
+	fakeS3.On("GetObjectWithContext", context.Background(), &s3.GetObjectInput{
 		Bucket: aws.String("foobar"),
 		Key:    aws.String("path/to/state"),
 	}).Return(&s3.GetObjectOutput{Body: fakeResponse}, nil).Once()
 
-	reader, err := NewS3Reader("foobar/path/to/state")
+	reader, err := NewS3Reader(context.Background(), "foobar/path/to/state")
 	if err != nil {
 		t.Error(err)
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"net/http"
 	"net/url"
 	"os"
 	"regexp"
@@ -28,18 +29,19 @@ type TFCloudBody struct {
 }
 
 type TFCloudBackend struct {
+	ctx           context.Context
 	client        *tfe.Client
 	reader        io.ReadCloser
 	opts          *Options
 	workspacePath string
 }
 
-func NewTFCloudReader(workspacePath string, opts *Options) *TFCloudBackend {
-	return &TFCloudBackend{opts: opts, workspacePath: workspacePath}
+func NewTFCloudReader(ctx context.Context, workspacePath string, opts *Options) *TFCloudBackend {
+	return &TFCloudBackend{ctx: ctx, opts: opts, workspacePath: workspacePath}
 }
 
-func (t *TFCloudBackend) getToken() (string, error) {
-	token := t.opts.TFCloudToken
+func getTFCloudToken(opts *Options) (string, error) {
+	token := opts.TFCloudToken
 	if token == "" {
 		tfConfigFile, err := getTerraformConfigFile()
 		if err != nil {
@@ -53,7 +55,7 @@ func (t *TFCloudBackend) getToken() (string, error) {
 		defer file.Close()
 		reader := NewTFCloudConfigReader(file)
 
-		u, err := url.Parse(t.opts.TFCloudEndpoint)
+		u, err := url.Parse(opts.TFCloudEndpoint)
 		if err != nil {
 			return "", err
 		}
@@ -78,23 +80,30 @@ func (t *TFCloudBackend) getWorkspaceId() (string, error) {
 	if len(workspacePath) != 2 {
 		return "", errors.New("unable to parse terraform cloud workspace, it should be either a workspace id (ws-xxxxx) or a {org}/{workspaceName}")
 	}
-	workspace, err := t.client.Workspaces.Read(context.Background(), workspacePath[0], workspacePath[1])
+	workspace, err := t.client.Workspaces.Read(t.ctx, workspacePath[0], workspacePath[1])
 	if err != nil {
 		return "", errors.Errorf("unable to read terraform workspace id: %s", err.Error())
 	}
 	return workspace.ID, nil
 }
 
-func (t *TFCloudBackend) initTFEClient() error {
-	token, err := t.getToken()
+// NewTFCloudClient returns a Terraform Cloud / Enterprise API client, the token is read from the
+// terraform CLI configuration when not given in options
+func NewTFCloudClient(opts *Options, httpClient *http.Client) (*tfe.Client, error) {
+	token, err := getTFCloudToken(opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	config := &tfe.Config{
-		Token:   token,
-		Address: t.opts.TFCloudEndpoint,
+		Token:      token,
+		Address:    opts.TFCloudEndpoint,
+		HTTPClient: httpClient,
 	}
-	tfcClient, err := tfe.NewClient(config)
+	return tfe.NewClient(config)
+}
+
+func (t *TFCloudBackend) initTFEClient() error {
+	tfcClient, err := NewTFCloudClient(t.opts, nil)
 	if err != nil {
 		return err
 	}
@@ -115,12 +124,12 @@ func (t *TFCloudBackend) Read(p []byte) (n int, err error) {
 			return 0, err
 		}
 
-		stateVersion, err := t.client.StateVersions.Current(context.Background(), workspaceId)
+		stateVersion, err := t.client.StateVersions.Current(t.ctx, workspaceId)
 		if err != nil {
 			return 0, errors.Errorf("unable to read current state version: %s", err.Error())
 		}
 
-		state, err := t.client.StateVersions.Download(context.Background(), stateVersion.DownloadURL)
+		state, err := t.client.StateVersions.Download(t.ctx, stateVersion.DownloadURL)
 		if err != nil {
 			return 0, errors.Errorf("unable to download current state content: %s", err.Error())
 		}
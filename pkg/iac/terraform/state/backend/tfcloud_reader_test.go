@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/test/mocks"
@@ -121,7 +122,7 @@ func TestTFCloudBackend_Read(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			reader := NewTFCloudReader(tt.args.workspaceId, tt.args.options)
+			reader := NewTFCloudReader(context.Background(), tt.args.workspaceId, tt.args.options)
 
 			fakeWorkspaces := &mocks.Workspaces{}
 			fakeStateVersions := &mocks.StateVersions{}
@@ -0,0 +1,101 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// ResourceOwner is a state claiming a resource and the address of the resource in it
+type ResourceOwner struct {
+	State   string
+	Address string
+}
+
+// DuplicateOwnershipAlert reports a resource claimed by several states, applying any of them fights over the resource
+type DuplicateOwnershipAlert struct {
+	resource *resource.Resource
+	owners   []ResourceOwner
+}
+
+func NewDuplicateOwnershipAlert(res *resource.Resource, owners []ResourceOwner) *DuplicateOwnershipAlert {
+	return &DuplicateOwnershipAlert{resource: res, owners: owners}
+}
+
+func (d *DuplicateOwnershipAlert) Message() string {
+	owners := make([]string, 0, len(d.owners))
+	for _, owner := range d.owners {
+		owners = append(owners, fmt.Sprintf("%s in %s", owner.Address, owner.State))
+	}
+	return fmt.Sprintf(
+		"Resource %s (%s) is managed by %d different Terraform states: %s",
+		d.resource.ResourceId(),
+		d.resource.ResourceType(),
+		countStates(d.owners),
+		strings.Join(owners, ", "),
+	)
+}
+
+func (d *DuplicateOwnershipAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
+func (d *DuplicateOwnershipAlert) Resource() *resource.Resource {
+	return d.resource
+}
+
+func (d *DuplicateOwnershipAlert) Owners() []ResourceOwner {
+	return d.owners
+}
+
+// FindDuplicateOwnership returns an alert for every resource type and ID claimed by more than one Terraform state,
+// sorted by type and ID. Owners are sorted by state then address.
+func FindDuplicateOwnership(resources []*resource.Resource) []*DuplicateOwnershipAlert {
+	type key struct {
+		ty string
+		id string
+	}
+	claims := make(map[key][]*resource.Resource)
+	for _, res := range resources {
+		if _, ok := res.Source.(*resource.TerraformStateSource); !ok {
+			continue
+		}
+		k := key{res.ResourceType(), res.ResourceId()}
+		claims[k] = append(claims[k], res)
+	}
+
+	alerts := make([]*DuplicateOwnershipAlert, 0)
+	for _, claimants := range claims {
+		owners := make([]ResourceOwner, 0, len(claimants))
+		for _, res := range claimants {
+			owners = append(owners, ResourceOwner{State: res.Source.Source(), Address: res.SourceString()})
+		}
+		if countStates(owners) < 2 {
+			continue
+		}
+		sort.Slice(owners, func(i, j int) bool {
+			if owners[i].State != owners[j].State {
+				return owners[i].State < owners[j].State
+			}
+			return owners[i].Address < owners[j].Address
+		})
+		alerts = append(alerts, NewDuplicateOwnershipAlert(claimants[0], owners))
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].resource.ResourceType() != alerts[j].resource.ResourceType() {
+			return alerts[i].resource.ResourceType() < alerts[j].resource.ResourceType()
+		}
+		return alerts[i].resource.ResourceId() < alerts[j].resource.ResourceId()
+	})
+	return alerts
+}
+
+func countStates(owners []ResourceOwner) int {
+	states := make(map[string]struct{}, len(owners))
+	for _, owner := range owners {
+		states[owner.State] = struct{}{}
+	}
+	return len(states)
+}
@@ -0,0 +1,40 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDuplicateOwnership(t *testing.T) {
+	resources := []*resource.Resource{
+		{Id: "vpc-1", Type: "aws_vpc", Source: resource.NewTerraformStateSource("tfstate://network.tfstate", "", "main")},
+		{Id: "vpc-1", Type: "aws_vpc", Source: resource.NewTerraformStateSource("tfstate+s3://states/app.tfstate", "module.network", "this")},
+		{Id: "bucket", Type: "aws_s3_bucket", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "", "assets")},
+		{Id: "bucket", Type: "aws_s3_bucket", Source: resource.NewTerraformStateSource("tfstate://network.tfstate", "", "logs")},
+		{Id: "bucket", Type: "aws_s3_bucket", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "module.cdn", "assets")},
+		// Claimed twice by the same state
+		{Id: "role", Type: "aws_iam_role", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "", "deploy")},
+		{Id: "role", Type: "aws_iam_role", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "module.ci", "deploy")},
+		// Same ID, different types
+		{Id: "vpc-1", Type: "aws_default_vpc", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "", "default")},
+		// Without source
+		{Id: "user", Type: "aws_iam_user"},
+		{Id: "user", Type: "aws_iam_user", Source: resource.NewTerraformStateSource("tfstate://app.tfstate", "", "ci")},
+	}
+
+	alerts := FindDuplicateOwnership(resources)
+
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, "aws_s3_bucket", alerts[0].Resource().ResourceType())
+	assert.Equal(t, []ResourceOwner{
+		{State: "tfstate://app.tfstate", Address: "aws_s3_bucket.assets"},
+		{State: "tfstate://app.tfstate", Address: "module.cdn.aws_s3_bucket.assets"},
+		{State: "tfstate://network.tfstate", Address: "aws_s3_bucket.logs"},
+	}, alerts[0].Owners())
+	assert.Equal(t, "Resource bucket (aws_s3_bucket) is managed by 2 different Terraform states: aws_s3_bucket.assets in tfstate://app.tfstate, module.cdn.aws_s3_bucket.assets in tfstate://app.tfstate, aws_s3_bucket.logs in tfstate://network.tfstate", alerts[0].Message())
+	assert.False(t, alerts[0].ShouldIgnoreResource())
+
+	assert.Equal(t, "Resource vpc-1 (aws_vpc) is managed by 2 different Terraform states: module.network.aws_vpc.this in tfstate+s3://states/app.tfstate, aws_vpc.main in tfstate://network.tfstate", alerts[1].Message())
+}
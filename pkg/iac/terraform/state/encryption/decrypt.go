@@ -0,0 +1,138 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// encryptedState is the envelope OpenTofu writes encrypted states in
+type encryptedState struct {
+	Meta    map[string][]byte `json:"meta"`
+	Data    []byte            `json:"encrypted_data"`
+	Version string            `json:"encryption_version"`
+}
+
+// Decrypt returns the plain state of an encrypted one, unencrypted states are returned as is.
+// The state method is tried first, then the fallback, then every configured method.
+func (c *Config) Decrypt(payload []byte) ([]byte, error) {
+	var state encryptedState
+	if err := json.Unmarshal(payload, &state); err != nil || state.Version == "" {
+		return payload, nil
+	}
+	if c == nil {
+		return nil, errors.Errorf("state is encrypted, configure an encryption block or %s to read it", EnvVar)
+	}
+
+	var lastErr error
+	for _, address := range c.candidateMethods() {
+		plain, err := c.decryptWith(address, state)
+		if err == nil {
+			logrus.WithField("method", address).Debug("Decrypted state")
+			return plain, nil
+		}
+		logrus.WithFields(logrus.Fields{
+			"method": address,
+			"error":  err,
+		}).Debug("Unable to decrypt state")
+		lastErr = err
+	}
+	if lastErr == nil {
+		return nil, errors.New("unable to decrypt state: no encryption method configured")
+	}
+	return nil, errors.Wrap(lastErr, "unable to decrypt state")
+}
+
+func (c *Config) candidateMethods() []string {
+	addresses := make([]string, 0, len(c.Methods))
+	seen := map[string]bool{}
+	for _, address := range []string{c.StateMethod, c.StateFallback} {
+		if address != "" && !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	// Other methods are tried in a stable order so that the error reported is always the same
+	others := make([]string, 0, len(c.Methods))
+	for address := range c.Methods {
+		if !seen[address] {
+			others = append(others, address)
+		}
+	}
+	sort.Strings(others)
+	return append(addresses, others...)
+}
+
+func (c *Config) decryptWith(address string, state encryptedState) ([]byte, error) {
+	method, exist := c.Methods[address]
+	if !exist {
+		return nil, errors.Errorf("method %s is not configured", address)
+	}
+	if method.Type != "aes_gcm" {
+		return nil, errors.Errorf("unsupported method type %s", method.Type)
+	}
+	provider, exist := c.KeyProviders[method.Keys]
+	if !exist {
+		return nil, errors.Errorf("key provider %s of %s is not configured", method.Keys, address)
+	}
+	meta, exist := state.Meta[provider.metaKey()]
+	if !exist {
+		return nil, errors.Errorf("state was not encrypted with key provider %s", provider.metaKey())
+	}
+	key, err := c.decryptionKey(provider, meta)
+	if err != nil {
+		return nil, err
+	}
+	return decryptAESGCM(key, state.Data)
+}
+
+func decryptAESGCM(key, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("encrypted data is too short")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+// Reader decrypts the state read from a backend in memory
+type Reader struct {
+	backend io.ReadCloser
+	config  *Config
+	reader  io.Reader
+}
+
+func NewReader(backend io.ReadCloser, config *Config) *Reader {
+	return &Reader{backend: backend, config: config}
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	if r.reader == nil {
+		payload, err := io.ReadAll(r.backend)
+		if err != nil {
+			return 0, err
+		}
+		plain, err := r.config.Decrypt(payload)
+		if err != nil {
+			return 0, err
+		}
+		r.reader = bytes.NewReader(plain)
+	}
+	return r.reader.Read(p)
+}
+
+func (r *Reader) Close() error {
+	return r.backend.Close()
+}
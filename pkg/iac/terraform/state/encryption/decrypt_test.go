@@ -0,0 +1,216 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/pbkdf2"
+	"google.golang.org/api/option"
+)
+
+const plainState = `{"version": 4, "terraform_version": "1.7.0", "serial": 1, "lineage": "abc", "outputs": {}, "resources": []}`
+
+// encrypt writes states the way OpenTofu does with the aes_gcm method
+func encrypt(t *testing.T, key []byte, meta map[string]interface{}) []byte {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatal(err)
+	}
+
+	state := encryptedState{
+		Meta:    map[string][]byte{},
+		Data:    append(nonce, gcm.Seal(nil, nonce, []byte(plainState), nil)...),
+		Version: "v0",
+	}
+	for key, value := range meta {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state.Meta[key] = encoded
+	}
+	payload, err := json.Marshal(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func pbkdf2Config(passphrase string) *Config {
+	return &Config{
+		KeyProviders: map[string]KeyProvider{
+			"key_provider.pbkdf2.main": {Type: "pbkdf2", Name: "main", Passphrase: passphrase},
+		},
+		Methods: map[string]Method{
+			"method.aes_gcm.main": {Type: "aes_gcm", Name: "main", Keys: "key_provider.pbkdf2.main"},
+		},
+		StateMethod: "method.aes_gcm.main",
+	}
+}
+
+func TestConfig_Decrypt_PBKDF2(t *testing.T) {
+	salt := []byte("0123456789abcdef0123456789abcdef")
+	key := pbkdf2.Key([]byte("correct-horse-battery-staple"), salt, 1000, 32, sha512.New)
+	payload := encrypt(t, key, map[string]interface{}{
+		"key_provider.pbkdf2.main": pbkdf2Meta{Salt: salt, Iterations: 1000, HashFunction: "sha512", KeyLength: 32},
+	})
+
+	plain, err := pbkdf2Config("correct-horse-battery-staple").Decrypt(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, plainState, string(plain))
+
+	_, err = pbkdf2Config("wrong").Decrypt(payload)
+	assert.EqualError(t, err, "unable to decrypt state: cipher: message authentication failed")
+
+	var config *Config
+	_, err = config.Decrypt(payload)
+	assert.EqualError(t, err, "state is encrypted, configure an encryption block or TF_ENCRYPTION to read it")
+}
+
+func TestConfig_Decrypt_Fallback(t *testing.T) {
+	salt := []byte("0123456789abcdef0123456789abcdef")
+	key := pbkdf2.Key([]byte("old-passphrase"), salt, 1000, 32, sha512.New)
+	payload := encrypt(t, key, map[string]interface{}{
+		"old-key": pbkdf2Meta{Salt: salt, Iterations: 1000, HashFunction: "sha512", KeyLength: 32},
+	})
+
+	config := pbkdf2Config("new-passphrase")
+	config.KeyProviders["key_provider.pbkdf2.old"] = KeyProvider{Type: "pbkdf2", Name: "old", Alias: "old-key", Passphrase: "old-passphrase"}
+	config.Methods["method.aes_gcm.old"] = Method{Type: "aes_gcm", Name: "old", Keys: "key_provider.pbkdf2.old"}
+	config.StateFallback = "method.aes_gcm.old"
+
+	plain, err := config.Decrypt(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, plainState, string(plain))
+}
+
+func TestConfig_candidateMethods(t *testing.T) {
+	config := pbkdf2Config("passphrase")
+	for _, name := range []string{"zeta", "alpha", "old", "beta"} {
+		config.Methods["method.aes_gcm."+name] = Method{Type: "aes_gcm", Name: name, Keys: "key_provider.pbkdf2.main"}
+	}
+	config.StateFallback = "method.aes_gcm.old"
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, []string{
+			"method.aes_gcm.main",
+			"method.aes_gcm.old",
+			"method.aes_gcm.alpha",
+			"method.aes_gcm.beta",
+			"method.aes_gcm.zeta",
+		}, config.candidateMethods())
+	}
+}
+
+func TestConfig_Decrypt_Unencrypted(t *testing.T) {
+	plain, err := pbkdf2Config("passphrase").Decrypt([]byte(plainState))
+	assert.NoError(t, err)
+	assert.Equal(t, plainState, string(plain))
+}
+
+func TestConfig_Decrypt_AWSKMS(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	payload := encrypt(t, key, map[string]interface{}{
+		"key_provider.aws_kms.main": awsKMSMeta{CiphertextBlob: []byte("encrypted-key")},
+	})
+
+	client := &awstest.MockFakeKMS{}
+	client.On("Decrypt", &kms.DecryptInput{
+		CiphertextBlob: []byte("encrypted-key"),
+		KeyId:          aws.String("alias/tofu-state"),
+	}).Return(&kms.DecryptOutput{Plaintext: key}, nil)
+
+	config := &Config{
+		KeyProviders: map[string]KeyProvider{
+			"key_provider.aws_kms.main": {Type: "aws_kms", Name: "main", KMSKeyID: "alias/tofu-state", Region: "eu-west-3"},
+		},
+		Methods: map[string]Method{
+			"method.aes_gcm.main": {Type: "aes_gcm", Name: "main", Keys: "key_provider.aws_kms.main"},
+		},
+		awsKMS: client,
+	}
+
+	plain, err := config.Decrypt(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, plainState, string(plain))
+	client.AssertExpectations(t)
+}
+
+func TestConfig_Decrypt_GCPKMS(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	payload := encrypt(t, key, map[string]interface{}{
+		"key_provider.gcp_kms.main": gcpKMSMeta{Ciphertext: []byte("encrypted-key")},
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/p/locations/global/keyRings/r/cryptoKeys/k:decrypt", r.URL.Path)
+		var request struct {
+			Ciphertext string `json:"ciphertext"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("encrypted-key")), request.Ciphertext)
+		_ = json.NewEncoder(w).Encode(map[string]string{"plaintext": base64.StdEncoding.EncodeToString(key)})
+	}))
+	defer server.Close()
+
+	config := &Config{
+		KeyProviders: map[string]KeyProvider{
+			"key_provider.gcp_kms.main": {Type: "gcp_kms", Name: "main", KMSEncryptionKey: "projects/p/locations/global/keyRings/r/cryptoKeys/k"},
+		},
+		Methods: map[string]Method{
+			"method.aes_gcm.main": {Type: "aes_gcm", Name: "main", Keys: "key_provider.gcp_kms.main"},
+		},
+		gcpOptions: []option.ClientOption{option.WithEndpoint(server.URL), option.WithoutAuthentication()},
+	}
+
+	plain, err := config.Decrypt(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, plainState, string(plain))
+}
+
+type closer struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closer) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestReader(t *testing.T) {
+	salt := []byte("0123456789abcdef0123456789abcdef")
+	key := pbkdf2.Key([]byte("passphrase"), salt, 1000, 32, sha512.New)
+	payload := encrypt(t, key, map[string]interface{}{
+		"key_provider.pbkdf2.main": pbkdf2Meta{Salt: salt, Iterations: 1000, HashFunction: "sha512", KeyLength: 32},
+	})
+
+	backend := &closer{Reader: bytes.NewReader(payload)}
+	reader := NewReader(backend, pbkdf2Config("passphrase"))
+	plain, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, plainState, string(plain))
+	assert.NoError(t, reader.Close())
+	assert.True(t, backend.closed)
+}
@@ -0,0 +1,253 @@
+package encryption
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/option"
+)
+
+// EnvVar holds an encryption block body, it is merged over the one found in Terraform files like
+// OpenTofu does
+const EnvVar = "TF_ENCRYPTION"
+
+type KeyProvider struct {
+	Type string
+	Name string
+	// Alias is the key the provider metadata is stored under instead of its address
+	Alias string
+	// Passphrase configures the pbkdf2 key provider
+	Passphrase string
+	// KMSKeyID and Region configure the aws_kms key provider
+	KMSKeyID string
+	Region   string
+	// KMSEncryptionKey configures the gcp_kms key provider
+	KMSEncryptionKey string
+}
+
+// Address is how methods reference the key provider, e.g. key_provider.pbkdf2.mykey
+func (k KeyProvider) Address() string {
+	return strings.Join([]string{"key_provider", k.Type, k.Name}, ".")
+}
+
+func (k KeyProvider) metaKey() string {
+	if k.Alias != "" {
+		return k.Alias
+	}
+	return k.Address()
+}
+
+type Method struct {
+	Type string
+	Name string
+	// Keys is the address of the key provider of the method
+	Keys string
+}
+
+// Address is how the state block references the method, e.g. method.aes_gcm.mymethod
+func (m Method) Address() string {
+	return strings.Join([]string{"method", m.Type, m.Name}, ".")
+}
+
+// Config is the state encryption configuration of OpenTofu
+type Config struct {
+	KeyProviders map[string]KeyProvider
+	Methods      map[string]Method
+	// StateMethod and StateFallback are the addresses of the methods states are encrypted with
+	StateMethod   string
+	StateFallback string
+
+	awsKMS     kmsiface.KMSAPI
+	gcpOptions []option.ClientOption
+}
+
+type keyProviderBlock struct {
+	Type             string   `hcl:"type,label"`
+	Name             string   `hcl:"name,label"`
+	Alias            string   `hcl:"encrypted_metadata_alias,optional"`
+	Passphrase       string   `hcl:"passphrase,optional"`
+	KMSKeyID         string   `hcl:"kms_key_id,optional"`
+	Region           string   `hcl:"region,optional"`
+	KMSEncryptionKey string   `hcl:"kms_encryption_key,optional"`
+	Remain           hcl.Body `hcl:",remain"`
+}
+
+type methodBlock struct {
+	Type   string         `hcl:"type,label"`
+	Name   string         `hcl:"name,label"`
+	Keys   hcl.Expression `hcl:"keys,optional"`
+	Remain hcl.Body       `hcl:",remain"`
+}
+
+type fallbackBlock struct {
+	Method hcl.Expression `hcl:"method"`
+	Remain hcl.Body       `hcl:",remain"`
+}
+
+type targetBlock struct {
+	Method   hcl.Expression `hcl:"method,optional"`
+	Fallback *fallbackBlock `hcl:"fallback,block"`
+	Remain   hcl.Body       `hcl:",remain"`
+}
+
+type encryptionBlock struct {
+	KeyProviders []keyProviderBlock `hcl:"key_provider,block"`
+	Methods      []methodBlock      `hcl:"method,block"`
+	State        *targetBlock       `hcl:"state,block"`
+	Remain       hcl.Body           `hcl:",remain"`
+}
+
+type terraformBlock struct {
+	Encryption *encryptionBlock `hcl:"encryption,block"`
+	Remain     hcl.Body         `hcl:",remain"`
+}
+
+type fileBody struct {
+	Terraform []terraformBlock `hcl:"terraform,block"`
+	Remain    hcl.Body         `hcl:",remain"`
+}
+
+// LoadConfig reads the encryption block of the Terraform files of dir and TF_ENCRYPTION, it
+// returns nil when states are not encrypted
+func LoadConfig(dir string) (*Config, error) {
+	var config *Config
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+	parser := hclparse.NewParser()
+	for _, match := range matches {
+		f, diags := parser.ParseHCLFile(match)
+		if diags.HasErrors() {
+			logrus.WithField("file", match).WithField("error", diags).Debug("Error parsing Terraform file")
+			continue
+		}
+		var body fileBody
+		if diags := gohcl.DecodeBody(f.Body, nil, &body); diags.HasErrors() {
+			logrus.WithField("file", match).WithField("error", diags).Debug("Error parsing Terraform file")
+			continue
+		}
+		for _, terraform := range body.Terraform {
+			if terraform.Encryption == nil {
+				continue
+			}
+			config, err = config.merge(terraform.Encryption)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid encryption block in %s", match)
+			}
+		}
+	}
+
+	if env := os.Getenv(EnvVar); env != "" {
+		block, err := parseEncryptionBody(env)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", EnvVar)
+		}
+		config, err = config.merge(block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", EnvVar)
+		}
+	}
+
+	return config, nil
+}
+
+// parseEncryptionBody parses the body of an encryption block written in HCL or JSON
+func parseEncryptionBody(content string) (*encryptionBlock, error) {
+	parser := hclparse.NewParser()
+	var f *hcl.File
+	var diags hcl.Diagnostics
+	if strings.HasPrefix(strings.TrimSpace(content), "{") {
+		f, diags = parser.ParseJSON([]byte(content), EnvVar)
+	} else {
+		f, diags = parser.ParseHCL([]byte(content), EnvVar)
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	var block encryptionBlock
+	if diags := gohcl.DecodeBody(f.Body, nil, &block); diags.HasErrors() {
+		return nil, diags
+	}
+	return &block, nil
+}
+
+// merge adds block to the configuration, key providers and methods of block replace the ones
+// with the same address
+func (c *Config) merge(block *encryptionBlock) (*Config, error) {
+	if c == nil {
+		c = &Config{KeyProviders: map[string]KeyProvider{}, Methods: map[string]Method{}}
+	}
+	for _, b := range block.KeyProviders {
+		provider := KeyProvider{
+			Type:             b.Type,
+			Name:             b.Name,
+			Alias:            b.Alias,
+			Passphrase:       b.Passphrase,
+			KMSKeyID:         b.KMSKeyID,
+			Region:           b.Region,
+			KMSEncryptionKey: b.KMSEncryptionKey,
+		}
+		c.KeyProviders[provider.Address()] = provider
+	}
+	for _, b := range block.Methods {
+		method := Method{Type: b.Type, Name: b.Name}
+		if b.Keys != nil {
+			keys, err := reference(b.Keys)
+			if err != nil {
+				return nil, err
+			}
+			method.Keys = keys
+		}
+		c.Methods[method.Address()] = method
+	}
+	if block.State != nil {
+		method, err := reference(block.State.Method)
+		if err != nil {
+			return nil, err
+		}
+		if method != "" {
+			c.StateMethod = method
+		}
+		if block.State.Fallback != nil {
+			fallback, err := reference(block.State.Fallback.Method)
+			if err != nil {
+				return nil, err
+			}
+			c.StateFallback = fallback
+		}
+	}
+	return c, nil
+}
+
+// reference returns the address an expression like key_provider.pbkdf2.mykey refers to, or
+// an empty string for a missing optional attribute
+func reference(expr hcl.Expression) (string, error) {
+	if expr == nil {
+		return "", nil
+	}
+	if value, diags := expr.Value(nil); !diags.HasErrors() && value.IsNull() {
+		return "", nil
+	}
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() {
+		return "", diags
+	}
+	parts := []string{traversal.RootName()}
+	for _, step := range traversal[1:] {
+		attr, ok := step.(hcl.TraverseAttr)
+		if !ok {
+			return "", errors.Errorf("unsupported reference at %s", expr.Range())
+		}
+		parts = append(parts, attr.Name)
+	}
+	return strings.Join(parts, "."), nil
+}
@@ -0,0 +1,63 @@
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig("testdata/config")
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{
+		KeyProviders: map[string]KeyProvider{
+			"key_provider.pbkdf2.old":  {Type: "pbkdf2", Name: "old", Passphrase: "correct-horse-battery-staple"},
+			"key_provider.aws_kms.new": {Type: "aws_kms", Name: "new", KMSKeyID: "alias/tofu-state", Region: "eu-west-3"},
+		},
+		Methods: map[string]Method{
+			"method.aes_gcm.old": {Type: "aes_gcm", Name: "old", Keys: "key_provider.pbkdf2.old"},
+			"method.aes_gcm.new": {Type: "aes_gcm", Name: "new", Keys: "key_provider.aws_kms.new"},
+		},
+		StateMethod:   "method.aes_gcm.new",
+		StateFallback: "method.aes_gcm.old",
+	}, config)
+}
+
+func TestLoadConfig_Env(t *testing.T) {
+	t.Setenv(EnvVar, `
+key_provider "pbkdf2" "old" {
+  passphrase = "from-env"
+}
+`)
+	config, err := LoadConfig("testdata/config")
+	assert.NoError(t, err)
+	assert.Equal(t, "from-env", config.KeyProviders["key_provider.pbkdf2.old"].Passphrase)
+	assert.Equal(t, "method.aes_gcm.new", config.StateMethod)
+
+	t.Setenv(EnvVar, `{
+  "key_provider": {"gcp_kms": {"main": {"kms_encryption_key": "projects/p/locations/global/keyRings/r/cryptoKeys/k"}}},
+  "method": {"aes_gcm": {"main": {"keys": "key_provider.gcp_kms.main"}}},
+  "state": {"method": "method.aes_gcm.main"}
+}`)
+	config, err = LoadConfig("testdata")
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{
+		KeyProviders: map[string]KeyProvider{
+			"key_provider.gcp_kms.main": {Type: "gcp_kms", Name: "main", KMSEncryptionKey: "projects/p/locations/global/keyRings/r/cryptoKeys/k"},
+		},
+		Methods: map[string]Method{
+			"method.aes_gcm.main": {Type: "aes_gcm", Name: "main", Keys: "key_provider.gcp_kms.main"},
+		},
+		StateMethod: "method.aes_gcm.main",
+	}, config)
+}
+
+func TestLoadConfig_None(t *testing.T) {
+	config, err := LoadConfig("testdata")
+	assert.NoError(t, err)
+	assert.Nil(t, config)
+
+	t.Setenv(EnvVar, `key_provider "pbkdf2" {}`)
+	_, err = LoadConfig("testdata")
+	assert.Error(t, err)
+}
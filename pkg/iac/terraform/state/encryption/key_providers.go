@@ -0,0 +1,115 @@
+package encryption
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"hash"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+)
+
+type pbkdf2Meta struct {
+	Salt         []byte `json:"salt"`
+	Iterations   int    `json:"iterations"`
+	HashFunction string `json:"hash_function"`
+	KeyLength    int    `json:"key_length"`
+}
+
+type awsKMSMeta struct {
+	CiphertextBlob []byte `json:"ciphertext_blob"`
+}
+
+type gcpKMSMeta struct {
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// decryptionKey derives the key a state was encrypted with from the metadata the key provider
+// stored next to it
+func (c *Config) decryptionKey(provider KeyProvider, meta []byte) ([]byte, error) {
+	switch provider.Type {
+	case "pbkdf2":
+		return pbkdf2Key(provider, meta)
+	case "aws_kms":
+		return c.awsKMSKey(provider, meta)
+	case "gcp_kms":
+		return c.gcpKMSKey(provider, meta)
+	}
+	return nil, errors.Errorf("unsupported key provider type %s", provider.Type)
+}
+
+func pbkdf2Key(provider KeyProvider, meta []byte) ([]byte, error) {
+	if provider.Passphrase == "" {
+		return nil, errors.Errorf("%s has no passphrase", provider.Address())
+	}
+	var m pbkdf2Meta
+	if err := json.Unmarshal(meta, &m); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s metadata", provider.Address())
+	}
+
+	var hashFunc func() hash.Hash
+	switch m.HashFunction {
+	case "sha256":
+		hashFunc = sha256.New
+	case "sha512", "":
+		hashFunc = sha512.New
+	default:
+		return nil, errors.Errorf("unsupported pbkdf2 hash function %s", m.HashFunction)
+	}
+	return pbkdf2.Key([]byte(provider.Passphrase), m.Salt, m.Iterations, m.KeyLength, hashFunc), nil
+}
+
+func (c *Config) awsKMSKey(provider KeyProvider, meta []byte) ([]byte, error) {
+	var m awsKMSMeta
+	if err := json.Unmarshal(meta, &m); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s metadata", provider.Address())
+	}
+
+	client := c.awsKMS
+	if client == nil {
+		sess, err := session.NewSessionWithOptions(session.Options{
+			SharedConfigState: session.SharedConfigEnable,
+			Config:            aws.Config{Region: aws.String(provider.Region)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		client = kms.New(sess)
+	}
+
+	input := &kms.DecryptInput{CiphertextBlob: m.CiphertextBlob}
+	if provider.KMSKeyID != "" {
+		input.KeyId = aws.String(provider.KMSKeyID)
+	}
+	output, err := client.Decrypt(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decrypt the key of %s", provider.Address())
+	}
+	return output.Plaintext, nil
+}
+
+func (c *Config) gcpKMSKey(provider KeyProvider, meta []byte) ([]byte, error) {
+	var m gcpKMSMeta
+	if err := json.Unmarshal(meta, &m); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s metadata", provider.Address())
+	}
+
+	service, err := cloudkms.NewService(context.Background(), c.gcpOptions...)
+	if err != nil {
+		return nil, err
+	}
+	response, err := service.Projects.Locations.KeyRings.CryptoKeys.Decrypt(provider.KMSEncryptionKey, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(m.Ciphertext),
+	}).Do()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decrypt the key of %s", provider.Address())
+	}
+	return base64.StdEncoding.DecodeString(response.Plaintext)
+}
@@ -0,0 +1,30 @@
+terraform {
+  encryption {
+    key_provider "pbkdf2" "old" {
+      passphrase = "correct-horse-battery-staple"
+    }
+
+    key_provider "aws_kms" "new" {
+      kms_key_id = "alias/tofu-state"
+      region     = "eu-west-3"
+    }
+
+    method "aes_gcm" "old" {
+      keys = key_provider.pbkdf2.old
+    }
+
+    method "aes_gcm" "new" {
+      keys = key_provider.aws_kms.new
+    }
+
+    state {
+      method = method.aes_gcm.new
+
+      fallback {
+        method = method.aes_gcm.old
+      }
+    }
+  }
+}
+
+provider "aws" {}
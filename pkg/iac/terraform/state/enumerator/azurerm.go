@@ -58,7 +58,7 @@ func (s *AzureRMEnumerator) Origin() string {
 	return s.origin
 }
 
-func (s *AzureRMEnumerator) Enumerate() ([]string, error) {
+func (s *AzureRMEnumerator) Enumerate(ctx context.Context) ([]string, error) {
 	// prefix should contains everything that does not have a glob pattern
 	// Pattern should be the glob matcher string
 	prefix, pattern := extractPrefixAndPattern(s.objectPath)
@@ -71,7 +71,7 @@ func (s *AzureRMEnumerator) Enumerate() ([]string, error) {
 	})
 
 	files := make([]string, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		for _, v := range resp.ContainerListBlobFlatSegmentResult.Segment.BlobItems {
 			if *v.Properties.ContentLength == 0 {
@@ -27,6 +27,6 @@ func extractPrefixAndPattern(path string) (prefix string, pattern string) {
 
 // HasMeta reports whether path contains any of the magic characters
 func HasMeta(path string) bool {
-	magicChars := `?*[]`
+	magicChars := `?*[]{}`
 	return strings.ContainsAny(path, magicChars)
 }
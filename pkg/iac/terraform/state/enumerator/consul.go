@@ -0,0 +1,48 @@
+package enumerator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+)
+
+// ConsulEnumerator lists state keys, terraform stores the states of workspaces other than
+// default under {path}-env:{workspace} so a path/to/state* glob matches every workspace
+type ConsulEnumerator struct {
+	config config.SupplierConfig
+	client *backend.ConsulClient
+}
+
+func NewConsulEnumerator(config config.SupplierConfig) *ConsulEnumerator {
+	return &ConsulEnumerator{config, backend.NewConsulClient(&http.Client{})}
+}
+
+func (e *ConsulEnumerator) Origin() string {
+	return e.config.String()
+}
+
+func (e *ConsulEnumerator) Enumerate(ctx context.Context) ([]string, error) {
+	prefix, _ := extractPrefixAndPattern(e.config.Path)
+	keys, err := e.client.Keys(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0)
+	for _, key := range keys {
+		if match, _ := doublestar.Match(e.config.Path, key); match {
+			files = append(files, key)
+		}
+	}
+	sort.Strings(files)
+
+	if len(files) == 0 {
+		return files, fmt.Errorf("no Terraform state was found in %s, exiting", e.config.Path)
+	}
+	return files, nil
+}
@@ -0,0 +1,52 @@
+package enumerator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsulEnumerator_Enumerate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/kv/terraform", r.URL.Path)
+		_, _ = w.Write([]byte(`["terraform/network", "terraform/network-env:prod", "terraform/network/tfstate.abc/0", "terraform/app"]`))
+	}))
+	defer server.Close()
+	t.Setenv("CONSUL_HTTP_ADDR", server.URL)
+
+	tests := []struct {
+		name     string
+		path     string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "every workspace of a state",
+			path:     "terraform/network*",
+			expected: []string{"terraform/network", "terraform/network-env:prod"},
+		},
+		{
+			name:     "no state found",
+			path:     "terraform/db*",
+			expected: []string{},
+			err:      "no Terraform state was found in terraform/db*, exiting",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewConsulEnumerator(config.SupplierConfig{Key: "tfstate", Backend: backend.BackendKeyConsul, Path: tt.path})
+			got, err := e.Enumerate(context.Background())
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package enumerator
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -27,7 +28,7 @@ func (s *FileEnumerator) Origin() string {
 	return s.config.String()
 }
 
-func (s *FileEnumerator) Enumerate() ([]string, error) {
+func (s *FileEnumerator) Enumerate(_ context.Context) ([]string, error) {
 	path := s.config.Path
 
 	info, err := os.Lstat(path)
@@ -1,6 +1,7 @@
 package enumerator
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -85,6 +86,16 @@ func TestFileEnumerator_Enumerate(t *testing.T) {
 				"testdata/states/terraform.tfstate",
 			},
 		},
+		{
+			name: "glob with alternatives",
+			config: config.SupplierConfig{
+				Path: "testdata/states/{terraform.tfstate,s3/terraform.tfstate}",
+			},
+			want: []string{
+				"testdata/states/s3/terraform.tfstate",
+				"testdata/states/terraform.tfstate",
+			},
+		},
 		{
 			name: "invalid folder",
 			config: config.SupplierConfig{
@@ -138,7 +149,7 @@ func TestFileEnumerator_Enumerate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := NewFileEnumerator(tt.config)
-			got, err := s.Enumerate()
+			got, err := s.Enumerate(context.Background())
 			if tt.err != "" {
 				assert.EqualError(t, err, tt.err)
 			} else {
@@ -11,7 +11,8 @@ import (
 )
 
 func Glob(pattern string) ([]string, error) {
-	if !strings.Contains(pattern, "**") {
+	// filepath.Glob supports neither ** nor {a,b} alternatives
+	if !strings.Contains(pattern, "**") && !strings.ContainsAny(pattern, "{}") {
 		return filepath.Glob(pattern)
 	}
 
@@ -16,28 +16,17 @@ import (
 
 type GSEnumerator struct {
 	config config.SupplierConfig
-	client storage.Client
 }
 
-func NewGSEnumerator(config config.SupplierConfig) (*GSEnumerator, error) {
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, errors.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	return &GSEnumerator{
-		config,
-		*client,
-	}, nil
+func NewGSEnumerator(config config.SupplierConfig) *GSEnumerator {
+	return &GSEnumerator{config}
 }
 
 func (s *GSEnumerator) Origin() string {
 	return s.config.String()
 }
 
-func (s *GSEnumerator) Enumerate() ([]string, error) {
+func (s *GSEnumerator) Enumerate(ctx context.Context) ([]string, error) {
 	bucketPath := strings.Split(s.config.Path, "/")
 	if len(bucketPath) < 2 {
 		return nil, fmt.Errorf("unable to parse GS path: %s. Must be BUCKET_NAME/PREFIX", s.config.Path)
@@ -51,11 +40,17 @@ func (s *GSEnumerator) Enumerate() ([]string, error) {
 	// We combine the prefix and pattern to match file names against.
 	fullPattern := path.Join(prefix, pattern)
 
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, errors.Errorf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
 	files := make([]string, 0)
 
-	bucket := s.client.Bucket(bucketName)
+	bucket := client.Bucket(bucketName)
 
-	it := bucket.Objects(context.Background(), &storage.Query{})
+	it := bucket.Objects(ctx, &storage.Query{})
 	for {
 		attrs, err := it.Next()
 		if err == iterator.Done {
@@ -0,0 +1,66 @@
+package enumerator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// KubernetesEnumerator lists the state secrets of a namespace, the path is NAMESPACE/GLOB where
+// terraform names secrets tfstate-{workspace}-{secret_suffix}
+type KubernetesEnumerator struct {
+	config config.SupplierConfig
+	client kubernetes.Interface
+}
+
+func NewKubernetesEnumerator(config config.SupplierConfig) *KubernetesEnumerator {
+	return &KubernetesEnumerator{config: config}
+}
+
+func (e *KubernetesEnumerator) Origin() string {
+	return e.config.String()
+}
+
+func (e *KubernetesEnumerator) Enumerate(ctx context.Context) ([]string, error) {
+	parts := strings.Split(e.config.Path, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, errors.Errorf("Unable to parse kubernetes backend path: %s. Must be NAMESPACE/SECRET_NAME", e.config.Path)
+	}
+	namespace, pattern := parts[0], parts[1]
+
+	if e.client == nil {
+		client, err := backend.NewKubernetesClient()
+		if err != nil {
+			return nil, err
+		}
+		e.client = client
+	}
+
+	secrets, err := e.client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: backend.KubernetesStateLabelSelector,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list state secrets")
+	}
+
+	files := make([]string, 0)
+	for _, secret := range secrets.Items {
+		if match, _ := doublestar.Match(pattern, secret.Name); match {
+			files = append(files, namespace+"/"+secret.Name)
+		}
+	}
+	sort.Strings(files)
+
+	if len(files) == 0 {
+		return files, fmt.Errorf("no Terraform state was found in %s, exiting", e.config.Path)
+	}
+	return files, nil
+}
@@ -0,0 +1,58 @@
+package enumerator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestKubernetesEnumerator_Enumerate(t *testing.T) {
+	secret := func(name string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "terraform", Labels: labels}}
+	}
+	stateLabels := map[string]string{"tfstate": "true"}
+	client := fake.NewSimpleClientset(
+		secret("tfstate-prod-network", stateLabels),
+		secret("tfstate-default-network", stateLabels),
+		secret("tfstate-default-app", stateLabels),
+		secret("tfstate-staging-network", nil),
+	)
+
+	tests := []struct {
+		name     string
+		path     string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "every workspace of a secret suffix",
+			path:     "terraform/tfstate-*-network",
+			expected: []string{"terraform/tfstate-default-network", "terraform/tfstate-prod-network"},
+		},
+		{
+			name:     "no state found",
+			path:     "other/tfstate-*",
+			expected: []string{},
+			err:      "no Terraform state was found in other/tfstate-*, exiting",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewKubernetesEnumerator(config.SupplierConfig{Key: "tfstate", Backend: backend.BackendKeyKubernetes, Path: tt.path})
+			e.client = client
+			got, err := e.Enumerate(context.Background())
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
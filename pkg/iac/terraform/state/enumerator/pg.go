@@ -0,0 +1,70 @@
+package enumerator
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+)
+
+// PGEnumerator lists the workspaces of a pg backend schema, the path is SCHEMA/GLOB
+type PGEnumerator struct {
+	config config.SupplierConfig
+	db     *sql.DB
+}
+
+func NewPGEnumerator(config config.SupplierConfig) *PGEnumerator {
+	return &PGEnumerator{config: config}
+}
+
+func (e *PGEnumerator) Origin() string {
+	return e.config.String()
+}
+
+func (e *PGEnumerator) Enumerate(ctx context.Context) ([]string, error) {
+	parts := strings.Split(e.config.Path, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, errors.Errorf("Unable to parse pg backend path: %s. Must be SCHEMA/WORKSPACE", e.config.Path)
+	}
+	schema, pattern := parts[0], parts[1]
+
+	db := e.db
+	if db == nil {
+		var err error
+		db, err = backend.OpenPostgres()
+		if err != nil {
+			return nil, err
+		}
+		defer db.Close()
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT name FROM %s ORDER BY name", backend.PostgresStatesTable(schema)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list workspaces from postgres")
+	}
+	defer rows.Close()
+
+	workspaces := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		if match, _ := doublestar.Match(pattern, name); match {
+			workspaces = append(workspaces, schema+"/"+name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(workspaces) == 0 {
+		return workspaces, fmt.Errorf("no Terraform state was found in %s, exiting", e.config.Path)
+	}
+	return workspaces, nil
+}
@@ -0,0 +1,32 @@
+package enumerator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPGEnumerator_Enumerate(t *testing.T) {
+	db, dbMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	dbMock.ExpectQuery(`SELECT name FROM "terraform_remote_state".states ORDER BY name`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("default").AddRow("prod-app").AddRow("prod-db"))
+
+	e := NewPGEnumerator(config.SupplierConfig{Key: "tfstate", Backend: backend.BackendKeyPostgres, Path: "terraform_remote_state/prod-*"})
+	e.db = db
+	got, err := e.Enumerate(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"terraform_remote_state/prod-app", "terraform_remote_state/prod-db"}, got)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+
+	e = NewPGEnumerator(config.SupplierConfig{Key: "tfstate", Backend: backend.BackendKeyPostgres, Path: "prod-*"})
+	_, err = e.Enumerate(context.Background())
+	assert.EqualError(t, err, "Unable to parse pg backend path: prod-*. Must be SCHEMA/WORKSPACE")
+}
@@ -1,6 +1,7 @@
 package enumerator
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"strings"
@@ -38,7 +39,7 @@ func (s *S3Enumerator) Origin() string {
 	return s.config.String()
 }
 
-func (s *S3Enumerator) Enumerate() ([]string, error) {
+func (s *S3Enumerator) Enumerate(ctx context.Context) ([]string, error) {
 	bucketPath := strings.Split(s.config.Path, "/")
 	if len(bucketPath) < 2 {
 		return nil, errors.Errorf("Unable to parse S3 path: %s. Must be BUCKET_NAME/PREFIX", s.config.Path)
@@ -57,7 +58,7 @@ func (s *S3Enumerator) Enumerate() ([]string, error) {
 		Bucket: &bucket,
 		Prefix: &prefix,
 	}
-	err := s.client.ListObjectsV2Pages(input, func(output *s3.ListObjectsV2Output, lastPage bool) bool {
+	err := s.client.ListObjectsV2PagesWithContext(ctx, input, func(output *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, metadata := range output.Contents {
 			if aws.Int64Value(metadata.Size) > 0 {
 				key := *metadata.Key
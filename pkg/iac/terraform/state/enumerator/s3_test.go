@@ -1,6 +1,7 @@
 package enumerator
 
 import (
+	"context"
 	"errors"
 	"os"
 	"reflect"
@@ -78,7 +79,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested/prefix"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -131,7 +133,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested/prefix/state2"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -183,7 +186,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String(""),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -242,7 +246,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -285,7 +290,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested/prefix"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -342,7 +348,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested/prefix"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -390,7 +397,7 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 				Path: "bucket-name",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListObjectsV2Pages", mock.Anything, mock.Anything).Return(errors.New("error when listing"))
+				client.On("ListObjectsV2PagesWithContext", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error when listing"))
 			},
 			want: nil,
 			err:  "Unable to parse S3 path: bucket-name. Must be BUCKET_NAME/PREFIX",
@@ -399,7 +406,7 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 			name:   "test when empty config used",
 			config: config.SupplierConfig{},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListObjectsV2Pages", mock.Anything, mock.Anything).Return(errors.New("error when listing"))
+				client.On("ListObjectsV2PagesWithContext", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error when listing"))
 			},
 			want: nil,
 			err:  "Unable to parse S3 path: . Must be BUCKET_NAME/PREFIX",
@@ -410,7 +417,7 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 				Path: "bucket-name/a/nested/prefix",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListObjectsV2Pages", mock.Anything, mock.Anything).Return(errors.New("error when listing"))
+				client.On("ListObjectsV2PagesWithContext", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error when listing"))
 			},
 			want: nil,
 			err:  "error when listing",
@@ -426,7 +433,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested/prefix"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -455,7 +463,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested/prefix"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -484,7 +493,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested/prefix"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -513,7 +523,8 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 					Prefix: awssdk.String("a/nested"),
 				}
 				client.On(
-					"ListObjectsV2Pages",
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
 					input,
 					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
 						callback(&s3.ListObjectsV2Output{
@@ -530,6 +541,46 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 			},
 			want: []string{"bucket-name/a/nested/prefix/terraform.tfstate/terraform.tfstate"},
 		},
+		{
+			name: "test results with every workspace of a state",
+			config: config.SupplierConfig{
+				Path: "bucket-name/{network/terraform.tfstate,env:/*/network/terraform.tfstate}",
+			},
+			mocks: func(client *awstest.MockFakeS3) {
+				input := &s3.ListObjectsV2Input{
+					Bucket: awssdk.String("bucket-name"),
+					Prefix: awssdk.String(""),
+				}
+				client.On(
+					"ListObjectsV2PagesWithContext",
+					context.Background(),
+					input,
+					mock.MatchedBy(func(callback func(res *s3.ListObjectsV2Output, lastPage bool) bool) bool {
+						callback(&s3.ListObjectsV2Output{
+							Contents: []*s3.Object{
+								{
+									Key:  awssdk.String("network/terraform.tfstate"),
+									Size: awssdk.Int64(5),
+								},
+								{
+									Key:  awssdk.String("env:/prod/network/terraform.tfstate"),
+									Size: awssdk.Int64(5),
+								},
+								{
+									Key:  awssdk.String("env:/prod/app/terraform.tfstate"),
+									Size: awssdk.Int64(5),
+								},
+							},
+						}, true)
+						return true
+					}),
+				).Return(nil)
+			},
+			want: []string{
+				"bucket-name/network/terraform.tfstate",
+				"bucket-name/env:/prod/network/terraform.tfstate",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -539,7 +590,7 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 				config: tt.config,
 				client: &fakeS3,
 			}
-			got, err := s.Enumerate()
+			got, err := s.Enumerate(context.Background())
 			if err != nil && err.Error() != tt.err {
 				t.Fatalf("Expected error '%s', got '%s'", tt.err, err.Error())
 				return
@@ -1,6 +1,8 @@
 package enumerator
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
@@ -8,7 +10,7 @@ import (
 
 type StateEnumerator interface {
 	Origin() string
-	Enumerate() ([]string, error)
+	Enumerate(ctx context.Context) ([]string, error)
 }
 
 func GetEnumerator(config config.SupplierConfig, opts *backend.Options) (StateEnumerator, error) {
@@ -19,9 +21,25 @@ func GetEnumerator(config config.SupplierConfig, opts *backend.Options) (StateEn
 	case backend.BackendKeyS3:
 		return NewS3Enumerator(config), nil
 	case backend.BackendKeyGS:
-		return NewGSEnumerator(config)
+		return NewGSEnumerator(config), nil
 	case backend.BackendKeyAzureRM:
 		return NewAzureRMEnumerator(config, opts.AzureRMBackendOptions)
+	case backend.BackendKeyConsul:
+		if HasMeta(config.Path) {
+			return NewConsulEnumerator(config), nil
+		}
+	case backend.BackendKeyPostgres:
+		if HasMeta(config.Path) {
+			return NewPGEnumerator(config), nil
+		}
+	case backend.BackendKeyKubernetes:
+		if HasMeta(config.Path) {
+			return NewKubernetesEnumerator(config), nil
+		}
+	case backend.BackendKeyTFCloud:
+		if IsTFCloudOrganizationPath(config.Path) {
+			return NewTFCloudEnumerator(config, opts), nil
+		}
 	}
 
 	logrus.WithFields(logrus.Fields{
@@ -0,0 +1,144 @@
+package enumerator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+)
+
+const tfcloudPageSize = 100
+
+// TFCloudEnumerator lists the workspaces of a Terraform Cloud organization. The path is either an
+// organization, or an organization followed by a glob on workspace names (e.g. my-org/prod-*).
+type TFCloudEnumerator struct {
+	config config.SupplierConfig
+	opts   *backend.Options
+	client *tfe.Client
+}
+
+func NewTFCloudEnumerator(config config.SupplierConfig, opts *backend.Options) *TFCloudEnumerator {
+	return &TFCloudEnumerator{config: config, opts: opts}
+}
+
+// IsTFCloudOrganizationPath tells whether a tfcloud path designates several workspaces rather than
+// a workspace ID or an {org}/{workspaceName}
+func IsTFCloudOrganizationPath(path string) bool {
+	parts := strings.Split(path, "/")
+	switch len(parts) {
+	case 1:
+		return parts[0] != "" && !strings.HasPrefix(parts[0], "ws-")
+	case 2:
+		return HasMeta(parts[1])
+	}
+	return false
+}
+
+func (e *TFCloudEnumerator) Origin() string {
+	return e.config.String()
+}
+
+func (e *TFCloudEnumerator) Enumerate(ctx context.Context) ([]string, error) {
+	organization, pattern := e.config.Path, "*"
+	if i := strings.Index(e.config.Path, "/"); i != -1 {
+		organization, pattern = e.config.Path[:i], e.config.Path[i+1:]
+	}
+
+	if e.client == nil {
+		var httpClient *http.Client
+		if e.opts.TFCloudProject != "" {
+			httpClient = &http.Client{Transport: &projectFilterTransport{project: e.opts.TFCloudProject, base: http.DefaultTransport}}
+		}
+		client, err := backend.NewTFCloudClient(e.opts, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		e.client = client
+	}
+
+	options := tfe.WorkspaceListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: tfcloudPageSize},
+	}
+	// The name search matches anywhere in the name, the glob is still matched below
+	if i := strings.IndexAny(pattern, `?*[]`); i > 0 {
+		prefix := pattern[:i]
+		options.Search = &prefix
+	}
+	if len(e.opts.TFCloudWorkspaceTags) > 0 {
+		tags := strings.Join(e.opts.TFCloudWorkspaceTags, ",")
+		options.Tags = &tags
+	}
+
+	workspaces := make([]string, 0)
+	for {
+		list, err := e.client.Workspaces.List(ctx, organization, options)
+		if err != nil {
+			return nil, errors.Errorf("unable to list terraform cloud workspaces: %s", err.Error())
+		}
+		for _, workspace := range list.Items {
+			if match, _ := doublestar.Match(pattern, workspace.Name); !match {
+				continue
+			}
+			if !hasTags(workspace, e.opts.TFCloudWorkspaceTags) {
+				continue
+			}
+			// The reader would otherwise look the workspace up by name to get its ID
+			workspaces = append(workspaces, workspace.ID)
+		}
+		if list.Pagination == nil || list.Pagination.NextPage == 0 {
+			break
+		}
+		options.PageNumber = list.Pagination.NextPage
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"organization": organization,
+		"count":        len(workspaces),
+	}).Debug("Listed terraform cloud workspaces")
+
+	if len(workspaces) == 0 {
+		return workspaces, fmt.Errorf("no Terraform Cloud workspace was found in %s, exiting", e.config.Path)
+	}
+	return workspaces, nil
+}
+
+func hasTags(workspace *tfe.Workspace, tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, name := range workspace.TagNames {
+			if name == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// projectFilterTransport adds the project filter to workspace listings, which the API client
+// does not support yet
+type projectFilterTransport struct {
+	project string
+	base    http.RoundTripper
+}
+
+func (t *projectFilterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || !strings.HasSuffix(req.URL.Path, "/workspaces") {
+		return t.base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	query := req.URL.Query()
+	query.Set("filter[project][id]", t.project)
+	req.URL.RawQuery = query.Encode()
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,132 @@
+package enumerator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIsTFCloudOrganizationPath(t *testing.T) {
+	assert.True(t, IsTFCloudOrganizationPath("my-org"))
+	assert.True(t, IsTFCloudOrganizationPath("my-org/*"))
+	assert.True(t, IsTFCloudOrganizationPath("my-org/prod-*"))
+	assert.False(t, IsTFCloudOrganizationPath("ws-ABCDEFG12345678"))
+	assert.False(t, IsTFCloudOrganizationPath("my-org/my-workspace"))
+	assert.False(t, IsTFCloudOrganizationPath(""))
+}
+
+func TestTFCloudEnumerator_Enumerate(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		path     string
+		opts     *backend.Options
+		mocks    func(*mocks.Workspaces)
+		expected []string
+		err      string
+	}{
+		{
+			name: "list every workspace of an organization across pages",
+			path: "my-org",
+			opts: &backend.Options{},
+			mocks: func(workspaces *mocks.Workspaces) {
+				workspaces.On("List", mock.Anything, "my-org", tfe.WorkspaceListOptions{
+					ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+				}).Return(&tfe.WorkspaceList{
+					Items:      []*tfe.Workspace{{ID: "ws-1", Name: "network"}, {ID: "ws-2", Name: "prod-app"}},
+					Pagination: &tfe.Pagination{NextPage: 2},
+				}, nil)
+				workspaces.On("List", mock.Anything, "my-org", tfe.WorkspaceListOptions{
+					ListOptions: tfe.ListOptions{PageNumber: 2, PageSize: 100},
+				}).Return(&tfe.WorkspaceList{
+					Items:      []*tfe.Workspace{{ID: "ws-3", Name: "staging-app"}},
+					Pagination: &tfe.Pagination{},
+				}, nil)
+			},
+			expected: []string{"ws-1", "ws-2", "ws-3"},
+		},
+		{
+			name: "filter workspaces by name and tags",
+			path: "my-org/prod-*",
+			opts: &backend.Options{TFCloudWorkspaceTags: []string{"team-a", "aws"}},
+			mocks: func(workspaces *mocks.Workspaces) {
+				workspaces.On("List", mock.Anything, "my-org", tfe.WorkspaceListOptions{
+					ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+					Search:      str("prod-"),
+					Tags:        str("team-a,aws"),
+				}).Return(&tfe.WorkspaceList{
+					Items: []*tfe.Workspace{
+						{ID: "ws-2", Name: "prod-app", TagNames: []string{"aws", "team-a"}},
+						{ID: "ws-4", Name: "preprod-app", TagNames: []string{"aws", "team-a"}},
+						{ID: "ws-5", Name: "prod-db", TagNames: []string{"aws"}},
+					},
+				}, nil)
+			},
+			expected: []string{"ws-2"},
+		},
+		{
+			name: "no workspace found",
+			path: "my-org/prod-*",
+			opts: &backend.Options{},
+			mocks: func(workspaces *mocks.Workspaces) {
+				workspaces.On("List", mock.Anything, "my-org", mock.Anything).Return(&tfe.WorkspaceList{}, nil)
+			},
+			expected: []string{},
+			err:      "no Terraform Cloud workspace was found in my-org/prod-*, exiting",
+		},
+		{
+			name: "listing error",
+			path: "my-org",
+			opts: &backend.Options{},
+			mocks: func(workspaces *mocks.Workspaces) {
+				workspaces.On("List", mock.Anything, "my-org", mock.Anything).Return(nil, errors.New("unauthorized"))
+			},
+			err: "unable to list terraform cloud workspaces: unauthorized",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaces := &mocks.Workspaces{}
+			tt.mocks(workspaces)
+
+			e := NewTFCloudEnumerator(config.SupplierConfig{Key: "tfstate", Backend: "tfcloud", Path: tt.path}, tt.opts)
+			e.client = &tfe.Client{Workspaces: workspaces}
+
+			got, err := e.Enumerate(context.Background())
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+			workspaces.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectFilterTransport(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &projectFilterTransport{project: "prj-123", base: http.DefaultTransport}}
+	for _, path := range []string{"/api/v2/organizations/my-org/workspaces?page%5Bnumber%5D=1", "/api/v2/workspaces/ws-123/current-state-version"} {
+		res, err := client.Get(server.URL + path)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+
+	assert.Equal(t, []string{"filter%5Bproject%5D%5Bid%5D=prj-123&page%5Bnumber%5D=1", ""}, queries)
+}
@@ -0,0 +1,154 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/pkg/errors"
+)
+
+// jsonState is the machine readable rendering of a state printed by terraform show -json
+type jsonState struct {
+	FormatVersion    string           `json:"format_version"`
+	TerraformVersion string           `json:"terraform_version"`
+	Values           *jsonStateValues `json:"values"`
+}
+
+type jsonStateValues struct {
+	RootModule *jsonModule `json:"root_module"`
+}
+
+type jsonModule struct {
+	Address      string         `json:"address"`
+	Resources    []jsonResource `json:"resources"`
+	ChildModules []jsonModule   `json:"child_modules"`
+}
+
+type jsonResource struct {
+	Address       string          `json:"address"`
+	Mode          string          `json:"mode"`
+	Type          string          `json:"type"`
+	Name          string          `json:"name"`
+	Index         json.RawMessage `json:"index"`
+	ProviderName  string          `json:"provider_name"`
+	SchemaVersion uint64          `json:"schema_version"`
+	Values        json.RawMessage `json:"values"`
+	Tainted       bool            `json:"tainted"`
+	DeposedKey    string          `json:"deposed_key"`
+}
+
+// isJSONState tells a terraform show -json output apart from a raw state, the former has a
+// format_version. The output of a plan has one too, readJSONState rejects it.
+func isJSONState(payload []byte) bool {
+	var doc struct {
+		FormatVersion string `json:"format_version"`
+		Version       *int   `json:"version"`
+	}
+	if err := json.Unmarshal(payload, &doc); err != nil {
+		return false
+	}
+	return doc.FormatVersion != "" && doc.Version == nil
+}
+
+// readJSONState converts a terraform show -json output to a state, so resources are decoded
+// with the provider schemas like the ones of raw states
+func readJSONState(payload []byte) (*statefile.File, error) {
+	var doc jsonState
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, errors.Wrap(err, "unable to parse JSON state")
+	}
+
+	v, err := version.NewVersion(doc.TerraformVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid terraform_version %s", doc.TerraformVersion)
+	}
+	// Plans nest their values under planned_values and prior_state, they are not states
+	if doc.Values == nil || doc.Values.RootModule == nil {
+		return nil, errors.New("JSON state has no values.root_module, only the output of terraform show -json for a state is supported, not for a plan")
+	}
+
+	file := &statefile.File{TerraformVersion: v, State: states.NewState()}
+	if err := addJSONModule(file.State, *doc.Values.RootModule); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func addJSONModule(state *states.State, module jsonModule) error {
+	moduleAddr := addrs.RootModuleInstance
+	if module.Address != "" {
+		var diags tfdiags.Diagnostics
+		moduleAddr, diags = addrs.ParseModuleInstanceStr(module.Address)
+		if diags.HasErrors() {
+			return errors.Wrapf(diags.Err(), "invalid module address %s", module.Address)
+		}
+	}
+
+	for _, res := range module.Resources {
+		// Deposed objects are about to be destroyed, only current ones are managed
+		if res.DeposedKey != "" {
+			continue
+		}
+
+		mode := addrs.ManagedResourceMode
+		if res.Mode == "data" {
+			mode = addrs.DataResourceMode
+		}
+		key, err := jsonInstanceKey(res.Index)
+		if err != nil {
+			return errors.Wrapf(err, "invalid index of %s", res.Address)
+		}
+		provider, diags := addrs.ParseProviderSourceString(res.ProviderName)
+		if diags.HasErrors() {
+			return errors.Wrapf(diags.Err(), "invalid provider of %s", res.Address)
+		}
+
+		status := states.ObjectReady
+		if res.Tainted {
+			status = states.ObjectTainted
+		}
+		instance := addrs.Resource{Mode: mode, Type: res.Type, Name: res.Name}.Instance(key)
+		state.EnsureModule(moduleAddr).SetResourceInstanceCurrent(instance, &states.ResourceInstanceObjectSrc{
+			AttrsJSON:     res.Values,
+			SchemaVersion: res.SchemaVersion,
+			Status:        status,
+		}, addrs.AbsProviderConfig{Module: moduleAddr.Module(), Provider: provider})
+	}
+
+	for _, child := range module.ChildModules {
+		if err := addJSONModule(state, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func jsonInstanceKey(index json.RawMessage) (addrs.InstanceKey, error) {
+	if len(index) == 0 || string(index) == "null" {
+		return addrs.NoKey, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(index))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case string:
+		return addrs.StringKey(v), nil
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return nil, err
+		}
+		return addrs.IntKey(int(i)), nil
+	}
+	return nil, errors.Errorf("unsupported index %s", string(index))
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsJSONState(t *testing.T) {
+	show, err := os.ReadFile("testdata/json/show.json")
+	assert.NoError(t, err)
+	raw, err := os.ReadFile("testdata/v4/valid.tfstate")
+	assert.NoError(t, err)
+
+	assert.True(t, isJSONState(show))
+	assert.True(t, isJSONState([]byte(`{"format_version": "1.0", "terraform_version": "1.5.7"}`)))
+	assert.False(t, isJSONState(raw))
+	assert.False(t, isJSONState([]byte(`not json`)))
+}
+
+func TestReadStateJSON(t *testing.T) {
+	reader, err := os.Open("testdata/json/show.json")
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	state, err := readState("show.json", reader)
+	assert.NoError(t, err)
+
+	addresses := []string{}
+	for moduleName, module := range state.Modules {
+		for _, res := range module.Resources {
+			for key, instance := range res.Instances {
+				addr := res.Addr.Instance(key).String()
+				addresses = append(addresses, addr)
+				assert.Equal(t, "aws", res.ProviderConfig.Provider.Type, addr)
+				assert.Equal(t, moduleName, res.Addr.Module.String(), addr)
+				assert.NotEmpty(t, instance.Current.AttrsJSON, addr)
+			}
+		}
+	}
+	sort.Strings(addresses)
+	assert.Equal(t, []string{
+		"aws_s3_bucket.logs",
+		"data.aws_caller_identity.current",
+		"module.network.aws_vpc.main[0]",
+		`module.network.module.subnets["private"].aws_subnet.this["a"]`,
+	}, addresses)
+
+	vpc := state.Modules["module.network"].Resources["aws_vpc.main"].Instances
+	assert.JSONEq(t, `{"id": "vpc-123", "cidr_block": "10.0.0.0/16"}`, string(vpc[addrs.IntKey(0)].Current.AttrsJSON))
+	assert.Equal(t, uint64(1), vpc[addrs.IntKey(0)].Current.SchemaVersion)
+}
+
+func TestReadStateJSON_Invalid(t *testing.T) {
+	_, err := readJSONState([]byte(`{"format_version": "1.0"}`))
+	assert.EqualError(t, err, "invalid terraform_version : Malformed version: ")
+
+	plan := `{
+		"format_version": "1.2",
+		"terraform_version": "1.5.7",
+		"planned_values": {"root_module": {}},
+		"prior_state": {"format_version": "1.0", "terraform_version": "1.5.7", "values": {"root_module": {}}},
+		"resource_changes": []
+	}`
+	_, err = readJSONState([]byte(plan))
+	assert.EqualError(t, err, "JSON state has no values.root_module, only the output of terraform show -json for a state is supported, not for a plan")
+}
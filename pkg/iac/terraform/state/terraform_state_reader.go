@@ -1,13 +1,17 @@
 package state
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/terraform"
 
 	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/providers"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/states/statefile"
 	"github.com/pkg/errors"
@@ -22,6 +26,7 @@ import (
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/encryption"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/enumerator"
 	resdriftctl "github.com/snyk/driftctl/pkg/resource"
 )
@@ -73,12 +78,15 @@ func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary,
 	return &reader, nil
 }
 
-func (r *TerraformStateReader) retrieve() (map[string][]decodedRes, error) {
-	b, err := backend.GetBackend(r.config, r.backendOptions)
+func (r *TerraformStateReader) retrieve(ctx context.Context) (map[string][]decodedRes, error) {
+	b, err := backend.GetBackend(ctx, r.config, r.backendOptions)
 	if err != nil {
 		return nil, err
 	}
 	r.backend = b
+	if r.backendOptions != nil && r.backendOptions.Encryption != nil {
+		r.backend = encryption.NewReader(b, r.backendOptions.Encryption)
+	}
 
 	state, err := read(r.config.Path, r.backend)
 	defer r.backend.Close()
@@ -122,14 +130,42 @@ func (r *TerraformStateReader) retrieve() (map[string][]decodedRes, error) {
 			}
 			providerType := stateRes.ProviderConfig.Provider.Type
 			provider := r.library.Provider(providerType)
-			if provider == nil {
+			if provider == nil && !resdriftctl.IsAddedType(resType) {
 				logrus.WithFields(logrus.Fields{
 					"providerKey": providerType,
 				}).Debug("Unsupported provider found in state")
 				continue
 			}
-			schema := provider.Schema()[stateRes.Addr.Resource.Type]
+			var schema providers.Schema
+			if provider != nil {
+				schema = provider.Schema()[stateRes.Addr.Resource.Type]
+			}
 			for _, instance := range stateRes.Instances {
+				if schema.Block == nil && resdriftctl.IsAddedType(resType) {
+					// Types added by plugins may come from a provider driftctl does not run,
+					// attributes are read as they are stored in state
+					decodedVal, err := r.convertInstance(instance.Current, cty.DynamicPseudoType)
+					if err != nil {
+						logrus.WithFields(logrus.Fields{
+							"name": resName,
+							"type": resType,
+						}).Error("Unable to decode resource from state")
+						return nil, err
+					}
+					if ty := decodedVal.Value.Type(); !ty.IsObjectType() || !ty.HasAttribute("id") || ty.AttributeType("id") != cty.String {
+						logrus.WithFields(logrus.Fields{
+							"name": resName,
+							"type": resType,
+						}).Warn("Ignored resource from state since it has no id attribute")
+						continue
+					}
+					resMap[resType] = append(resMap[resType], decodedRes{
+						source: resource.NewTerraformStateSource(r.config.String(), moduleName, resName),
+						val:    decodedVal.Value,
+					})
+					continue
+				}
+
 				decodedVal, err := instance.Current.Decode(schema.Block.ImpliedType())
 				if err != nil {
 					// Try to do a manual type conversion if we got a path error
@@ -222,19 +258,19 @@ func (r *TerraformStateReader) decode(valFromState map[string][]decodedRes) ([]*
 	return results, nil
 }
 
-func (r *TerraformStateReader) Resources() ([]*resource.Resource, error) {
+func (r *TerraformStateReader) Resources(ctx context.Context) ([]*resource.Resource, error) {
 	if r.enumerator == nil {
-		return r.retrieveForState(r.config.Path)
+		return r.retrieveForState(ctx, r.config.Path)
 	}
 
-	return r.retrieveMultiplesStates()
+	return r.retrieveMultiplesStates(ctx)
 }
 
 func (r *TerraformStateReader) SourceCount() uint {
 	return r.sourceCount
 }
 
-func (r *TerraformStateReader) retrieveForState(path string) ([]*resource.Resource, error) {
+func (r *TerraformStateReader) retrieveForState(ctx context.Context, path string) ([]*resource.Resource, error) {
 	r.config.Path = path
 	r.sourceCount += 1
 	logrus.WithFields(logrus.Fields{
@@ -242,7 +278,7 @@ func (r *TerraformStateReader) retrieveForState(path string) ([]*resource.Resour
 		"backend": r.config.Backend,
 	}).Debug("Reading resources from state")
 	r.progress.Inc()
-	values, err := r.retrieve()
+	values, err := r.retrieve(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, r.config.String())
 	}
@@ -250,8 +286,8 @@ func (r *TerraformStateReader) retrieveForState(path string) ([]*resource.Resour
 	return decode, errors.Wrap(err, r.config.String())
 }
 
-func (r *TerraformStateReader) retrieveMultiplesStates() ([]*resource.Resource, error) {
-	keys, err := r.enumerator.Enumerate()
+func (r *TerraformStateReader) retrieveMultiplesStates(ctx context.Context) ([]*resource.Resource, error) {
+	keys, err := r.enumerator.Enumerate(ctx)
 	if err != nil {
 		r.alerter.SendAlert("", NewStateReadingAlert(r.enumerator.Origin(), err))
 		return nil, errors.Wrap(err, r.config.String())
@@ -266,7 +302,10 @@ func (r *TerraformStateReader) retrieveMultiplesStates() ([]*resource.Resource,
 	readingError := iac.NewStateReadingError()
 
 	for _, key := range keys {
-		resources, err := r.retrieveForState(key)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resources, err := r.retrieveForState(ctx, key)
 		if err != nil {
 			readingError.Add(err)
 			r.alerter.SendAlert("", NewStateReadingAlert(key, err))
@@ -296,7 +335,17 @@ func read(path string, reader backend.Backend) (*states.State, error) {
 }
 
 func readState(path string, reader backend.Backend) (*states.State, error) {
-	state, err := statefile.Read(reader)
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var state *statefile.File
+	if isJSONState(payload) {
+		state, err = readJSONState(payload)
+	} else {
+		state, err = statefile.Read(bytes.NewReader(payload))
+	}
 	if err != nil {
 		return nil, err
 	}
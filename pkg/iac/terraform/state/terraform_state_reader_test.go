@@ -1,12 +1,18 @@
 package state
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
 	"github.com/snyk/driftctl/enumeration/remote/github"
@@ -21,6 +27,7 @@ import (
 	resourceazure "github.com/snyk/driftctl/pkg/resource/azurerm"
 	resourcegithub "github.com/snyk/driftctl/pkg/resource/github"
 	resourcegoogle "github.com/snyk/driftctl/pkg/resource/google"
+	"github.com/snyk/driftctl/pkg/resource/schemas"
 	testresource "github.com/snyk/driftctl/test/resource"
 
 	terraform2 "github.com/snyk/driftctl/test/terraform"
@@ -78,7 +85,7 @@ func TestTerraformStateReader_Source(t *testing.T) {
 		deserializer: resource.NewDeserializer(factory),
 	}
 
-	got, err := r.Resources()
+	got, err := r.Resources(context.Background())
 	assert.Nil(t, err)
 	assert.Len(t, got, 2)
 	for _, res := range got {
@@ -255,7 +262,7 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 				deserializer: resource.NewDeserializer(factory),
 			}
 
-			got, err := r.Resources()
+			got, err := r.Resources(context.Background())
 			resGoldenName := goldenfile.ResultsFilename
 			if shouldUpdate {
 				unm, err := json.Marshal(got)
@@ -342,7 +349,7 @@ func TestTerraformStateReader_Github_Resources(t *testing.T) {
 				deserializer: resource.NewDeserializer(factory),
 			}
 
-			got, err := r.Resources()
+			got, err := r.Resources(context.Background())
 			resGoldenName := goldenfile.ResultsFilename
 			if shouldUpdate {
 				unm, err := json.Marshal(got)
@@ -448,7 +455,7 @@ func TestTerraformStateReader_Google_Resources(t *testing.T) {
 				deserializer: resource.NewDeserializer(factory),
 			}
 
-			got, err := r.Resources()
+			got, err := r.Resources(context.Background())
 			resGoldenName := goldenfile.ResultsFilename
 			if shouldUpdate {
 				unm, err := json.Marshal(got)
@@ -542,7 +549,7 @@ func TestTerraformStateReader_Azure_Resources(t *testing.T) {
 				deserializer: resource.NewDeserializer(factory),
 			}
 
-			got, err := r.Resources()
+			got, err := r.Resources(context.Background())
 			resGoldenName := goldenfile.ResultsFilename
 			if shouldUpdate {
 				unm, err := json.Marshal(got)
@@ -575,6 +582,99 @@ func TestTerraformStateReader_Azure_Resources(t *testing.T) {
 	}
 }
 
+func TestTerraformStateReader_JSONState(t *testing.T) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Return().Times(1)
+
+	attribute := func(ty cty.Type) *configschema.Attribute {
+		return &configschema.Attribute{Type: ty, Optional: true}
+	}
+	provider := &terraform.MockTerraformProvider{}
+	provider.On("Schema").Return(map[string]providers.Schema{
+		"aws_s3_bucket": {Block: &configschema.Block{Attributes: map[string]*configschema.Attribute{
+			"id":     attribute(cty.String),
+			"bucket": attribute(cty.String),
+		}}},
+		"aws_vpc": {Block: &configschema.Block{Attributes: map[string]*configschema.Attribute{
+			"id":                 attribute(cty.String),
+			"cidr_block":         attribute(cty.String),
+			"enable_dns_support": attribute(cty.Bool),
+		}}},
+		"aws_subnet": {Block: &configschema.Block{Attributes: map[string]*configschema.Attribute{
+			"id": attribute(cty.String),
+		}}},
+	})
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.AWS, provider)
+
+	statePath := path.Join("testdata", "json", "show.json")
+	r := &TerraformStateReader{
+		config: config.SupplierConfig{
+			Path: statePath,
+		},
+		library:      library,
+		progress:     progress,
+		deserializer: resource.NewDeserializer(dctlresource.NewDriftctlResourceFactory(schemas.NewSchemaRepository())),
+	}
+
+	got, err := r.Resources(context.Background())
+	assert.NoError(t, err)
+	sort.Slice(got, func(i, j int) bool {
+		return got[i].ResourceId() < got[j].ResourceId()
+	})
+	assert.Equal(t, []*resource.Resource{
+		{
+			Id:     "my-logs",
+			Type:   "aws_s3_bucket",
+			Attrs:  &resource.Attributes{"id": "my-logs", "bucket": "my-logs"},
+			Source: resource.NewTerraformStateSource(statePath, "", "logs"),
+		},
+		{
+			Id:     "subnet-a",
+			Type:   "aws_subnet",
+			Attrs:  &resource.Attributes{"id": "subnet-a"},
+			Source: resource.NewTerraformStateSource(statePath, `module.network.module.subnets["private"]`, "this"),
+		},
+		{
+			Id:     "vpc-123",
+			Type:   "aws_vpc",
+			Attrs:  &resource.Attributes{"id": "vpc-123", "cidr_block": "10.0.0.0/16"},
+			Source: resource.NewTerraformStateSource(statePath, "module.network", "main"),
+		},
+	}, got)
+}
+
+func TestTerraformStateReader_PluginType(t *testing.T) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Return().Times(1)
+
+	dctlresource.AddSupportedType("acme_widget")
+
+	r := &TerraformStateReader{
+		config: config.SupplierConfig{
+			Path: path.Join("testdata", "plugin", "terraform.tfstate"),
+		},
+		library:      terraform.NewProviderLibrary(),
+		progress:     progress,
+		deserializer: resource.NewDeserializer(dctlresource.NewDriftctlResourceFactory(schemas.NewSchemaRepository())),
+	}
+
+	got, err := r.Resources(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.Resource{
+		{
+			Id:   "widget-1",
+			Type: "acme_widget",
+			Attrs: &resource.Attributes{
+				"id":   "widget-1",
+				"name": "main",
+				"tags": map[string]interface{}{"env": "prod"},
+			},
+			Source: &resource.TerraformStateSource{State: "testdata/plugin/terraform.tfstate", Module: "", Name: "main"},
+		},
+	}, got)
+}
+
 func convert(got []*resource.Resource) []interface{} {
 	unm, err := json.Marshal(got)
 	if err != nil {
@@ -646,7 +746,7 @@ func TestTerraformStateReader_WithIgnoredResource(t *testing.T) {
 		filter:   filter,
 	}
 
-	got, err := r.Resources()
+	got, err := r.Resources(context.Background())
 	filter.AssertExpectations(t)
 	assert.Nil(t, err)
 	assert.Len(t, got, 0)
@@ -0,0 +1,65 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+// Terraform manages the default node pool of an AKS cluster through the default_node_pool block of
+// azurerm_kubernetes_cluster, Azure lists it like any other pool though.
+// This middleware removes from remote resources the node pool named after the default_node_pool of
+// the cluster in state, so it is not reported as an unmanaged azurerm_kubernetes_cluster_node_pool.
+type AzurermKubernetesDefaultNodePool struct{}
+
+func NewAzurermKubernetesDefaultNodePool() AzurermKubernetesDefaultNodePool {
+	return AzurermKubernetesDefaultNodePool{}
+}
+
+func (m AzurermKubernetesDefaultNodePool) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	defaultNodePools := make(map[string]struct{})
+	for _, stateResource := range *resourcesFromState {
+		if stateResource.ResourceType() != azurerm.AzureKubernetesClusterResourceType {
+			continue
+		}
+		pools, ok := stateResource.Attributes().Get("default_node_pool")
+		if !ok {
+			continue
+		}
+		poolList, ok := pools.([]interface{})
+		if !ok || len(poolList) == 0 {
+			continue
+		}
+		pool, ok := poolList[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := pool["name"].(string); ok && name != "" {
+			defaultNodePools[strings.ToLower(stateResource.ResourceId()+"/agentPools/"+name)] = struct{}{}
+		}
+	}
+
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+	for _, remoteResource := range *remoteResources {
+		if remoteResource.ResourceType() != azurerm.AzureKubernetesClusterNodePoolResourceType {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		if _, isDefault := defaultNodePools[strings.ToLower(remoteResource.ResourceId())]; !isDefault {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring node pool as it is the default node pool of a cluster managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+func TestAzurermKubernetesDefaultNodePool_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			name: "test that the default node pool of a managed cluster is ignored",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster/agentPools/system",
+					Type:  azurerm.AzureKubernetesClusterNodePoolResourceType,
+					Attrs: &resource.Attributes{"name": "system"},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster/agentPools/default",
+					Type:  azurerm.AzureKubernetesClusterNodePoolResourceType,
+					Attrs: &resource.Attributes{"name": "default"},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/other/agentPools/default",
+					Type:  azurerm.AzureKubernetesClusterNodePoolResourceType,
+					Attrs: &resource.Attributes{"name": "default"},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster",
+					Type: azurerm.AzureKubernetesClusterResourceType,
+					Attrs: &resource.Attributes{
+						"name": "cluster",
+						"default_node_pool": []interface{}{
+							map[string]interface{}{"name": "default", "mode": "System"},
+						},
+					},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster/agentPools/system",
+					Type:  azurerm.AzureKubernetesClusterNodePoolResourceType,
+					Attrs: &resource.Attributes{"name": "system"},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/other/agentPools/default",
+					Type:  azurerm.AzureKubernetesClusterNodePoolResourceType,
+					Attrs: &resource.Attributes{"name": "default"},
+				},
+			},
+		},
+		{
+			name: "test that node pools are kept when the cluster has no default node pool in state",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster/agentPools/default",
+					Type:  azurerm.AzureKubernetesClusterNodePoolResourceType,
+					Attrs: &resource.Attributes{"name": "default"},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster",
+					Type:  azurerm.AzureKubernetesClusterResourceType,
+					Attrs: &resource.Attributes{"name": "cluster"},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster/agentPools/default",
+					Type:  azurerm.AzureKubernetesClusterNodePoolResourceType,
+					Attrs: &resource.Attributes{"name": "default"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAzurermKubernetesDefaultNodePool()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+// AKS creates a node resource group (named MC_<group>_<cluster>_<location> by default) that holds the
+// cluster VMs, disks, load balancers and so on. Everything in there is managed by AKS itself, so this
+// middleware ignores the group and its content unless they are managed by IaC.
+type AzurermKubernetesNodeResourceGroup struct{}
+
+func NewAzurermKubernetesNodeResourceGroup() AzurermKubernetesNodeResourceGroup {
+	return AzurermKubernetesNodeResourceGroup{}
+}
+
+func (m AzurermKubernetesNodeResourceGroup) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	// Node resource groups can be renamed at cluster creation, collect them from clusters first
+	nodeResourceGroups := make(map[string]struct{})
+	for _, remoteResource := range *remoteResources {
+		if remoteResource.ResourceType() != azurerm.AzureKubernetesClusterResourceType {
+			continue
+		}
+		if group := remoteResource.Attributes().GetString("node_resource_group"); group != nil && *group != "" {
+			nodeResourceGroups[strings.ToLower(*group)] = struct{}{}
+		}
+	}
+
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+	for _, remoteResource := range *remoteResources {
+		group := azurermResourceGroupName(remoteResource.ResourceId())
+		_, isNodeResourceGroup := nodeResourceGroups[strings.ToLower(group)]
+		if !isNodeResourceGroup && !strings.HasPrefix(strings.ToUpper(group), "MC_") {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring resource from AKS node resource group as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+// azurermResourceGroupName extracts the resource group name from an Azure resource ID,
+// e.g. /subscriptions/xxx/resourceGroups/my-group/providers/... returns my-group.
+func azurermResourceGroupName(id string) string {
+	parts := strings.Split(id, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if strings.EqualFold(parts[i], "resourceGroups") {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
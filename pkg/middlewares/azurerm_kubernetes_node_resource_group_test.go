@@ -0,0 +1,122 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/azurerm"
+)
+
+func TestAzurermKubernetesNodeResourceGroup_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			name: "test that node resource group content is ignored",
+			remoteResources: []*resource.Resource{
+				{
+					Id:   "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster",
+					Type: azurerm.AzureKubernetesClusterResourceType,
+					Attrs: &resource.Attributes{
+						"name":                "cluster",
+						"node_resource_group": "custom-nodes",
+					},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks",
+					Type:  azurerm.AzureResourceGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/MC_aks_other_westeurope",
+					Type:  azurerm.AzureResourceGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/mc_aks_other_westeurope/providers/Microsoft.Network/publicIPAddresses/ip",
+					Type:  azurerm.AzurePublicIPResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/custom-nodes/providers/Microsoft.Network/loadBalancers/kubernetes",
+					Type:  azurerm.AzureLoadBalancerResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.Network/publicIPAddresses/ip",
+					Type:  azurerm.AzurePublicIPResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			resourcesFromState: []*resource.Resource{},
+			expected: []*resource.Resource{
+				{
+					Id:   "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster",
+					Type: azurerm.AzureKubernetesClusterResourceType,
+					Attrs: &resource.Attributes{
+						"name":                "cluster",
+						"node_resource_group": "custom-nodes",
+					},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks",
+					Type:  azurerm.AzureResourceGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/aks/providers/Microsoft.Network/publicIPAddresses/ip",
+					Type:  azurerm.AzurePublicIPResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+		{
+			name: "test that managed node resource group content is kept",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/MC_aks_cluster_westeurope/providers/Microsoft.Network/publicIPAddresses/ip",
+					Type:  azurerm.AzurePublicIPResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/MC_aks_cluster_westeurope/providers/Microsoft.Network/publicIPAddresses/ip",
+					Type:  azurerm.AzurePublicIPResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:    "/subscriptions/xxx/resourceGroups/MC_aks_cluster_westeurope/providers/Microsoft.Network/publicIPAddresses/ip",
+					Type:  azurerm.AzurePublicIPResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAzurermKubernetesNodeResourceGroup()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package middlewares
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -14,14 +16,111 @@ func NewChain(middlewares ...Middleware) Chain {
 }
 
 func (c Chain) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	return c.ExecuteContext(context.Background(), remoteResources, resourcesFromState)
+}
+
+// ExecuteContext runs the middlewares in order, the ones calling out of driftctl are given ctx
+func (c Chain) ExecuteContext(ctx context.Context, remoteResources, resourcesFromState *[]*resource.Resource) error {
 	for _, middleware := range c {
 		logrus.WithFields(logrus.Fields{
-			"middleware": fmt.Sprintf("%T", middleware),
+			"middleware": Name(middleware),
 		}).Debug("Starting middleware")
-		err := middleware.Execute(remoteResources, resourcesFromState)
+		err := execute(ctx, middleware, remoteResources, resourcesFromState)
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// Changes counts the resources a middleware added, removed or changed the attributes of,
+// resources are told apart by identity so that a resource replaced by a copy counts as
+// removed and added
+type Changes struct {
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+	Changed int `json:"changed"`
+}
+
+func (c Changes) IsEmpty() bool {
+	return c.Added == 0 && c.Removed == 0 && c.Changed == 0
+}
+
+type Trace struct {
+	Middleware string        `json:"middleware"`
+	Duration   time.Duration `json:"duration"`
+	Remote     Changes       `json:"remote"`
+	State      Changes       `json:"state"`
+}
+
+// ExecuteWithTrace runs the chain like ExecuteContext and records what each middleware did, it is slower
+// since resources are fingerprinted before and after every middleware
+func (c Chain) ExecuteWithTrace(ctx context.Context, remoteResources, resourcesFromState *[]*resource.Resource) ([]Trace, error) {
+	traces := make([]Trace, 0, len(c))
+	remote, state := fingerprint(*remoteResources), fingerprint(*resourcesFromState)
+	for _, middleware := range c {
+		name := Name(middleware)
+		logrus.WithFields(logrus.Fields{
+			"middleware": name,
+		}).Debug("Starting middleware")
+
+		start := time.Now()
+		err := execute(ctx, middleware, remoteResources, resourcesFromState)
+		if err != nil {
+			return traces, err
+		}
+		trace := Trace{Middleware: name, Duration: time.Since(start)}
+
+		newRemote, newState := fingerprint(*remoteResources), fingerprint(*resourcesFromState)
+		trace.Remote = diffFingerprints(remote, newRemote)
+		trace.State = diffFingerprints(state, newState)
+		remote, state = newRemote, newState
+
+		if !trace.Remote.IsEmpty() || !trace.State.IsEmpty() {
+			logrus.WithFields(logrus.Fields{
+				"middleware":     name,
+				"remote_added":   trace.Remote.Added,
+				"remote_removed": trace.Remote.Removed,
+				"remote_changed": trace.Remote.Changed,
+				"state_added":    trace.State.Added,
+				"state_removed":  trace.State.Removed,
+				"state_changed":  trace.State.Changed,
+			}).Debug("Middleware changed resources")
+		}
+		traces = append(traces, trace)
+	}
+	return traces, nil
+}
+
+func fingerprint(resources []*resource.Resource) map[*resource.Resource]string {
+	results := make(map[*resource.Resource]string, len(resources))
+	for _, res := range resources {
+		var attrs []byte
+		if res.Attrs != nil {
+			// Map keys are sorted by the encoder so equal attributes give the same fingerprint
+			attrs, _ = json.Marshal(res.Attrs)
+		}
+		results[res] = string(attrs)
+	}
+	return results
+}
+
+func diffFingerprints(before, after map[*resource.Resource]string) Changes {
+	changes := Changes{}
+	for res, attrs := range after {
+		previous, exist := before[res]
+		if !exist {
+			changes.Added++
+			continue
+		}
+		if previous != attrs {
+			changes.Changed++
+		}
+	}
+	for res := range before {
+		if _, exist := after[res]; !exist {
+			changes.Removed++
+		}
+	}
+	return changes
+}
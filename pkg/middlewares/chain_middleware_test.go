@@ -1,7 +1,9 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -83,3 +85,90 @@ func TestChainMiddlewareErrorShouldStopExecution(t *testing.T) {
 	}
 
 }
+
+type funcMiddleware struct {
+	name string
+	fn   func(remoteResources, resourcesFromState *[]*resource.Resource)
+}
+
+func (m funcMiddleware) Name() string {
+	return m.name
+}
+
+func (m funcMiddleware) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	m.fn(remoteResources, resourcesFromState)
+	return nil
+}
+
+func TestChainMiddleware_ExecuteWithTrace(t *testing.T) {
+	remoteResources := []*resource.Resource{
+		{Id: "default", Type: "aws_vpc", Attrs: &resource.Attributes{"is_default": true}},
+		{Id: "vpc", Type: "aws_vpc", Attrs: &resource.Attributes{"is_default": false}},
+	}
+	stateResources := []*resource.Resource{
+		{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"policy": "{}"}},
+	}
+
+	middleware := NewChain(
+		funcMiddleware{name: "drop", fn: func(remoteResources, _ *[]*resource.Resource) {
+			*remoteResources = (*remoteResources)[1:]
+		}},
+		funcMiddleware{name: "expand", fn: func(_, resourcesFromState *[]*resource.Resource) {
+			bucket := (*resourcesFromState)[0]
+			bucket.Attrs.SafeDelete([]string{"policy"})
+			*resourcesFromState = append(*resourcesFromState, &resource.Resource{Id: "bucket", Type: "aws_s3_bucket_policy", Attrs: &resource.Attributes{"policy": "{}"}})
+		}},
+		FakeMiddleware{Name: "noop"},
+	)
+	callCounters = make(map[string]int)
+	trace, err := middleware.ExecuteWithTrace(context.Background(), &remoteResources, &stateResources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range trace {
+		trace[i].Duration = 0
+	}
+	expected := []Trace{
+		{Middleware: "drop", Remote: Changes{Removed: 1}},
+		{Middleware: "expand", State: Changes{Added: 1, Changed: 1}},
+		{Middleware: "FakeMiddleware"},
+	}
+	if !reflect.DeepEqual(expected, trace) {
+		t.Errorf("Expected %+v, got %+v", expected, trace)
+	}
+}
+
+func TestChainMiddleware_ExecuteWithTrace_Duplicates(t *testing.T) {
+	// Both resources share a type and an id, as when several states manage the same resource
+	remoteResources := []*resource.Resource{}
+	stateResources := []*resource.Resource{
+		{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"acl": "private"}},
+		{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"acl": "private"}},
+	}
+
+	middleware := NewChain(
+		funcMiddleware{name: "dedup", fn: func(_, resourcesFromState *[]*resource.Resource) {
+			*resourcesFromState = (*resourcesFromState)[:1]
+		}},
+		funcMiddleware{name: "copy", fn: func(_, resourcesFromState *[]*resource.Resource) {
+			bucket := *(*resourcesFromState)[0]
+			*resourcesFromState = []*resource.Resource{&bucket}
+		}},
+	)
+	trace, err := middleware.ExecuteWithTrace(context.Background(), &remoteResources, &stateResources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range trace {
+		trace[i].Duration = 0
+	}
+	expected := []Trace{
+		{Middleware: "dedup", State: Changes{Removed: 1}},
+		{Middleware: "copy", State: Changes{Added: 1, Removed: 1}},
+	}
+	if !reflect.DeepEqual(expected, trace) {
+		t.Errorf("Expected %+v, got %+v", expected, trace)
+	}
+}
@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+// Default service accounts are created by Google when enabling Compute Engine or App Engine,
+// this middleware will filter them unless they are managed.
+type GoogleDefaultServiceAccount struct{}
+
+func NewGoogleDefaultServiceAccount() *GoogleDefaultServiceAccount {
+	return &GoogleDefaultServiceAccount{}
+}
+
+func (m *GoogleDefaultServiceAccount) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+
+	newRemoteResources := make([]*resource.Resource, 0)
+
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than service accounts
+		if remoteResource.ResourceType() != google.GoogleServiceAccountResourceType {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Ignore all non default service accounts
+		if email := remoteResource.Attrs.GetString("email"); email == nil || !isGoogleDefaultServiceAccount(*email) {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Check if service account is managed by IaC
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		// Include resource if it's managed by IaC
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Else, resource is not added to newRemoteResources slice, so it will be ignored
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring default service account as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+// Compute Engine default account is <project-number>-compute@developer.gserviceaccount.com
+// and App Engine default account is <project-id>@appspot.gserviceaccount.com
+func isGoogleDefaultServiceAccount(email string) bool {
+	return strings.HasSuffix(email, "-compute@developer.gserviceaccount.com") ||
+		strings.HasSuffix(email, "@appspot.gserviceaccount.com")
+}
@@ -0,0 +1,113 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+func TestGoogleDefaultServiceAccount_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			name: "test that we ignore only default service accounts",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "fake",
+					Type:  google.GoogleStorageBucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "projects/project/serviceAccounts/test@project.iam.gserviceaccount.com",
+					Type: google.GoogleServiceAccountResourceType,
+					Attrs: &resource.Attributes{
+						"email": "test@project.iam.gserviceaccount.com",
+					},
+				},
+				{
+					Id:   "projects/project/serviceAccounts/123456789-compute@developer.gserviceaccount.com",
+					Type: google.GoogleServiceAccountResourceType,
+					Attrs: &resource.Attributes{
+						"email": "123456789-compute@developer.gserviceaccount.com",
+					},
+				},
+				{
+					Id:   "projects/project/serviceAccounts/project@appspot.gserviceaccount.com",
+					Type: google.GoogleServiceAccountResourceType,
+					Attrs: &resource.Attributes{
+						"email": "project@appspot.gserviceaccount.com",
+					},
+				},
+			},
+			resourcesFromState: []*resource.Resource{},
+			expected: []*resource.Resource{
+				{
+					Id:    "fake",
+					Type:  google.GoogleStorageBucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "projects/project/serviceAccounts/test@project.iam.gserviceaccount.com",
+					Type: google.GoogleServiceAccountResourceType,
+					Attrs: &resource.Attributes{
+						"email": "test@project.iam.gserviceaccount.com",
+					},
+				},
+			},
+		},
+		{
+			name: "test that we keep managed default service accounts",
+			remoteResources: []*resource.Resource{
+				{
+					Id:   "projects/project/serviceAccounts/project@appspot.gserviceaccount.com",
+					Type: google.GoogleServiceAccountResourceType,
+					Attrs: &resource.Attributes{
+						"email": "project@appspot.gserviceaccount.com",
+					},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "projects/project/serviceAccounts/project@appspot.gserviceaccount.com",
+					Type:  google.GoogleServiceAccountResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:   "projects/project/serviceAccounts/project@appspot.gserviceaccount.com",
+					Type: google.GoogleServiceAccountResourceType,
+					Attrs: &resource.Attributes{
+						"email": "project@appspot.gserviceaccount.com",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewGoogleDefaultServiceAccount()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
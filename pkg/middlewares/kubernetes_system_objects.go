@@ -0,0 +1,112 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/kubernetes"
+)
+
+var kubernetesSystemNamespaces = map[string]struct{}{
+	"kube-system":     {},
+	"kube-public":     {},
+	"kube-node-lease": {},
+}
+
+// Kubernetes creates a lot of objects by itself (system namespaces, default service accounts, RBAC defaults, ...)
+// and controllers own objects created from other ones (e.g. a deployment managed by an operator),
+// this middleware will filter them unless they are managed.
+type KubernetesSystemObjects struct{}
+
+func NewKubernetesSystemObjects() *KubernetesSystemObjects {
+	return &KubernetesSystemObjects{}
+}
+
+func (m *KubernetesSystemObjects) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+
+	newRemoteResources := make([]*resource.Resource, 0)
+
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than kubernetes ones
+		if !strings.HasPrefix(remoteResource.ResourceType(), "kubernetes_") {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Ignore all objects created by users
+		if !isKubernetesSystemObject(remoteResource) {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Check if object is managed by IaC
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		// Include resource if it's managed by IaC
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Else, resource is not added to newRemoteResources slice, so it will be ignored
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring kubernetes system object as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+func isKubernetesSystemObject(res *resource.Resource) bool {
+	if res.Attrs == nil {
+		return false
+	}
+
+	if _, exist := res.Attrs.Get("controller"); exist {
+		return true
+	}
+
+	namespace := ""
+	if ns := res.Attrs.GetString("namespace"); ns != nil {
+		namespace = *ns
+	}
+	if _, isSystem := kubernetesSystemNamespaces[namespace]; isSystem {
+		return true
+	}
+
+	name := ""
+	if n := res.Attrs.GetString("name"); n != nil {
+		name = *n
+	}
+
+	switch res.ResourceType() {
+	case kubernetes.KubernetesNamespaceResourceType:
+		_, isSystem := kubernetesSystemNamespaces[name]
+		return isSystem || name == "default"
+	case kubernetes.KubernetesClusterRoleResourceType, kubernetes.KubernetesClusterRoleBindingResourceType:
+		if strings.HasPrefix(name, "system:") {
+			return true
+		}
+		if labels := res.Attrs.GetMap("labels"); labels != nil {
+			return labels["kubernetes.io/bootstrapping"] == "rbac-defaults"
+		}
+	case kubernetes.KubernetesServiceAccountResourceType:
+		return name == "default"
+	case kubernetes.KubernetesConfigMapResourceType:
+		return name == "kube-root-ca.crt"
+	case kubernetes.KubernetesServiceResourceType:
+		return namespace == "default" && name == "kubernetes"
+	}
+
+	return false
+}
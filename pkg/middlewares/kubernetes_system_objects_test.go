@@ -0,0 +1,178 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/kubernetes"
+)
+
+func TestKubernetesSystemObjects_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			name: "test that we ignore only system objects",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "fake",
+					Type:  "github_repository",
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "app",
+					Type:  kubernetes.KubernetesNamespaceResourceType,
+					Attrs: &resource.Attributes{"name": "app"},
+				},
+				{
+					Id:    "kube-system",
+					Type:  kubernetes.KubernetesNamespaceResourceType,
+					Attrs: &resource.Attributes{"name": "kube-system"},
+				},
+				{
+					Id:    "default",
+					Type:  kubernetes.KubernetesNamespaceResourceType,
+					Attrs: &resource.Attributes{"name": "default"},
+				},
+				{
+					Id:    "kube-system/coredns",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{"name": "coredns", "namespace": "kube-system"},
+				},
+				{
+					Id:    "app/web",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{"name": "web", "namespace": "app"},
+				},
+				{
+					Id:   "app/operated",
+					Type: kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{
+						"name":       "operated",
+						"namespace":  "app",
+						"controller": "Operator/operator",
+					},
+				},
+				{
+					Id:    "default/kubernetes",
+					Type:  kubernetes.KubernetesServiceResourceType,
+					Attrs: &resource.Attributes{"name": "kubernetes", "namespace": "default"},
+				},
+				{
+					Id:    "app/kubernetes",
+					Type:  kubernetes.KubernetesServiceResourceType,
+					Attrs: &resource.Attributes{"name": "kubernetes", "namespace": "app"},
+				},
+				{
+					Id:    "app/default",
+					Type:  kubernetes.KubernetesServiceAccountResourceType,
+					Attrs: &resource.Attributes{"name": "default", "namespace": "app"},
+				},
+				{
+					Id:    "app/kube-root-ca.crt",
+					Type:  kubernetes.KubernetesConfigMapResourceType,
+					Attrs: &resource.Attributes{"name": "kube-root-ca.crt", "namespace": "app"},
+				},
+				{
+					Id:    "system:node",
+					Type:  kubernetes.KubernetesClusterRoleResourceType,
+					Attrs: &resource.Attributes{"name": "system:node"},
+				},
+				{
+					Id:   "cluster-admin",
+					Type: kubernetes.KubernetesClusterRoleBindingResourceType,
+					Attrs: &resource.Attributes{
+						"name":   "cluster-admin",
+						"labels": map[string]interface{}{"kubernetes.io/bootstrapping": "rbac-defaults"},
+					},
+				},
+				{
+					Id:    "reader",
+					Type:  kubernetes.KubernetesClusterRoleResourceType,
+					Attrs: &resource.Attributes{"name": "reader"},
+				},
+			},
+			resourcesFromState: []*resource.Resource{},
+			expected: []*resource.Resource{
+				{
+					Id:    "fake",
+					Type:  "github_repository",
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "app",
+					Type:  kubernetes.KubernetesNamespaceResourceType,
+					Attrs: &resource.Attributes{"name": "app"},
+				},
+				{
+					Id:    "app/web",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{"name": "web", "namespace": "app"},
+				},
+				{
+					Id:    "app/kubernetes",
+					Type:  kubernetes.KubernetesServiceResourceType,
+					Attrs: &resource.Attributes{"name": "kubernetes", "namespace": "app"},
+				},
+				{
+					Id:    "reader",
+					Type:  kubernetes.KubernetesClusterRoleResourceType,
+					Attrs: &resource.Attributes{"name": "reader"},
+				},
+			},
+		},
+		{
+			name: "test that we keep managed system objects",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "default",
+					Type:  kubernetes.KubernetesNamespaceResourceType,
+					Attrs: &resource.Attributes{"name": "default"},
+				},
+				{
+					Id:    "app/default",
+					Type:  kubernetes.KubernetesServiceAccountResourceType,
+					Attrs: &resource.Attributes{"name": "default", "namespace": "app"},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "app/default",
+					Type:  kubernetes.KubernetesServiceAccountResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:    "app/default",
+					Type:  kubernetes.KubernetesServiceAccountResourceType,
+					Attrs: &resource.Attributes{"name": "default", "namespace": "app"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewKubernetesSystemObjects()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
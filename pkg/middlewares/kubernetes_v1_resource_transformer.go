@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/kubernetes"
+)
+
+var kubernetesV1ResourceTypes = map[string]string{
+	kubernetes.KubernetesNamespaceV1ResourceType:          kubernetes.KubernetesNamespaceResourceType,
+	kubernetes.KubernetesDeploymentV1ResourceType:         kubernetes.KubernetesDeploymentResourceType,
+	kubernetes.KubernetesServiceV1ResourceType:            kubernetes.KubernetesServiceResourceType,
+	kubernetes.KubernetesConfigMapV1ResourceType:          kubernetes.KubernetesConfigMapResourceType,
+	kubernetes.KubernetesServiceAccountV1ResourceType:     kubernetes.KubernetesServiceAccountResourceType,
+	kubernetes.KubernetesRoleV1ResourceType:               kubernetes.KubernetesRoleResourceType,
+	kubernetes.KubernetesRoleBindingV1ResourceType:        kubernetes.KubernetesRoleBindingResourceType,
+	kubernetes.KubernetesClusterRoleV1ResourceType:        kubernetes.KubernetesClusterRoleResourceType,
+	kubernetes.KubernetesClusterRoleBindingV1ResourceType: kubernetes.KubernetesClusterRoleBindingResourceType,
+}
+
+// KubernetesV1ResourceTransformer turns kubernetes_*_v1 resources into their unversioned counterpart.
+// The terraform provider exposes both names for the same kubernetes objects, so like AwsALBTransformer
+// we use the unversioned type as the common one.
+type KubernetesV1ResourceTransformer struct {
+	resourceFactory resource.ResourceFactory
+}
+
+func NewKubernetesV1ResourceTransformer(resourceFactory resource.ResourceFactory) KubernetesV1ResourceTransformer {
+	return KubernetesV1ResourceTransformer{
+		resourceFactory: resourceFactory,
+	}
+}
+
+func (m KubernetesV1ResourceTransformer) Execute(_, resourcesFromState *[]*resource.Resource) error {
+	newStateResources := make([]*resource.Resource, 0, len(*resourcesFromState))
+
+	for _, res := range *resourcesFromState {
+		ty, isV1 := kubernetesV1ResourceTypes[res.ResourceType()]
+		if !isV1 {
+			newStateResources = append(newStateResources, res)
+			continue
+		}
+
+		newStateResources = append(newStateResources, m.resourceFactory.CreateAbstractResource(
+			ty,
+			res.ResourceId(),
+			*res.Attributes(),
+		))
+	}
+
+	*resourcesFromState = newStateResources
+	return nil
+}
@@ -0,0 +1,112 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/kubernetes"
+)
+
+func TestKubernetesV1ResourceTransformer_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		resourcesFromState []*resource.Resource
+		mocks              func(*dctlresource.MockResourceFactory)
+		expected           []*resource.Resource
+	}{
+		{
+			name:  "should not transform anything",
+			mocks: func(factory *dctlresource.MockResourceFactory) {},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "app/web",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:    "app/web",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+		{
+			name: "should transform v1 resources",
+			mocks: func(factory *dctlresource.MockResourceFactory) {
+				factory.On("CreateAbstractResource", kubernetes.KubernetesDeploymentResourceType, "app/api", map[string]interface{}{"id": "app/api"}).Return(&resource.Resource{
+					Id:    "app/api",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{"id": "app/api"},
+				})
+				factory.On("CreateAbstractResource", kubernetes.KubernetesClusterRoleResourceType, "reader", map[string]interface{}{}).Return(&resource.Resource{
+					Id:    "reader",
+					Type:  kubernetes.KubernetesClusterRoleResourceType,
+					Attrs: &resource.Attributes{},
+				})
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "app/web",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "app/api",
+					Type:  kubernetes.KubernetesDeploymentV1ResourceType,
+					Attrs: &resource.Attributes{"id": "app/api"},
+				},
+				{
+					Id:    "reader",
+					Type:  kubernetes.KubernetesClusterRoleV1ResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:    "app/web",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "app/api",
+					Type:  kubernetes.KubernetesDeploymentResourceType,
+					Attrs: &resource.Attributes{"id": "app/api"},
+				},
+				{
+					Id:    "reader",
+					Type:  kubernetes.KubernetesClusterRoleResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := &dctlresource.MockResourceFactory{}
+			if tt.mocks != nil {
+				tt.mocks(factory)
+			}
+
+			m := NewKubernetesV1ResourceTransformer(factory)
+			err := m.Execute(&[]*resource.Resource{}, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,43 @@
 package middlewares
 
-import "github.com/snyk/driftctl/enumeration/resource"
+import (
+	"context"
+	"reflect"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+)
 
 type Middleware interface {
 	Execute(remoteResources, resourcesFromState *[]*resource.Resource) error
 }
+
+// ContextMiddleware is implemented by middlewares that call out of driftctl, such as plugin ones,
+// a chain runs them with its context instead of calling Execute
+type ContextMiddleware interface {
+	ExecuteContext(ctx context.Context, remoteResources, resourcesFromState *[]*resource.Resource) error
+}
+
+// NamedMiddleware is implemented by middlewares that are not built in, such as plugin or rule ones
+type NamedMiddleware interface {
+	Name() string
+}
+
+// Name returns the name used to enable, disable or trace a middleware, built-in middlewares are
+// named after their type
+func Name(m Middleware) string {
+	if named, ok := m.(NamedMiddleware); ok {
+		return named.Name()
+	}
+	ty := reflect.TypeOf(m)
+	for ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+	return ty.Name()
+}
+
+func execute(ctx context.Context, m Middleware, remoteResources, resourcesFromState *[]*resource.Resource) error {
+	if withContext, ok := m.(ContextMiddleware); ok {
+		return withContext.ExecuteContext(ctx, remoteResources, resourcesFromState)
+	}
+	return m.Execute(remoteResources, resourcesFromState)
+}
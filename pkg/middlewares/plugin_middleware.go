@@ -0,0 +1,85 @@
+package middlewares
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/plugin"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// PluginMiddleware runs a middleware implemented by a plugin. Resources the plugin returns replace
+// the ones sent with the same type and id, keeping their source and schema, the others are created
+// with the resource factory.
+type PluginMiddleware struct {
+	plugin          plugin.Plugin
+	name            string
+	resourceFactory resource.ResourceFactory
+}
+
+func NewPluginMiddleware(p plugin.Plugin, name string, resourceFactory resource.ResourceFactory) PluginMiddleware {
+	return PluginMiddleware{plugin: p, name: name, resourceFactory: resourceFactory}
+}
+
+func (m PluginMiddleware) Name() string {
+	return m.name
+}
+
+func (m PluginMiddleware) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	return m.ExecuteContext(context.Background(), remoteResources, resourcesFromState)
+}
+
+// ExecuteContext runs the plugin middleware, the call to the plugin is canceled with ctx
+func (m PluginMiddleware) ExecuteContext(ctx context.Context, remoteResources, resourcesFromState *[]*resource.Resource) error {
+	remote, state, err := m.plugin.Execute(ctx, m.name, toPluginResources(*remoteResources), toPluginResources(*resourcesFromState))
+	if err != nil {
+		return errors.Wrapf(err, "plugin middleware %s failed", m.name)
+	}
+
+	*remoteResources = m.fromPluginResources(*remoteResources, remote)
+	*resourcesFromState = m.fromPluginResources(*resourcesFromState, state)
+	return nil
+}
+
+func toPluginResources(resources []*resource.Resource) []plugin.Resource {
+	results := make([]plugin.Resource, 0, len(resources))
+	for _, res := range resources {
+		var attributes map[string]interface{}
+		if res.Attrs != nil {
+			attributes = *res.Attrs
+		}
+		results = append(results, plugin.Resource{ID: res.ResourceId(), Type: res.ResourceType(), Attributes: attributes})
+	}
+	return results
+}
+
+func (m PluginMiddleware) fromPluginResources(previous []*resource.Resource, resources []plugin.Resource) []*resource.Resource {
+	byKey := make(map[string]*resource.Resource, len(previous))
+	for _, res := range previous {
+		byKey[res.ResourceType()+"."+res.ResourceId()] = res
+	}
+
+	results := make([]*resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		existing, found := byKey[res.Type+"."+res.ID]
+		if !found {
+			logrus.WithFields(logrus.Fields{
+				"middleware": m.name,
+				"type":       res.Type,
+				"id":         res.ID,
+			}).Debug("Plugin middleware created a resource")
+			results = append(results, m.resourceFactory.CreateAbstractResource(res.Type, res.ID, res.Attributes))
+			continue
+		}
+		attributes := resource.Attributes(res.Attributes)
+		results = append(results, &resource.Resource{
+			Id:     existing.Id,
+			Type:   existing.Type,
+			Attrs:  &attributes,
+			Sch:    existing.Sch,
+			Source: existing.Source,
+		})
+	}
+	return results
+}
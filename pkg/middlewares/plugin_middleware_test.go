@@ -0,0 +1,95 @@
+package middlewares
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/plugin"
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPluginMiddleware_Execute(t *testing.T) {
+	schema := &resource.Schema{}
+	source := resource.NewTerraformStateSource("tfstate://terraform.tfstate", "", "widget")
+
+	remoteResources := []*resource.Resource{
+		{Id: "widget-1", Type: "acme_widget", Attrs: &resource.Attributes{"name": "widget"}, Sch: schema},
+		{Id: "widget-default", Type: "acme_widget", Attrs: &resource.Attributes{}},
+	}
+	resourcesFromState := []*resource.Resource{
+		{Id: "widget-1", Type: "acme_widget", Attrs: &resource.Attributes{"name": "widget", "computed": "value"}, Sch: schema, Source: source},
+	}
+
+	p := &plugin.MockPlugin{}
+	p.On("Execute", mock.Anything, "acme_defaults",
+		[]plugin.Resource{
+			{ID: "widget-1", Type: "acme_widget", Attributes: map[string]interface{}{"name": "widget"}},
+			{ID: "widget-default", Type: "acme_widget", Attributes: map[string]interface{}{}},
+		},
+		[]plugin.Resource{
+			{ID: "widget-1", Type: "acme_widget", Attributes: map[string]interface{}{"name": "widget", "computed": "value"}},
+		},
+	).Return(
+		[]plugin.Resource{
+			{ID: "widget-1", Type: "acme_widget", Attributes: map[string]interface{}{"name": "widget"}},
+		},
+		[]plugin.Resource{
+			{ID: "widget-1", Type: "acme_widget", Attributes: map[string]interface{}{"name": "widget"}},
+			{ID: "widget-1-policy", Type: "acme_widget_policy", Attributes: map[string]interface{}{"widget": "widget-1"}},
+		},
+		nil,
+	)
+
+	factory := &dctlresource.MockResourceFactory{}
+	factory.On("CreateAbstractResource", "acme_widget_policy", "widget-1-policy", map[string]interface{}{"widget": "widget-1"}).Return(
+		&resource.Resource{Id: "widget-1-policy", Type: "acme_widget_policy", Attrs: &resource.Attributes{"widget": "widget-1"}},
+	)
+
+	m := NewPluginMiddleware(p, "acme_defaults", factory)
+	err := m.Execute(&remoteResources, &resourcesFromState)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*resource.Resource{
+		{Id: "widget-1", Type: "acme_widget", Attrs: &resource.Attributes{"name": "widget"}, Sch: schema},
+	}, remoteResources)
+	assert.Equal(t, []*resource.Resource{
+		{Id: "widget-1", Type: "acme_widget", Attrs: &resource.Attributes{"name": "widget"}, Sch: schema, Source: source},
+		{Id: "widget-1-policy", Type: "acme_widget_policy", Attrs: &resource.Attributes{"widget": "widget-1"}},
+	}, resourcesFromState)
+
+	p.AssertExpectations(t)
+	factory.AssertExpectations(t)
+}
+
+func TestPluginMiddleware_ExecuteError(t *testing.T) {
+	p := &plugin.MockPlugin{}
+	p.On("Execute", context.Background(), "acme_defaults", []plugin.Resource{}, []plugin.Resource{}).
+		Return(nil, nil, errors.New("connection refused"))
+
+	remoteResources := []*resource.Resource{}
+	resourcesFromState := []*resource.Resource{}
+	err := NewPluginMiddleware(p, "acme_defaults", &dctlresource.MockResourceFactory{}).Execute(&remoteResources, &resourcesFromState)
+	assert.EqualError(t, err, "plugin middleware acme_defaults failed: connection refused")
+}
+
+func TestPluginMiddleware_ExecuteContext(t *testing.T) {
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "scan"))
+	defer cancel()
+
+	p := &plugin.MockPlugin{}
+	p.On("Execute", ctx, "acme_defaults", []plugin.Resource{}, []plugin.Resource{}).
+		Return([]plugin.Resource{}, []plugin.Resource{}, nil)
+
+	remoteResources := []*resource.Resource{}
+	resourcesFromState := []*resource.Resource{}
+	chain := NewChain(NewChain(NewPluginMiddleware(p, "acme_defaults", &dctlresource.MockResourceFactory{})))
+	err := chain.ExecuteContext(ctx, &remoteResources, &resourcesFromState)
+	assert.NoError(t, err)
+
+	p.AssertExpectations(t)
+}
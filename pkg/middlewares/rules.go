@@ -0,0 +1,310 @@
+package middlewares
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+const (
+	RuleKindDrop       = "drop"
+	RuleKindRenameType = "rename_type"
+	RuleKindExpand     = "expand"
+)
+
+const (
+	RuleFromRemote = "remote"
+	RuleFromState  = "state"
+)
+
+// RulesFile is a list of declarative middlewares, see LoadRules
+type RulesFile struct {
+	// DryRun only logs what rules would touch
+	DryRun bool   `json:"dry_run"`
+	Rules  []Rule `json:"rules"`
+}
+
+type Rule struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// Type restricts the rule to a resource type, it is required for rename_type and expand
+	Type string `json:"type"`
+	// Where is a JMESPath predicate evaluated on {Type, Id, Attr} like --filter, the rule only
+	// touches the resources it is true for
+	Where string `json:"where"`
+	// From restricts the rule to remote or state resources, it applies to both when empty
+	From string `json:"from"`
+
+	// To is the new type of a rename_type rule
+	To string `json:"to"`
+
+	// Attribute holds an object or a list of objects to turn into children with an expand rule
+	Attribute string `json:"attribute"`
+	// ChildType is the type of the resources created by an expand rule
+	ChildType string `json:"child_type"`
+	// IDAttribute is the child attribute holding the child id, children otherwise get the parent id,
+	// suffixed with their index when the attribute is a list
+	IDAttribute string `json:"id_attribute"`
+	// ParentAttribute is a child attribute set to the parent id
+	ParentAttribute string `json:"parent_attribute"`
+}
+
+// LoadRules compiles a YAML (or JSON) rules file into middlewares, to run after the built-in ones.
+// Rules are enabled, disabled and traced by name, so a name must be unique and must not be one of
+// the existing middleware names.
+func LoadRules(path string, resourceFactory resource.ResourceFactory, existingNames []string) ([]Middleware, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read middleware rules")
+	}
+	file := RulesFile{}
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse middleware rules %s", path)
+	}
+
+	existing := make(map[string]struct{}, len(existingNames))
+	for _, name := range existingNames {
+		existing[name] = struct{}{}
+	}
+	names := make(map[string]struct{}, len(file.Rules))
+
+	results := make([]Middleware, 0, len(file.Rules))
+	for i, rule := range file.Rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule #%d", i+1)
+		}
+		if _, exist := existing[rule.Name]; exist {
+			return nil, errors.Errorf("invalid middleware rule %s: name is already used by another middleware", rule.Name)
+		}
+		if _, exist := names[rule.Name]; exist {
+			return nil, errors.Errorf("invalid middleware rule %s: name is used by several rules", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+		m, err := compileRule(rule, file.DryRun, resourceFactory)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid middleware rule %s", rule.Name)
+		}
+		results = append(results, m)
+	}
+	return results, nil
+}
+
+func compileRule(rule Rule, dryRun bool, resourceFactory resource.ResourceFactory) (*RuleMiddleware, error) {
+	m := &RuleMiddleware{rule: rule, dryRun: dryRun, resourceFactory: resourceFactory}
+
+	if rule.From != "" && rule.From != RuleFromRemote && rule.From != RuleFromState {
+		return nil, errors.Errorf("from must be %s or %s", RuleFromRemote, RuleFromState)
+	}
+	if rule.Where != "" {
+		where, err := jmespath.Compile(rule.Where)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to compile where")
+		}
+		m.where = where
+	}
+
+	switch rule.Kind {
+	case RuleKindDrop:
+		if rule.Type == "" && rule.Where == "" {
+			return nil, errors.New("a drop rule needs a type or a where predicate")
+		}
+	case RuleKindRenameType:
+		if rule.Type == "" || rule.To == "" {
+			return nil, errors.New("a rename_type rule needs a type and a to type")
+		}
+	case RuleKindExpand:
+		if rule.Type == "" || rule.Attribute == "" || rule.ChildType == "" {
+			return nil, errors.New("an expand rule needs a type, an attribute and a child_type")
+		}
+	default:
+		return nil, errors.Errorf("unknown kind '%s', must be one of %s, %s, %s", rule.Kind, RuleKindDrop, RuleKindRenameType, RuleKindExpand)
+	}
+	return m, nil
+}
+
+// RuleMiddleware runs a rule of a rules file
+type RuleMiddleware struct {
+	rule            Rule
+	dryRun          bool
+	where           *jmespath.JMESPath
+	resourceFactory resource.ResourceFactory
+}
+
+type ruleResource struct {
+	Attr     interface{}
+	Type, Id string
+}
+
+func (m *RuleMiddleware) Name() string {
+	return m.rule.Name
+}
+
+func (m *RuleMiddleware) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	if m.rule.From != RuleFromState {
+		if err := m.apply(RuleFromRemote, remoteResources); err != nil {
+			return err
+		}
+	}
+	if m.rule.From != RuleFromRemote {
+		if err := m.apply(RuleFromState, resourcesFromState); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *RuleMiddleware) apply(from string, resources *[]*resource.Resource) error {
+	existing := make(map[string]struct{}, len(*resources))
+	if m.rule.Kind == RuleKindExpand {
+		for _, res := range *resources {
+			existing[res.ResourceType()+"."+res.ResourceId()] = struct{}{}
+		}
+	}
+
+	touched := 0
+	newList := make([]*resource.Resource, 0, len(*resources))
+	for _, res := range *resources {
+		match, err := m.matches(res)
+		if err != nil {
+			return errors.Wrapf(err, "middleware rule %s", m.rule.Name)
+		}
+		if !match {
+			newList = append(newList, res)
+			continue
+		}
+
+		touched++
+		m.log(from, res)
+		if m.dryRun {
+			newList = append(newList, res)
+			continue
+		}
+
+		switch m.rule.Kind {
+		case RuleKindDrop:
+		case RuleKindRenameType:
+			var attrs map[string]interface{}
+			if res.Attrs != nil {
+				attrs = *res.Attrs
+			}
+			renamed := m.resourceFactory.CreateAbstractResource(m.rule.To, res.ResourceId(), attrs)
+			renamed.Source = res.Source
+			newList = append(newList, renamed)
+		case RuleKindExpand:
+			newList = append(newList, res)
+			newList = append(newList, m.expand(res, existing)...)
+		}
+	}
+
+	if touched > 0 {
+		logrus.WithFields(logrus.Fields{
+			"rule":    m.rule.Name,
+			"from":    from,
+			"count":   touched,
+			"dry_run": m.dryRun,
+		}).Debug("Middleware rule touched resources")
+	}
+	*resources = newList
+	return nil
+}
+
+func (m *RuleMiddleware) matches(res *resource.Resource) (bool, error) {
+	if m.rule.Type != "" && res.ResourceType() != m.rule.Type {
+		return false, nil
+	}
+	if m.where == nil {
+		return true, nil
+	}
+
+	var attrs map[string]interface{}
+	if res.Attrs != nil {
+		attrs = *res.Attrs
+	}
+	result, err := m.where.Search(ruleResource{Attr: attrs, Type: res.ResourceType(), Id: res.ResourceId()})
+	if err != nil {
+		return false, err
+	}
+	match, _ := result.(bool)
+	return match, nil
+}
+
+func (m *RuleMiddleware) expand(parent *resource.Resource, existing map[string]struct{}) []*resource.Resource {
+	if parent.Attrs == nil {
+		return nil
+	}
+	value, exist := parent.Attrs.Get(m.rule.Attribute)
+	if !exist || value == nil {
+		return nil
+	}
+
+	var items []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		items = v
+	case map[string]interface{}:
+		items = []interface{}{v}
+	default:
+		logrus.WithFields(logrus.Fields{
+			"rule":      m.rule.Name,
+			"attribute": m.rule.Attribute,
+			"id":        parent.ResourceId(),
+		}).Warn("Unable to expand an attribute that is not an object or a list of objects")
+		return nil
+	}
+	parent.Attrs.SafeDelete([]string{m.rule.Attribute})
+
+	children := make([]*resource.Resource, 0, len(items))
+	for i, item := range items {
+		attrs, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		data := make(map[string]interface{}, len(attrs)+1)
+		for k, v := range attrs {
+			data[k] = v
+		}
+		if m.rule.ParentAttribute != "" {
+			data[m.rule.ParentAttribute] = parent.ResourceId()
+		}
+
+		id := parent.ResourceId()
+		if _, isList := value.([]interface{}); isList {
+			id = fmt.Sprintf("%s-%d", parent.ResourceId(), i)
+		}
+		if m.rule.IDAttribute != "" {
+			childID, ok := attrs[m.rule.IDAttribute].(string)
+			if !ok || childID == "" {
+				continue
+			}
+			id = childID
+		}
+
+		// A dedicated resource wins over the embedded attribute
+		if _, exist := existing[m.rule.ChildType+"."+id]; exist {
+			continue
+		}
+		existing[m.rule.ChildType+"."+id] = struct{}{}
+		children = append(children, m.resourceFactory.CreateAbstractResource(m.rule.ChildType, id, data))
+	}
+	return children
+}
+
+func (m *RuleMiddleware) log(from string, res *resource.Resource) {
+	entry := logrus.WithFields(logrus.Fields{
+		"rule": m.rule.Name,
+		"kind": m.rule.Kind,
+		"from": from,
+		"type": res.ResourceType(),
+		"id":   res.ResourceId(),
+	})
+	if m.dryRun {
+		entry.Info("Middleware rule would touch resource (dry run)")
+		return
+	}
+	entry.Debug("Middleware rule touched resource")
+}
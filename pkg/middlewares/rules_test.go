@@ -0,0 +1,267 @@
+package middlewares
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func writeRules(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "rules.yml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadRules_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr string
+	}{
+		{
+			name: "unknown kind",
+			rules: `rules:
+  - name: unknown
+    kind: delete`,
+			wantErr: "invalid middleware rule unknown: unknown kind 'delete', must be one of drop, rename_type, expand",
+		},
+		{
+			name: "drop without predicate",
+			rules: `rules:
+  - kind: drop`,
+			wantErr: "invalid middleware rule rule #1: a drop rule needs a type or a where predicate",
+		},
+		{
+			name: "rename without target",
+			rules: `rules:
+  - name: rename
+    kind: rename_type
+    type: acme_widget`,
+			wantErr: "invalid middleware rule rename: a rename_type rule needs a type and a to type",
+		},
+		{
+			name: "expand without child type",
+			rules: `rules:
+  - name: expand
+    kind: expand
+    type: acme_widget
+    attribute: rules`,
+			wantErr: "invalid middleware rule expand: an expand rule needs a type, an attribute and a child_type",
+		},
+		{
+			name: "invalid from",
+			rules: `rules:
+  - name: drop
+    kind: drop
+    type: acme_widget
+    from: iac`,
+			wantErr: "invalid middleware rule drop: from must be remote or state",
+		},
+		{
+			name: "invalid where",
+			rules: `rules:
+  - name: drop
+    kind: drop
+    where: "Attr.["`,
+			wantErr: "invalid middleware rule drop: unable to compile where: SyntaxError: Incomplete expression",
+		},
+		{
+			name: "duplicate name",
+			rules: `rules:
+  - name: drop
+    kind: drop
+    type: acme_widget
+  - name: drop
+    kind: drop
+    type: acme_gadget`,
+			wantErr: "invalid middleware rule drop: name is used by several rules",
+		},
+		{
+			name: "name of a default name",
+			rules: `rules:
+  - name: "rule #2"
+    kind: drop
+    type: acme_widget
+  - kind: drop
+    type: acme_gadget`,
+			wantErr: "invalid middleware rule rule #2: name is used by several rules",
+		},
+		{
+			name: "name of a built-in middleware",
+			rules: `rules:
+  - name: AwsDefaultVPC
+    kind: drop
+    type: aws_vpc`,
+			wantErr: "invalid middleware rule AwsDefaultVPC: name is already used by another middleware",
+		},
+		{
+			name: "name of a plugin middleware",
+			rules: `rules:
+  - name: acme_defaults
+    kind: drop
+    type: acme_widget`,
+			wantErr: "invalid middleware rule acme_defaults: name is already used by another middleware",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRules(writeRules(t, tt.rules), &dctlresource.MockResourceFactory{}, []string{"AwsDefaultVPC", "acme_defaults"})
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRuleMiddleware_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		rules              string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expectedRemote     []*resource.Resource
+		expectedState      []*resource.Resource
+	}{
+		{
+			name: "drop default resources from remote",
+			rules: `rules:
+  - name: default widgets
+    kind: drop
+    type: acme_widget
+    where: "Attr.is_default == ` + "`true`" + `"
+    from: remote`,
+			remoteResources: []*resource.Resource{
+				{Id: "default", Type: "acme_widget", Attrs: &resource.Attributes{"is_default": true}},
+				{Id: "custom", Type: "acme_widget", Attrs: &resource.Attributes{"is_default": false}},
+				{Id: "default", Type: "acme_gadget", Attrs: &resource.Attributes{"is_default": true}},
+			},
+			resourcesFromState: []*resource.Resource{
+				{Id: "default", Type: "acme_widget", Attrs: &resource.Attributes{"is_default": true}},
+			},
+			expectedRemote: []*resource.Resource{
+				{Id: "custom", Type: "acme_widget", Attrs: &resource.Attributes{"is_default": false}},
+				{Id: "default", Type: "acme_gadget", Attrs: &resource.Attributes{"is_default": true}},
+			},
+			expectedState: []*resource.Resource{
+				{Id: "default", Type: "acme_widget", Attrs: &resource.Attributes{"is_default": true}},
+			},
+		},
+		{
+			name: "dry run leaves resources untouched",
+			rules: `dry_run: true
+rules:
+  - kind: drop
+    type: acme_widget`,
+			remoteResources: []*resource.Resource{
+				{Id: "default", Type: "acme_widget", Attrs: &resource.Attributes{}},
+			},
+			resourcesFromState: []*resource.Resource{},
+			expectedRemote: []*resource.Resource{
+				{Id: "default", Type: "acme_widget", Attrs: &resource.Attributes{}},
+			},
+			expectedState: []*resource.Resource{},
+		},
+		{
+			name: "rename type keeps the state source",
+			rules: `rules:
+  - kind: rename_type
+    type: acme_legacy_widget
+    to: acme_widget
+    from: state`,
+			remoteResources: []*resource.Resource{},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:     "widget",
+					Type:   "acme_legacy_widget",
+					Attrs:  &resource.Attributes{"name": "widget"},
+					Source: resource.NewTerraformStateSource("tfstate://terraform.tfstate", "", "widget"),
+				},
+			},
+			expectedRemote: []*resource.Resource{},
+			expectedState: []*resource.Resource{
+				{
+					Id:     "widget",
+					Type:   "acme_widget",
+					Attrs:  &resource.Attributes{"name": "widget"},
+					Source: resource.NewTerraformStateSource("tfstate://terraform.tfstate", "", "widget"),
+				},
+			},
+		},
+		{
+			name: "expand a list attribute into children",
+			rules: `rules:
+  - kind: expand
+    type: acme_firewall
+    attribute: rule
+    child_type: acme_firewall_rule
+    id_attribute: name
+    parent_attribute: firewall_id
+    from: state`,
+			remoteResources: []*resource.Resource{},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "firewall",
+					Type: "acme_firewall",
+					Attrs: &resource.Attributes{
+						"name": "firewall",
+						"rule": []interface{}{
+							map[string]interface{}{"name": "allow-http", "port": float64(80)},
+							map[string]interface{}{"name": "allow-https", "port": float64(443)},
+						},
+					},
+				},
+				{Id: "allow-https", Type: "acme_firewall_rule", Attrs: &resource.Attributes{"port": float64(443)}},
+			},
+			expectedRemote: []*resource.Resource{},
+			expectedState: []*resource.Resource{
+				{Id: "firewall", Type: "acme_firewall", Attrs: &resource.Attributes{"name": "firewall"}},
+				{Id: "allow-http", Type: "acme_firewall_rule", Attrs: &resource.Attributes{"name": "allow-http", "port": float64(80), "firewall_id": "firewall"}},
+				{Id: "allow-https", Type: "acme_firewall_rule", Attrs: &resource.Attributes{"port": float64(443)}},
+			},
+		},
+		{
+			name: "expand an object attribute with the parent id",
+			rules: `rules:
+  - kind: expand
+    type: acme_bucket
+    attribute: policy
+    child_type: acme_bucket_policy`,
+			remoteResources: []*resource.Resource{
+				{Id: "bucket", Type: "acme_bucket", Attrs: &resource.Attributes{"policy": map[string]interface{}{"public": false}}},
+			},
+			resourcesFromState: []*resource.Resource{},
+			expectedRemote: []*resource.Resource{
+				{Id: "bucket", Type: "acme_bucket", Attrs: &resource.Attributes{}},
+				{Id: "bucket", Type: "acme_bucket_policy", Attrs: &resource.Attributes{"public": false}},
+			},
+			expectedState: []*resource.Resource{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := &dctlresource.MockResourceFactory{}
+			factory.On("CreateAbstractResource", mock.Anything, mock.Anything, mock.Anything).Return(
+				func(ty, id string, data map[string]interface{}) *resource.Resource {
+					attributes := resource.Attributes(data)
+					return &resource.Resource{Id: id, Type: ty, Attrs: &attributes}
+				},
+			).Maybe()
+
+			rules, err := LoadRules(writeRules(t, tt.rules), factory, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			remoteResources, resourcesFromState := tt.remoteResources, tt.resourcesFromState
+			err = NewChain(rules...).Execute(&remoteResources, &resourcesFromState)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRemote, remoteResources)
+			assert.Equal(t, tt.expectedState, resourcesFromState)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package azurerm
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AzureKubernetesClusterResourceType = "azurerm_kubernetes_cluster"
+
+func initAzureKubernetesClusterMetadata(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AzureKubernetesClusterResourceType, func(res *resource.Resource) {
+		res.Attributes().SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AzureKubernetesClusterResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if name := res.Attributes().GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+}
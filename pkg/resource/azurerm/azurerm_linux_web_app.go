@@ -0,0 +1,21 @@
+package azurerm
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AzureLinuxWebAppResourceType = "azurerm_linux_web_app"
+
+func initAzureLinuxWebAppMetadata(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AzureLinuxWebAppResourceType, func(res *resource.Resource) {
+		res.Attributes().SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AzureLinuxWebAppResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if name := res.Attributes().GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+}
@@ -27,4 +27,9 @@ func InitResourcesMetadata(resourceSchemaRepository resource.SchemaRepositoryInt
 	initAzureSSHPublicKeyMetaData(resourceSchemaRepository)
 	initAzurePrivateDNSCNameRecordMetaData(resourceSchemaRepository)
 	initAzureLoadBalancerRuleMetadata(resourceSchemaRepository)
+	initAzureKubernetesClusterMetadata(resourceSchemaRepository)
+	initAzureKubernetesClusterNodePoolMetadata(resourceSchemaRepository)
+	initAzureServicePlanMetadata(resourceSchemaRepository)
+	initAzureLinuxWebAppMetadata(resourceSchemaRepository)
+	initAzureLinuxFunctionAppMetadata(resourceSchemaRepository)
 }
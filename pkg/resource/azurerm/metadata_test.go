@@ -11,31 +11,33 @@ import (
 
 func TestAzureMetadata_Flags(t *testing.T) {
 	testcases := map[string][]resource.Flags{
-		azurerm.AzureContainerRegistryResourceType:     {},
-		azurerm.AzureFirewallResourceType:              {},
-		azurerm.AzurePostgresqlServerResourceType:      {},
-		azurerm.AzurePostgresqlDatabaseResourceType:    {},
-		azurerm.AzurePublicIPResourceType:              {},
-		azurerm.AzureResourceGroupResourceType:         {},
-		azurerm.AzureRouteResourceType:                 {},
-		azurerm.AzureRouteTableResourceType:            {},
-		azurerm.AzureStorageAccountResourceType:        {},
-		azurerm.AzureStorageContainerResourceType:      {},
-		azurerm.AzureSubnetResourceType:                {},
-		azurerm.AzureVirtualNetworkResourceType:        {},
-		azurerm.AzureNetworkSecurityGroupResourceType:  {},
-		azurerm.AzureLoadBalancerResourceType:          {},
-		azurerm.AzurePrivateDNSZoneResourceType:        {},
-		azurerm.AzurePrivateDNSARecordResourceType:     {},
-		azurerm.AzurePrivateDNSAAAARecordResourceType:  {},
-		azurerm.AzurePrivateDNSCNameRecordResourceType: {},
-		azurerm.AzurePrivateDNSPTRRecordResourceType:   {},
-		azurerm.AzurePrivateDNSMXRecordResourceType:    {},
-		azurerm.AzurePrivateDNSSRVRecordResourceType:   {},
-		azurerm.AzurePrivateDNSTXTRecordResourceType:   {},
-		azurerm.AzureImageResourceType:                 {},
-		azurerm.AzureSSHPublicKeyResourceType:          {},
-		azurerm.AzureLoadBalancerRuleResourceType:      {},
+		azurerm.AzureContainerRegistryResourceType:         {},
+		azurerm.AzureFirewallResourceType:                  {},
+		azurerm.AzurePostgresqlServerResourceType:          {},
+		azurerm.AzurePostgresqlDatabaseResourceType:        {},
+		azurerm.AzurePublicIPResourceType:                  {},
+		azurerm.AzureResourceGroupResourceType:             {},
+		azurerm.AzureRouteResourceType:                     {},
+		azurerm.AzureRouteTableResourceType:                {},
+		azurerm.AzureStorageAccountResourceType:            {},
+		azurerm.AzureStorageContainerResourceType:          {},
+		azurerm.AzureSubnetResourceType:                    {},
+		azurerm.AzureVirtualNetworkResourceType:            {},
+		azurerm.AzureNetworkSecurityGroupResourceType:      {},
+		azurerm.AzureLoadBalancerResourceType:              {},
+		azurerm.AzurePrivateDNSZoneResourceType:            {},
+		azurerm.AzurePrivateDNSARecordResourceType:         {},
+		azurerm.AzurePrivateDNSAAAARecordResourceType:      {},
+		azurerm.AzurePrivateDNSCNameRecordResourceType:     {},
+		azurerm.AzurePrivateDNSPTRRecordResourceType:       {},
+		azurerm.AzurePrivateDNSMXRecordResourceType:        {},
+		azurerm.AzurePrivateDNSSRVRecordResourceType:       {},
+		azurerm.AzurePrivateDNSTXTRecordResourceType:       {},
+		azurerm.AzureImageResourceType:                     {},
+		azurerm.AzureSSHPublicKeyResourceType:              {},
+		azurerm.AzureLoadBalancerRuleResourceType:          {},
+		azurerm.AzureKubernetesClusterResourceType:         {},
+		azurerm.AzureKubernetesClusterNodePoolResourceType: {},
 	}
 
 	schemaRepository := testresource.InitFakeSchemaRepository("azurerm", "2.71.0")
@@ -57,3 +59,30 @@ func TestAzureMetadata_Flags(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureMetadata_Flags_V3(t *testing.T) {
+	testcases := map[string][]resource.Flags{
+		azurerm.AzureServicePlanResourceType:      {},
+		azurerm.AzureLinuxWebAppResourceType:      {},
+		azurerm.AzureLinuxFunctionAppResourceType: {},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("azurerm", "3.0.0")
+	azurerm.InitResourcesMetadata(schemaRepository)
+
+	for ty, flags := range testcases {
+		t.Run(ty, func(tt *testing.T) {
+			sch, exist := schemaRepository.GetSchema(ty)
+			assert.True(tt, exist)
+
+			if len(flags) == 0 {
+				assert.Equal(tt, resource.Flags(0x0), sch.Flags, "should not have any flag")
+				return
+			}
+
+			for _, flag := range flags {
+				assert.Truef(tt, sch.Flags.HasFlag(flag), "should have given flag %d", flag)
+			}
+		})
+	}
+}
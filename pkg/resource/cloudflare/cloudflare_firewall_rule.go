@@ -0,0 +1,22 @@
+package cloudflare
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const CloudflareFirewallRuleResourceType = "cloudflare_firewall_rule"
+
+func initCloudflareFirewallRuleMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(CloudflareFirewallRuleResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if description := val.GetString("description"); description != nil && *description != "" {
+			attrs["Description"] = *description
+		}
+		if zoneID := val.GetString("zone_id"); zoneID != nil && *zoneID != "" {
+			attrs["ZoneId"] = *zoneID
+		}
+		return attrs
+	})
+}
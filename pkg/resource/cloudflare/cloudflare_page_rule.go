@@ -0,0 +1,22 @@
+package cloudflare
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const CloudflarePageRuleResourceType = "cloudflare_page_rule"
+
+func initCloudflarePageRuleMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(CloudflarePageRuleResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if target := val.GetString("target"); target != nil && *target != "" {
+			attrs["Target"] = *target
+		}
+		if zoneID := val.GetString("zone_id"); zoneID != nil && *zoneID != "" {
+			attrs["ZoneId"] = *zoneID
+		}
+		return attrs
+	})
+}
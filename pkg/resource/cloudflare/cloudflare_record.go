@@ -0,0 +1,32 @@
+package cloudflare
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const CloudflareRecordResourceType = "cloudflare_record"
+
+func initCloudflareRecordMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(CloudflareRecordResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"created_on"})
+		val.SafeDelete([]string{"modified_on"})
+		val.SafeDelete([]string{"metadata"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(CloudflareRecordResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		if ty := val.GetString("type"); ty != nil && *ty != "" {
+			attrs["Type"] = *ty
+		}
+		if zoneID := val.GetString("zone_id"); zoneID != nil && *zoneID != "" {
+			attrs["ZoneId"] = *zoneID
+		}
+		return attrs
+	})
+}
@@ -0,0 +1,22 @@
+package cloudflare
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const CloudflareWorkerRouteResourceType = "cloudflare_worker_route"
+
+func initCloudflareWorkerRouteMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(CloudflareWorkerRouteResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if pattern := val.GetString("pattern"); pattern != nil && *pattern != "" {
+			attrs["Pattern"] = *pattern
+		}
+		if zoneID := val.GetString("zone_id"); zoneID != nil && *zoneID != "" {
+			attrs["ZoneId"] = *zoneID
+		}
+		return attrs
+	})
+}
@@ -0,0 +1,18 @@
+package cloudflare
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const CloudflareZoneResourceType = "cloudflare_zone"
+
+func initCloudflareZoneMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(CloudflareZoneResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if zone := res.Attrs.GetString("zone"); zone != nil && *zone != "" {
+			attrs["Zone"] = *zone
+		}
+		return attrs
+	})
+}
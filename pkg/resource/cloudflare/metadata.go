@@ -0,0 +1,13 @@
+package cloudflare
+
+import (
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+func InitResourcesMetadata(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	initCloudflareZoneMetaData(resourceSchemaRepository)
+	initCloudflareRecordMetaData(resourceSchemaRepository)
+	initCloudflarePageRuleMetaData(resourceSchemaRepository)
+	initCloudflareFirewallRuleMetaData(resourceSchemaRepository)
+	initCloudflareWorkerRouteMetaData(resourceSchemaRepository)
+}
@@ -0,0 +1,18 @@
+package github
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const GithubActionsOrganizationSecretResourceType = "github_actions_organization_secret"
+
+func initGithubActionsOrganizationSecretMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(GithubActionsOrganizationSecretResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"plaintext_value"})
+		val.SafeDelete([]string{"encrypted_value"})
+		val.SafeDelete([]string{"created_at"})
+		val.SafeDelete([]string{"updated_at"})
+	})
+}
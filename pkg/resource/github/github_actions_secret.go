@@ -0,0 +1,19 @@
+package github
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const GithubActionsSecretResourceType = "github_actions_secret"
+
+func initGithubActionsSecretMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(GithubActionsSecretResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// secret values are never returned by the API, and timestamps change on each update
+		val.SafeDelete([]string{"plaintext_value"})
+		val.SafeDelete([]string{"encrypted_value"})
+		val.SafeDelete([]string{"created_at"})
+		val.SafeDelete([]string{"updated_at"})
+	})
+}
@@ -0,0 +1,16 @@
+package github
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const GithubRepositoryCollaboratorResourceType = "github_repository_collaborator"
+
+func initGithubRepositoryCollaboratorMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(GithubRepositoryCollaboratorResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"invitation_id"})
+		val.SafeDelete([]string{"permission_diff_suppression"})
+	})
+}
@@ -0,0 +1,15 @@
+package github
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const GithubRepositoryDeployKeyResourceType = "github_repository_deploy_key"
+
+func initGithubRepositoryDeployKeyMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(GithubRepositoryDeployKeyResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"etag"})
+	})
+}
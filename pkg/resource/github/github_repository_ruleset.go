@@ -0,0 +1,15 @@
+package github
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const GithubRepositoryRulesetResourceType = "github_repository_ruleset"
+
+func initGithubRepositoryRulesetMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(GithubRepositoryRulesetResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"etag"})
+	})
+}
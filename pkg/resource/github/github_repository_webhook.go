@@ -0,0 +1,15 @@
+package github
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const GithubRepositoryWebhookResourceType = "github_repository_webhook"
+
+func initGithubRepositoryWebhookMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(GithubRepositoryWebhookResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"etag"})
+	})
+}
@@ -11,11 +11,16 @@ import (
 
 func TestGitHub_Metadata_Flags(t *testing.T) {
 	testcases := map[string][]resource.Flags{
-		github.GithubBranchProtectionResourceType: {},
-		github.GithubMembershipResourceType:       {},
-		github.GithubTeamMembershipResourceType:   {},
-		github.GithubRepositoryResourceType:       {},
-		github.GithubTeamResourceType:             {},
+		github.GithubBranchProtectionResourceType:          {},
+		github.GithubMembershipResourceType:                {},
+		github.GithubTeamMembershipResourceType:            {},
+		github.GithubRepositoryResourceType:                {},
+		github.GithubTeamResourceType:                      {},
+		github.GithubActionsSecretResourceType:             {},
+		github.GithubActionsOrganizationSecretResourceType: {},
+		github.GithubRepositoryDeployKeyResourceType:       {},
+		github.GithubRepositoryWebhookResourceType:         {},
+		github.GithubRepositoryCollaboratorResourceType:    {},
 	}
 
 	schemaRepository := testresource.InitFakeSchemaRepository("github", "4.4.0")
@@ -37,3 +42,29 @@ func TestGitHub_Metadata_Flags(t *testing.T) {
 		})
 	}
 }
+
+func TestGitHub_Metadata_Flags_V6(t *testing.T) {
+	testcases := map[string][]resource.Flags{
+		github.GithubRepositoryEnvironmentResourceType: {},
+		github.GithubRepositoryRulesetResourceType:     {},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("github", "6.0.0")
+	github.InitResourcesMetadata(schemaRepository)
+
+	for ty, flags := range testcases {
+		t.Run(ty, func(tt *testing.T) {
+			sch, exist := schemaRepository.GetSchema(ty)
+			assert.True(tt, exist)
+
+			if len(flags) == 0 {
+				assert.Equal(tt, resource.Flags(0x0), sch.Flags, "should not have any flag")
+				return
+			}
+
+			for _, flag := range flags {
+				assert.Truef(tt, sch.Flags.HasFlag(flag), "should have given flag %d", flag)
+			}
+		})
+	}
+}
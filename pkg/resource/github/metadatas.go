@@ -10,4 +10,10 @@ func InitResourcesMetadata(resourceSchemaRepository resource.SchemaRepositoryInt
 	initGithubRepositoryMetaData(resourceSchemaRepository)
 	initGithubTeamMetaData(resourceSchemaRepository)
 	initGithubTeamMembershipMetaData(resourceSchemaRepository)
+	initGithubActionsSecretMetaData(resourceSchemaRepository)
+	initGithubActionsOrganizationSecretMetaData(resourceSchemaRepository)
+	initGithubRepositoryDeployKeyMetaData(resourceSchemaRepository)
+	initGithubRepositoryWebhookMetaData(resourceSchemaRepository)
+	initGithubRepositoryCollaboratorMetaData(resourceSchemaRepository)
+	initGithubRepositoryRulesetMetaData(resourceSchemaRepository)
 }
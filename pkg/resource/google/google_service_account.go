@@ -0,0 +1,3 @@
+package google
+
+const GoogleServiceAccountResourceType = "google_service_account"
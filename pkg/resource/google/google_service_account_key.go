@@ -0,0 +1,3 @@
+package google
+
+const GoogleServiceAccountKeyResourceType = "google_service_account_key"
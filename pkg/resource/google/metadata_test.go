@@ -35,6 +35,14 @@ func TestGoogle_Metadata_Flags(t *testing.T) {
 		google.GoogleComputeInstanceGroupManagerResourceType: {},
 		google.GoogleComputeGlobalForwardingRuleResourceType: {},
 		google.GoogleComputeSslCertificateResourceType:       {},
+		google.GoogleContainerClusterResourceType:            {},
+		google.GoogleContainerNodePoolResourceType:           {},
+		google.GooglePubsubTopicResourceType:                 {},
+		google.GooglePubsubSubscriptionResourceType:          {},
+		google.GoogleServiceAccountResourceType:              {},
+		google.GoogleServiceAccountKeyResourceType:           {},
+		google.GoogleKMSKeyRingResourceType:                  {},
+		google.GoogleKMSCryptoKeyResourceType:                {},
 	}
 
 	schemaRepository := testresource.InitFakeSchemaRepository("google", "3.78.0")
@@ -0,0 +1,15 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const KubernetesClusterRoleResourceType = "kubernetes_cluster_role"
+const KubernetesClusterRoleV1ResourceType = "kubernetes_cluster_role_v1"
+
+func initKubernetesClusterRoleMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(KubernetesClusterRoleResourceType, func(res *resource.Resource) map[string]string {
+		return humanReadableMetadata(res)
+	})
+}
@@ -0,0 +1,15 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const KubernetesClusterRoleBindingResourceType = "kubernetes_cluster_role_binding"
+const KubernetesClusterRoleBindingV1ResourceType = "kubernetes_cluster_role_binding_v1"
+
+func initKubernetesClusterRoleBindingMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(KubernetesClusterRoleBindingResourceType, func(res *resource.Resource) map[string]string {
+		return humanReadableMetadata(res)
+	})
+}
@@ -0,0 +1,15 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const KubernetesConfigMapResourceType = "kubernetes_config_map"
+const KubernetesConfigMapV1ResourceType = "kubernetes_config_map_v1"
+
+func initKubernetesConfigMapMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(KubernetesConfigMapResourceType, func(res *resource.Resource) map[string]string {
+		return humanReadableMetadata(res)
+	})
+}
@@ -0,0 +1,20 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const KubernetesDeploymentResourceType = "kubernetes_deployment"
+const KubernetesDeploymentV1ResourceType = "kubernetes_deployment_v1"
+
+func initKubernetesDeploymentMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(KubernetesDeploymentResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"wait_for_rollout"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(KubernetesDeploymentResourceType, func(res *resource.Resource) map[string]string {
+		return humanReadableMetadata(res)
+	})
+}
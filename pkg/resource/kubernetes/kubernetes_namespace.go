@@ -0,0 +1,15 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const KubernetesNamespaceResourceType = "kubernetes_namespace"
+const KubernetesNamespaceV1ResourceType = "kubernetes_namespace_v1"
+
+func initKubernetesNamespaceMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(KubernetesNamespaceResourceType, func(res *resource.Resource) map[string]string {
+		return humanReadableMetadata(res)
+	})
+}
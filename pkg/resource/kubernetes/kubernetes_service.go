@@ -0,0 +1,20 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const KubernetesServiceResourceType = "kubernetes_service"
+const KubernetesServiceV1ResourceType = "kubernetes_service_v1"
+
+func initKubernetesServiceMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(KubernetesServiceResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"wait_for_load_balancer"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(KubernetesServiceResourceType, func(res *resource.Resource) map[string]string {
+		return humanReadableMetadata(res)
+	})
+}
@@ -0,0 +1,20 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const KubernetesServiceAccountResourceType = "kubernetes_service_account"
+const KubernetesServiceAccountV1ResourceType = "kubernetes_service_account_v1"
+
+func initKubernetesServiceAccountMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(KubernetesServiceAccountResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"automount_service_account_token"})
+		val.SafeDelete([]string{"default_secret_name"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(KubernetesServiceAccountResourceType, func(res *resource.Resource) map[string]string {
+		return humanReadableMetadata(res)
+	})
+}
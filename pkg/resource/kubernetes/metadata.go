@@ -0,0 +1,43 @@
+package kubernetes
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+func InitResourcesMetadata(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	initKubernetesNamespaceMetaData(resourceSchemaRepository)
+	initKubernetesDeploymentMetaData(resourceSchemaRepository)
+	initKubernetesServiceMetaData(resourceSchemaRepository)
+	initKubernetesConfigMapMetaData(resourceSchemaRepository)
+	initKubernetesServiceAccountMetaData(resourceSchemaRepository)
+	initKubernetesRoleMetaData(resourceSchemaRepository)
+	initKubernetesRoleBindingMetaData(resourceSchemaRepository)
+	initKubernetesClusterRoleMetaData(resourceSchemaRepository)
+	initKubernetesClusterRoleBindingMetaData(resourceSchemaRepository)
+}
+
+// humanReadableMetadata handles both enumerated resources, which have flat name and namespace
+// attributes, and resources read from state where they live in the metadata block
+func humanReadableMetadata(res *resource.Resource) map[string]string {
+	values := map[string]interface{}{}
+	if metadata := res.Attributes().GetSlice("metadata"); len(metadata) > 0 {
+		if m, ok := metadata[0].(map[string]interface{}); ok {
+			values["namespace"], values["name"] = m["namespace"], m["name"]
+		}
+	}
+	for _, key := range []string{"namespace", "name"} {
+		if v, exist := res.Attributes().Get(key); exist {
+			values[key] = v
+		}
+	}
+
+	attrs := make(map[string]string)
+	if namespace, ok := values["namespace"].(string); ok && namespace != "" {
+		attrs["Namespace"] = namespace
+	}
+	if name, ok := values["name"].(string); ok && name != "" {
+		attrs["Name"] = name
+	}
+	return attrs
+}
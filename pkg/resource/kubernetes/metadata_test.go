@@ -0,0 +1,85 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/kubernetes"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubernetes_Metadata_Flags(t *testing.T) {
+	testcases := map[string][]resource.Flags{
+		kubernetes.KubernetesNamespaceResourceType:          {},
+		kubernetes.KubernetesDeploymentResourceType:         {},
+		kubernetes.KubernetesServiceResourceType:            {},
+		kubernetes.KubernetesConfigMapResourceType:          {},
+		kubernetes.KubernetesServiceAccountResourceType:     {},
+		kubernetes.KubernetesRoleResourceType:               {},
+		kubernetes.KubernetesRoleBindingResourceType:        {},
+		kubernetes.KubernetesClusterRoleResourceType:        {},
+		kubernetes.KubernetesClusterRoleBindingResourceType: {},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("kubernetes", "2.13.1")
+	kubernetes.InitResourcesMetadata(schemaRepository)
+
+	for ty, flags := range testcases {
+		t.Run(ty, func(tt *testing.T) {
+			sch, exist := schemaRepository.GetSchema(ty)
+			assert.True(tt, exist)
+
+			if len(flags) == 0 {
+				assert.Equal(tt, resource.Flags(0x0), sch.Flags, "should not have any flag")
+				return
+			}
+
+			for _, flag := range flags {
+				assert.Truef(tt, sch.Flags.HasFlag(flag), "should have given flag %d", flag)
+			}
+		})
+	}
+}
+
+func TestKubernetes_HumanReadableAttributes(t *testing.T) {
+	schemaRepository := testresource.InitFakeSchemaRepository("kubernetes", "2.13.1")
+	kubernetes.InitResourcesMetadata(schemaRepository)
+
+	tests := []struct {
+		name     string
+		res      *resource.Resource
+		expected map[string]string
+	}{
+		{
+			name: "enumerated resource",
+			res: &resource.Resource{
+				Id:    "app/api",
+				Type:  kubernetes.KubernetesDeploymentResourceType,
+				Attrs: &resource.Attributes{"namespace": "app", "name": "api"},
+			},
+			expected: map[string]string{"Namespace": "app", "Name": "api"},
+		},
+		{
+			name: "resource from state",
+			res: &resource.Resource{
+				Id:   "admins",
+				Type: kubernetes.KubernetesClusterRoleBindingResourceType,
+				Attrs: &resource.Attributes{
+					"metadata": []interface{}{
+						map[string]interface{}{"name": "admins"},
+					},
+				},
+			},
+			expected: map[string]string{"Name": "admins"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch, exist := schemaRepository.GetSchema(tt.res.ResourceType())
+			assert.True(t, exist)
+			assert.Equal(t, tt.expected, sch.HumanReadableAttributesFunc(tt.res))
+		})
+	}
+}
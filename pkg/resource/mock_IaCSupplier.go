@@ -3,6 +3,8 @@
 package resource
 
 import (
+	context "context"
+
 	enumerationresource "github.com/snyk/driftctl/enumeration/resource"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockIaCSupplier struct {
 	mock.Mock
 }
 
-// Resources provides a mock function with given fields:
-func (_m *MockIaCSupplier) Resources() ([]*enumerationresource.Resource, error) {
-	ret := _m.Called()
+// Resources provides a mock function with given fields: ctx
+func (_m *MockIaCSupplier) Resources(ctx context.Context) ([]*enumerationresource.Resource, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*enumerationresource.Resource
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*enumerationresource.Resource, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*enumerationresource.Resource, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*enumerationresource.Resource); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*enumerationresource.Resource); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*enumerationresource.Resource)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -185,6 +185,14 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"github_team":              {},
 	"github_team_membership":   {},
 
+	"github_actions_secret":              {},
+	"github_actions_organization_secret": {},
+	"github_repository_environment":      {},
+	"github_repository_deploy_key":       {},
+	"github_repository_webhook":          {},
+	"github_repository_collaborator":     {},
+	"github_repository_ruleset":          {},
+
 	"google_storage_bucket":   {},
 	"google_compute_firewall": {},
 	"google_compute_router":   {},
@@ -225,6 +233,15 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"google_compute_global_forwarding_rule": {},
 	"google_compute_ssl_certificate":        {},
 
+	"google_container_cluster":   {},
+	"google_container_node_pool": {},
+	"google_pubsub_topic":        {},
+	"google_pubsub_subscription": {},
+	"google_service_account":     {},
+	"google_service_account_key": {},
+	"google_kms_key_ring":        {},
+	"google_kms_crypto_key":      {},
+
 	"azurerm_storage_account":   {},
 	"azurerm_storage_container": {},
 	"azurerm_virtual_network": {children: []ResourceType{
@@ -254,6 +271,55 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"azurerm_private_dns_txt_record":   {},
 	"azurerm_image":                    {},
 	"azurerm_ssh_public_key":           {},
+
+	"azurerm_kubernetes_cluster":           {},
+	"azurerm_kubernetes_cluster_node_pool": {},
+	"azurerm_service_plan":                 {},
+	"azurerm_linux_web_app":                {},
+	"azurerm_linux_function_app":           {},
+
+	"kubernetes_namespace":            {},
+	"kubernetes_deployment":           {},
+	"kubernetes_service":              {},
+	"kubernetes_config_map":           {},
+	"kubernetes_service_account":      {},
+	"kubernetes_role":                 {},
+	"kubernetes_role_binding":         {},
+	"kubernetes_cluster_role":         {},
+	"kubernetes_cluster_role_binding": {},
+	"kubernetes_namespace_v1": {children: []ResourceType{
+		"kubernetes_namespace",
+	}},
+	"kubernetes_deployment_v1": {children: []ResourceType{
+		"kubernetes_deployment",
+	}},
+	"kubernetes_service_v1": {children: []ResourceType{
+		"kubernetes_service",
+	}},
+	"kubernetes_config_map_v1": {children: []ResourceType{
+		"kubernetes_config_map",
+	}},
+	"kubernetes_service_account_v1": {children: []ResourceType{
+		"kubernetes_service_account",
+	}},
+	"kubernetes_role_v1": {children: []ResourceType{
+		"kubernetes_role",
+	}},
+	"kubernetes_role_binding_v1": {children: []ResourceType{
+		"kubernetes_role_binding",
+	}},
+	"kubernetes_cluster_role_v1": {children: []ResourceType{
+		"kubernetes_cluster_role",
+	}},
+	"kubernetes_cluster_role_binding_v1": {children: []ResourceType{
+		"kubernetes_cluster_role_binding",
+	}},
+
+	"cloudflare_zone":          {},
+	"cloudflare_record":        {},
+	"cloudflare_page_rule":     {},
+	"cloudflare_firewall_rule": {},
+	"cloudflare_worker_route":  {},
 }
 
 func IsResourceTypeSupported(ty string) bool {
@@ -261,6 +327,25 @@ func IsResourceTypeSupported(ty string) bool {
 	return exist
 }
 
+// addedTypes are the types added with AddSupportedType
+var addedTypes = map[string]struct{}{}
+
+// AddSupportedType makes state readers keep resources of a type that is not built in, such as
+// the types listed by plugins
+func AddSupportedType(ty string) {
+	if _, exist := supportedTypes[ty]; !exist {
+		supportedTypes[ty] = ResourceTypeMeta{}
+		addedTypes[ty] = struct{}{}
+	}
+}
+
+// IsAddedType tells whether the type was added with AddSupportedType, the provider of such a type
+// may be unknown to driftctl
+func IsAddedType(ty string) bool {
+	_, exist := addedTypes[ty]
+	return exist
+}
+
 func GetSupportedTypes() []string {
 	types := make([]string, 0, len(supportedTypes))
 	for k := range supportedTypes {
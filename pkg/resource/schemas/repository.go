@@ -11,8 +11,10 @@ import (
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/pkg/resource/aws"
 	"github.com/snyk/driftctl/pkg/resource/azurerm"
+	"github.com/snyk/driftctl/pkg/resource/cloudflare"
 	"github.com/snyk/driftctl/pkg/resource/github"
 	"github.com/snyk/driftctl/pkg/resource/google"
+	"github.com/snyk/driftctl/pkg/resource/kubernetes"
 )
 
 type SchemaRepository struct {
@@ -58,6 +60,10 @@ func (r *SchemaRepository) Init(providerName, providerVersion string, schema map
 			providerVersion = "3.78.0"
 		case "azurerm":
 			providerVersion = "2.71.0"
+		case "kubernetes":
+			providerVersion = "2.13.1"
+		case "cloudflare":
+			providerVersion = "3.35.0"
 		default:
 			return errors.Errorf("unsupported remote '%s'", providerName)
 		}
@@ -92,6 +98,10 @@ func (r *SchemaRepository) Init(providerName, providerVersion string, schema map
 		google.InitResourcesMetadata(r)
 	case "azurerm":
 		azurerm.InitResourcesMetadata(r)
+	case "kubernetes":
+		kubernetes.InitResourcesMetadata(r)
+	case "cloudflare":
+		cloudflare.InitResourcesMetadata(r)
 	default:
 		return errors.Errorf("unsupported remote '%s'", providerName)
 	}
@@ -2,9 +2,12 @@ package hcl
 
 import (
 	"fmt"
+	"os"
 	"path"
+	"strings"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
@@ -20,6 +23,13 @@ type BackendBlock struct {
 	Prefix             string   `hcl:"prefix,optional"`
 	ContainerName      string   `hcl:"container_name,optional"`
 	WorkspaceKeyPrefix string   `hcl:"workspace_key_prefix,optional"`
+	SchemaName         string   `hcl:"schema_name,optional"`
+	SecretSuffix       string   `hcl:"secret_suffix,optional"`
+	Namespace          string   `hcl:"namespace,optional"`
+	Address            string   `hcl:"address,optional"`
+	Scheme             string   `hcl:"scheme,optional"`
+	AccessToken        string   `hcl:"access_token,optional"`
+	ConnStr            string   `hcl:"conn_str,optional"`
 	Remain             hcl.Body `hcl:",remain"`
 }
 
@@ -33,10 +43,93 @@ func (b BackendBlock) SupplierConfig(workspace string) *config.SupplierConfig {
 		return b.parseGCSBackend(workspace)
 	case "azurerm":
 		return b.parseAzurermBackend(workspace)
+	case "consul":
+		return b.parseConsulBackend(workspace)
+	case "pg":
+		return b.parsePostgresBackend(workspace)
+	case "kubernetes":
+		return b.parseKubernetesBackend(workspace)
 	}
 	return nil
 }
 
+// AllWorkspacesSupplierConfig returns a glob matching the states of every workspace of the
+// backend, or nil when the backend layout cannot be enumerated
+func (b BackendBlock) AllWorkspacesSupplierConfig() *config.SupplierConfig {
+	var cfg *config.SupplierConfig
+	switch b.Name {
+	case "s3":
+		if b.Bucket == "" || b.Key == "" {
+			return nil
+		}
+		prefix := b.WorkspaceKeyPrefix
+		if prefix == "" {
+			prefix = "env:"
+		}
+		cfg = &config.SupplierConfig{
+			Backend: backend.BackendKeyS3,
+			Path:    fmt.Sprintf("%s/{%s,%s}", b.Bucket, b.Key, path.Join(prefix, "*", b.Key)),
+		}
+	case "gcs":
+		if b.Bucket == "" || b.Prefix == "" {
+			return nil
+		}
+		cfg = &config.SupplierConfig{
+			Backend: backend.BackendKeyGS,
+			Path:    path.Join(b.Bucket, b.Prefix, "*.tfstate"),
+		}
+	case "azurerm":
+		if b.ContainerName == "" || b.Key == "" {
+			return nil
+		}
+		cfg = &config.SupplierConfig{
+			Backend: backend.BackendKeyAzureRM,
+			Path:    fmt.Sprintf("%s/{%s,%senv:*}", b.ContainerName, b.Key, b.Key),
+		}
+	case "consul":
+		if b.Path == "" {
+			return nil
+		}
+		cfg = &config.SupplierConfig{
+			Backend: backend.BackendKeyConsul,
+			Path:    fmt.Sprintf("{%s,%s-env:*}", b.Path, b.Path),
+		}
+	case "pg":
+		cfg = b.parsePostgresBackend("*")
+	case "kubernetes":
+		cfg = b.parseKubernetesBackend("*")
+	}
+	if cfg != nil {
+		cfg.Key = state.TerraformStateReaderSupplier
+	}
+	return cfg
+}
+
+// warnEnvironmentSettings warns about the connection settings of the backend that state readers do
+// not take from the block, they read the environment variables of the terraform backend instead
+func (b BackendBlock) warnEnvironmentSettings() {
+	unused := make([]string, 0)
+	switch b.Name {
+	case "consul":
+		if b.Address != "" && os.Getenv("CONSUL_HTTP_ADDR") == "" {
+			unused = append(unused, "address (set CONSUL_HTTP_ADDR)")
+		}
+		if b.Scheme == "https" && os.Getenv("CONSUL_HTTP_SSL") == "" && !strings.HasPrefix(os.Getenv("CONSUL_HTTP_ADDR"), "https://") {
+			unused = append(unused, "scheme (set CONSUL_HTTP_SSL=true)")
+		}
+		if b.AccessToken != "" && os.Getenv("CONSUL_HTTP_TOKEN") == "" {
+			unused = append(unused, "access_token (set CONSUL_HTTP_TOKEN)")
+		}
+	case "pg":
+		if b.ConnStr != "" && os.Getenv("PG_CONN_STR") == "" {
+			unused = append(unused, "conn_str (set PG_CONN_STR)")
+		}
+	}
+	if len(unused) > 0 {
+		logrus.WithField("backend", b.Name).Warnf("Backend block settings are not used to read states: %s", strings.Join(unused, ", "))
+	}
+}
+
 func (b BackendBlock) parseLocalBackend() *config.SupplierConfig {
 	if b.Path == "" {
 		return nil
@@ -92,3 +185,45 @@ func (b BackendBlock) parseAzurermBackend(ws string) *config.SupplierConfig {
 		Path:    path.Join(b.ContainerName, b.Key),
 	}
 }
+
+func (b BackendBlock) parseConsulBackend(ws string) *config.SupplierConfig {
+	if b.Path == "" {
+		return nil
+	}
+	key := b.Path
+	if ws != DefaultStateName {
+		key = fmt.Sprintf("%s-env:%s", b.Path, ws)
+	}
+	return &config.SupplierConfig{
+		Key:     state.TerraformStateReaderSupplier,
+		Backend: backend.BackendKeyConsul,
+		Path:    key,
+	}
+}
+
+func (b BackendBlock) parsePostgresBackend(ws string) *config.SupplierConfig {
+	schema := b.SchemaName
+	if schema == "" {
+		schema = backend.PostgresDefaultSchema
+	}
+	return &config.SupplierConfig{
+		Key:     state.TerraformStateReaderSupplier,
+		Backend: backend.BackendKeyPostgres,
+		Path:    path.Join(schema, ws),
+	}
+}
+
+func (b BackendBlock) parseKubernetesBackend(ws string) *config.SupplierConfig {
+	if b.SecretSuffix == "" {
+		return nil
+	}
+	namespace := b.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	return &config.SupplierConfig{
+		Key:     state.TerraformStateReaderSupplier,
+		Backend: backend.BackendKeyKubernetes,
+		Path:    path.Join(namespace, fmt.Sprintf("tfstate-%s-%s", ws, b.SecretSuffix)),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"path"
 	"testing"
 
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/stretchr/testify/assert"
 )
@@ -73,6 +75,42 @@ func TestBackend_SupplierConfig(t *testing.T) {
 				Path:    "states/prod.terraform.tfstateenv:bar",
 			},
 		},
+		{
+			name:     "test with Consul backend block",
+			filename: "testdata/consul_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "consul",
+				Path:    "terraform/network",
+			},
+		},
+		{
+			name:     "test with Consul backend block with non-default workspace",
+			filename: "testdata/consul_backend_workspace/consul_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "consul",
+				Path:    "terraform/network-env:bar",
+			},
+		},
+		{
+			name:     "test with Postgres backend block",
+			filename: "testdata/pg_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "pg",
+				Path:    "network/default",
+			},
+		},
+		{
+			name:     "test with Kubernetes backend block",
+			filename: "testdata/kubernetes_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "kubernetes",
+				Path:    "terraform/tfstate-default-network",
+			},
+		},
 		{
 			name:     "test with unknown backend",
 			filename: "testdata/unknown_backend_block.tf",
@@ -100,3 +138,129 @@ func TestBackend_SupplierConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestBackend_AllWorkspacesSupplierConfig(t *testing.T) {
+	cases := []struct {
+		name     string
+		filename string
+		want     *config.SupplierConfig
+	}{
+		{
+			name:     "test with local backend block",
+			filename: "testdata/local_backend_block.tf",
+			want:     nil,
+		},
+		{
+			name:     "test with S3 backend block",
+			filename: "testdata/s3_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "s3",
+				Path:    "terraform-state-prod/{network/terraform.tfstate,env:/*/network/terraform.tfstate}",
+			},
+		},
+		{
+			name:     "test with GCS backend block",
+			filename: "testdata/gcs_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "gs",
+				Path:    "tf-state-prod/terraform/state/*.tfstate",
+			},
+		},
+		{
+			name:     "test with Azure backend block",
+			filename: "testdata/azurerm_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "azurerm",
+				Path:    "states/{prod.terraform.tfstate,prod.terraform.tfstateenv:*}",
+			},
+		},
+		{
+			name:     "test with Consul backend block",
+			filename: "testdata/consul_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "consul",
+				Path:    "{terraform/network,terraform/network-env:*}",
+			},
+		},
+		{
+			name:     "test with Postgres backend block",
+			filename: "testdata/pg_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "pg",
+				Path:    "network/*",
+			},
+		},
+		{
+			name:     "test with Kubernetes backend block",
+			filename: "testdata/kubernetes_backend_block.tf",
+			want: &config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "kubernetes",
+				Path:    "terraform/tfstate-*-network",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			hcl, err := ParseTerraformFromHCL(tt.filename)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, hcl.Backend.AllWorkspacesSupplierConfig())
+		})
+	}
+}
+
+func TestBackend_WarnEnvironmentSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		block    BackendBlock
+		env      map[string]string
+		expected string
+	}{
+		{
+			name:     "consul settings without environment",
+			block:    BackendBlock{Name: "consul", Address: "consul.example.com", Scheme: "https", AccessToken: "token"},
+			expected: "Backend block settings are not used to read states: address (set CONSUL_HTTP_ADDR), scheme (set CONSUL_HTTP_SSL=true), access_token (set CONSUL_HTTP_TOKEN)",
+		},
+		{
+			name:  "consul settings with environment",
+			block: BackendBlock{Name: "consul", Address: "consul.example.com", Scheme: "https", AccessToken: "token"},
+			env:   map[string]string{"CONSUL_HTTP_ADDR": "https://consul.example.com", "CONSUL_HTTP_TOKEN": "token"},
+		},
+		{
+			name:     "pg connection string without environment",
+			block:    BackendBlock{Name: "pg", ConnStr: "postgres://db.example.com/terraform_backend"},
+			expected: "Backend block settings are not used to read states: conn_str (set PG_CONN_STR)",
+		},
+		{
+			name:  "pg connection string with environment",
+			block: BackendBlock{Name: "pg", ConnStr: "postgres://db.example.com/terraform_backend"},
+			env:   map[string]string{"PG_CONN_STR": "postgres://db.example.com/terraform_backend"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"CONSUL_HTTP_ADDR", "CONSUL_HTTP_SSL", "CONSUL_HTTP_TOKEN", "PG_CONN_STR"} {
+				t.Setenv(key, tt.env[key])
+			}
+			hook := logtest.NewGlobal()
+			defer hook.Reset()
+
+			tt.block.warnEnvironmentSettings()
+
+			if tt.expected == "" {
+				assert.Empty(t, hook.AllEntries())
+				return
+			}
+			if assert.Len(t, hook.AllEntries(), 1) {
+				assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+				assert.Equal(t, tt.expected, hook.LastEntry().Message)
+			}
+		})
+	}
+}
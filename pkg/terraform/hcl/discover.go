@@ -0,0 +1,219 @@
+package hcl
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+)
+
+// DiscoveredState is a state found while walking a repository
+type DiscoveredState struct {
+	// Dir is the root module or terragrunt module the state belongs to
+	Dir string
+	// File is the file the state configuration was read from
+	File      string
+	Workspace string
+	Config    config.SupplierConfig
+}
+
+// Discover walks root and returns the state of every terraform root module and terragrunt
+// module with a remote_state. Hidden directories such as .terraform and .terragrunt-cache are
+// skipped, as are terragrunt files only included by others. With allWorkspaces the states of
+// every workspace are globbed rather than the current one.
+func Discover(root string, allWorkspaces bool) ([]DiscoveredState, error) {
+	var moduleDirs, terragruntFiles []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			matches, err := filepath.Glob(filepath.Join(path, "*.tf"))
+			if err != nil {
+				return err
+			}
+			if len(matches) > 0 {
+				moduleDirs = append(moduleDirs, path)
+			}
+			return nil
+		}
+		if d.Name() == TerragruntFileName {
+			terragruntFiles = append(terragruntFiles, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Terragrunt modules also hold the generated backend blocks, the remote_state is what counts
+	terragruntDirs := map[string]bool{}
+	for _, file := range terragruntFiles {
+		terragruntDirs[filepath.Dir(file)] = true
+	}
+
+	discovered := make([]DiscoveredState, 0)
+	for _, dir := range moduleDirs {
+		if terragruntDirs[dir] {
+			continue
+		}
+		if found := discoverRootModule(dir, allWorkspaces); found != nil {
+			discovered = append(discovered, *found)
+		}
+	}
+	discovered = append(discovered, discoverTerragrunt(terragruntFiles, allWorkspaces)...)
+
+	sort.SliceStable(discovered, func(i, j int) bool {
+		return discovered[i].Dir < discovered[j].Dir
+	})
+	return discovered, nil
+}
+
+func discoverRootModule(dir string, allWorkspaces bool) *DiscoveredState {
+	ws := GetCurrentWorkspaceName(dir)
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.tf"))
+	for _, match := range matches {
+		body, err := ParseTerraformFromHCL(match)
+		if err != nil {
+			logrus.
+				WithField("file", match).
+				WithField("error", err).
+				Debug("Error parsing backend block in Terraform file")
+			continue
+		}
+
+		if cfg := body.SupplierConfig(ws, allWorkspaces); cfg != nil {
+			if allWorkspaces && body.Backend != nil && body.Backend.AllWorkspacesSupplierConfig() != nil {
+				ws = AllWorkspaces
+			}
+			return &DiscoveredState{Dir: dir, File: match, Workspace: ws, Config: relativeToModule(dir, *cfg)}
+		}
+	}
+
+	// Root modules without backend keep their state next to them once applied
+	if _, err := os.Stat(filepath.Join(dir, "terraform.tfstate")); err == nil && len(matches) > 0 {
+		return &DiscoveredState{
+			Dir:       dir,
+			File:      matches[0],
+			Workspace: DefaultStateName,
+			Config: config.SupplierConfig{
+				Key:     state.TerraformStateReaderSupplier,
+				Backend: backend.BackendKeyFile,
+				Path:    filepath.Join(dir, "terraform.tfstate"),
+			},
+		}
+	}
+	return nil
+}
+
+func discoverTerragrunt(files []string, allWorkspaces bool) []DiscoveredState {
+	included := map[string]bool{}
+	parsed := map[string]*TerragruntFile{}
+	for _, file := range files {
+		tg, err := ParseTerragruntFromHCL(file, ".")
+		if err != nil {
+			logrus.
+				WithField("file", file).
+				WithField("error", err).
+				Debug("Error parsing terragrunt file")
+			continue
+		}
+		parsed[file] = tg
+		for _, include := range tg.Includes {
+			path := include.Path
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(filepath.Dir(file), path)
+			}
+			included[absPath(path)] = true
+		}
+	}
+
+	discovered := make([]DiscoveredState, 0)
+	for _, file := range files {
+		tg, exist := parsed[file]
+		if !exist || included[absPath(file)] {
+			continue
+		}
+
+		dir := filepath.Dir(file)
+		remoteState, source := tg.RemoteState, file
+		if remoteState == nil {
+			remoteState, source = includedRemoteState(file, tg)
+		}
+		if remoteState == nil {
+			continue
+		}
+
+		ws := GetCurrentWorkspaceName(dir)
+		block := remoteState.BackendBlock()
+		cfg := TerraformBlock{Backend: &block}.SupplierConfig(ws, allWorkspaces)
+		if cfg == nil {
+			logrus.WithField("file", source).Debug("Unsupported terragrunt remote_state")
+			continue
+		}
+		if allWorkspaces && block.AllWorkspacesSupplierConfig() != nil {
+			ws = AllWorkspaces
+		}
+		discovered = append(discovered, DiscoveredState{Dir: dir, File: source, Workspace: ws, Config: relativeToModule(dir, *cfg)})
+	}
+	return discovered
+}
+
+// includedRemoteState evaluates the remote_state of the files a terragrunt module includes,
+// path_relative_to_include() then returns the path of the module from the included file
+func includedRemoteState(file string, tg *TerragruntFile) (*TerragruntRemoteStateBlock, string) {
+	for _, include := range tg.Includes {
+		// find_in_parent_folders() returns absolute paths, keep them relative to the walked root
+		path := include.Path
+		if filepath.IsAbs(path) {
+			if rel, err := filepath.Rel(absPath(filepath.Dir(file)), path); err == nil {
+				path = rel
+			}
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(file), path)
+		}
+		relative, err := filepath.Rel(filepath.Dir(absPath(path)), filepath.Dir(absPath(file)))
+		if err != nil {
+			continue
+		}
+		parent, err := ParseTerragruntFromHCL(path, relative)
+		if err != nil {
+			logrus.
+				WithField("file", path).
+				WithField("error", err).
+				Debug("Error parsing included terragrunt file")
+			continue
+		}
+		if parent.RemoteState != nil {
+			return parent.RemoteState, path
+		}
+	}
+	return nil, ""
+}
+
+// relativeToModule resolves local state paths from the module directory rather than from
+// where driftctl runs
+func relativeToModule(dir string, cfg config.SupplierConfig) config.SupplierConfig {
+	if cfg.Backend == backend.BackendKeyFile && !filepath.IsAbs(cfg.Path) {
+		cfg.Path = filepath.Join(dir, cfg.Path)
+	}
+	return cfg
+}
+
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	return abs
+}
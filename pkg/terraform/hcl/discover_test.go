@@ -0,0 +1,94 @@
+package hcl
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscover(t *testing.T) {
+	got, err := Discover("testdata/discover", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []DiscoveredState{
+		{
+			Dir:       "testdata/discover/app",
+			File:      "testdata/discover/app/main.tf",
+			Workspace: "staging",
+			Config:    config.SupplierConfig{Key: state.TerraformStateReaderSupplier, Backend: backend.BackendKeyGS, Path: "tf-state-prod/app/staging.tfstate"},
+		},
+		{
+			Dir:       "testdata/discover/live/prod/db",
+			File:      "testdata/discover/live/terragrunt.hcl",
+			Workspace: "default",
+			Config:    config.SupplierConfig{Key: state.TerraformStateReaderSupplier, Backend: backend.BackendKeyS3, Path: "terragrunt-state/prod/db/terraform.tfstate"},
+		},
+		{
+			Dir:       "testdata/discover/live/staging/app",
+			File:      "testdata/discover/live/terragrunt.hcl",
+			Workspace: "default",
+			Config:    config.SupplierConfig{Key: state.TerraformStateReaderSupplier, Backend: backend.BackendKeyS3, Path: "terragrunt-state/staging/app/terraform.tfstate"},
+		},
+		{
+			Dir:       "testdata/discover/local",
+			File:      "testdata/discover/local/main.tf",
+			Workspace: "default",
+			Config:    config.SupplierConfig{Key: state.TerraformStateReaderSupplier, Backend: backend.BackendKeyFile, Path: "testdata/discover/local/terraform.tfstate"},
+		},
+		{
+			Dir:       "testdata/discover/network",
+			File:      "testdata/discover/network/main.tf",
+			Workspace: "default",
+			Config:    config.SupplierConfig{Key: state.TerraformStateReaderSupplier, Backend: backend.BackendKeyS3, Path: "terraform-state-prod/network/terraform.tfstate"},
+		},
+		{
+			Dir:       "testdata/discover/standalone",
+			File:      "testdata/discover/standalone/terragrunt.hcl",
+			Workspace: "default",
+			Config:    config.SupplierConfig{Key: state.TerraformStateReaderSupplier, Backend: backend.BackendKeyGS, Path: "standalone-state/standalone/default.tfstate"},
+		},
+	}, got)
+}
+
+func TestParseTerragruntFromHCL(t *testing.T) {
+	t.Setenv("AWS_REGION", "eu-west-3")
+
+	file, err := ParseTerragruntFromHCL("testdata/discover/live/terragrunt.hcl", "prod/db")
+	assert.NoError(t, err)
+	assert.Equal(t, BackendBlock{
+		Name:   "s3",
+		Bucket: "terragrunt-state",
+		Key:    "prod/db/terraform.tfstate",
+		Region: "eu-west-3",
+	}, file.RemoteState.BackendBlock())
+
+	file, err = ParseTerragruntFromHCL("testdata/discover/live/prod/db/terragrunt.hcl", ".")
+	assert.NoError(t, err)
+	assert.Nil(t, file.RemoteState)
+	assert.Len(t, file.Includes, 1)
+	assert.Equal(t, absPath("testdata/discover/live/terragrunt.hcl"), file.Includes[0].Path)
+}
+
+func TestDiscover_AllWorkspaces(t *testing.T) {
+	got, err := Discover("testdata/discover", true)
+	assert.NoError(t, err)
+
+	workspaces := map[string]string{}
+	paths := map[string]string{}
+	for _, found := range got {
+		workspaces[found.Dir] = found.Workspace
+		paths[found.Dir] = found.Config.Path
+	}
+	assert.Equal(t, map[string]string{
+		"testdata/discover/app":              AllWorkspaces,
+		"testdata/discover/live/prod/db":     AllWorkspaces,
+		"testdata/discover/live/staging/app": AllWorkspaces,
+		"testdata/discover/local":            DefaultStateName,
+		"testdata/discover/network":          AllWorkspaces,
+		"testdata/discover/standalone":       AllWorkspaces,
+	}, workspaces)
+	assert.Equal(t, "tf-state-prod/app/*.tfstate", paths["testdata/discover/app"])
+	assert.Equal(t, "terragrunt-state/{prod/db/terraform.tfstate,env:/*/prod/db/terraform.tfstate}", paths["testdata/discover/live/prod/db"])
+}
@@ -8,10 +8,14 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/snyk/driftctl/pkg/iac/config"
 )
 
 const DefaultStateName = "default"
 
+// AllWorkspaces is reported as the workspace of states globbing every workspace
+const AllWorkspaces = "*"
+
 type MainBodyBlock struct {
 	Terraform TerraformBlock `hcl:"terraform,block"`
 	Remain    hcl.Body       `hcl:",remain"`
@@ -40,6 +44,24 @@ func ParseTerraformFromHCL(filename string) (*TerraformBlock, error) {
 	return &body.Terraform, nil
 }
 
+// SupplierConfig returns the state of the workspace ws, or a glob matching the states of every
+// workspace when allWorkspaces is set and the backend supports it
+func (t TerraformBlock) SupplierConfig(ws string, allWorkspaces bool) *config.SupplierConfig {
+	if t.Backend != nil {
+		t.Backend.warnEnvironmentSettings()
+		if allWorkspaces {
+			if cfg := t.Backend.AllWorkspacesSupplierConfig(); cfg != nil {
+				return cfg
+			}
+		}
+		return t.Backend.SupplierConfig(ws)
+	}
+	if t.Cloud != nil {
+		return t.Cloud.SupplierConfig(ws)
+	}
+	return nil
+}
+
 func GetCurrentWorkspaceName(cwd string) string {
 	name := DefaultStateName // See https://github.com/hashicorp/terraform/blob/main/internal/backend/backend.go#L33
 
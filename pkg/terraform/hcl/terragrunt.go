@@ -0,0 +1,177 @@
+package hcl
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+const TerragruntFileName = "terragrunt.hcl"
+
+type TerragruntIncludeBlock struct {
+	Path   string   `hcl:"path"`
+	Remain hcl.Body `hcl:",remain"`
+}
+
+type TerragruntRemoteStateBlock struct {
+	Backend string    `hcl:"backend"`
+	Config  cty.Value `hcl:"config"`
+	Remain  hcl.Body  `hcl:",remain"`
+}
+
+// TerragruntFile holds the parts of a terragrunt.hcl driftctl needs to find where the state of
+// the module is stored. Only literals and the path functions terragrunt configurations commonly
+// use to compute state keys are supported.
+type TerragruntFile struct {
+	Includes    []TerragruntIncludeBlock
+	RemoteState *TerragruntRemoteStateBlock
+}
+
+// ParseTerragruntFromHCL parses filename, relativePath is what path_relative_to_include()
+// returns, it is the path of the including module when the file is included
+func ParseTerragruntFromHCL(filename, relativePath string) (*TerragruntFile, error) {
+	parser := hclparse.NewParser()
+	f, diags := parser.ParseHCLFile(filename)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	// Blocks are read from the syntax tree as include is written with or without a label
+	// depending on the terragrunt version, and other blocks may use unsupported functions
+	body, ok := f.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, errors.Errorf("unable to read %s", filename)
+	}
+	ctx := terragruntEvalContext(filepath.Dir(filename), relativePath)
+
+	var file TerragruntFile
+	for _, block := range body.Blocks {
+		switch block.Type {
+		case "include":
+			var include TerragruntIncludeBlock
+			if diags := gohcl.DecodeBody(block.Body, ctx, &include); diags.HasErrors() {
+				return nil, diags
+			}
+			file.Includes = append(file.Includes, include)
+		case "remote_state":
+			var remoteState TerragruntRemoteStateBlock
+			if diags := gohcl.DecodeBody(block.Body, ctx, &remoteState); diags.HasErrors() {
+				return nil, diags
+			}
+			file.RemoteState = &remoteState
+		}
+	}
+	return &file, nil
+}
+
+func terragruntEvalContext(dir, relativePath string) *hcl.EvalContext {
+	return &hcl.EvalContext{
+		Functions: map[string]function.Function{
+			"path_relative_to_include": function.New(&function.Spec{
+				Type: function.StaticReturnType(cty.String),
+				Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+					return cty.StringVal(filepath.ToSlash(relativePath)), nil
+				},
+			}),
+			"find_in_parent_folders": function.New(&function.Spec{
+				VarParam: &function.Parameter{Name: "name", Type: cty.String},
+				Type:     function.StaticReturnType(cty.String),
+				Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+					name := TerragruntFileName
+					if len(args) > 0 {
+						name = args[0].AsString()
+					}
+					found, err := findInParentFolders(dir, name)
+					if err != nil {
+						return cty.NilVal, err
+					}
+					return cty.StringVal(found), nil
+				},
+			}),
+			"get_env": function.New(&function.Spec{
+				Params:   []function.Parameter{{Name: "name", Type: cty.String}},
+				VarParam: &function.Parameter{Name: "default", Type: cty.String},
+				Type:     function.StaticReturnType(cty.String),
+				Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+					if value, exist := os.LookupEnv(args[0].AsString()); exist {
+						return cty.StringVal(value), nil
+					}
+					if len(args) > 1 {
+						return args[1], nil
+					}
+					return cty.StringVal(""), nil
+				},
+			}),
+		},
+	}
+}
+
+func findInParentFolders(dir, name string) (string, error) {
+	current, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		parent := filepath.Dir(current)
+		if parent == current {
+			return "", errors.Errorf("could not find %s in any parent folder of %s", name, dir)
+		}
+		current = parent
+		candidate := filepath.Join(current, name)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+}
+
+// BackendBlock converts the remote_state config to the backend block terraform would have
+// been generated with
+func (r TerragruntRemoteStateBlock) BackendBlock() BackendBlock {
+	block := BackendBlock{Name: r.Backend}
+	if r.Config.IsNull() || !r.Config.IsKnown() {
+		return block
+	}
+
+	value := reflect.ValueOf(&block).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		name := strings.Split(value.Type().Field(i).Tag.Get("hcl"), ",")[0]
+		if name == "" || name == "name" || value.Field(i).Kind() != reflect.String {
+			continue
+		}
+		if !hasConfigAttribute(r.Config, name) {
+			continue
+		}
+		attr := configAttribute(r.Config, name)
+		if attr.IsNull() || !attr.IsKnown() || attr.Type() != cty.String {
+			continue
+		}
+		value.Field(i).SetString(attr.AsString())
+	}
+	return block
+}
+
+func configAttribute(config cty.Value, name string) cty.Value {
+	if config.Type().IsObjectType() {
+		return config.GetAttr(name)
+	}
+	return config.Index(cty.StringVal(name))
+}
+
+func hasConfigAttribute(config cty.Value, name string) bool {
+	switch {
+	case config.Type().IsObjectType():
+		return config.Type().HasAttribute(name)
+	case config.Type().IsMapType():
+		return config.HasIndex(cty.StringVal(name)).True()
+	}
+	return false
+}
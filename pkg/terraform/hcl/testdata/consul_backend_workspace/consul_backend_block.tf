@@ -0,0 +1,9 @@
+terraform {
+    backend "consul" {
+        address = "consul.example.com"
+        scheme  = "https"
+        path    = "terraform/network"
+    }
+}
+
+provider "aws" {}
@@ -0,0 +1,6 @@
+terraform {
+  backend "gcs" {
+    bucket = "tf-state-prod"
+    prefix = "app"
+  }
+}
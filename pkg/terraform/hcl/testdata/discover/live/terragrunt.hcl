@@ -0,0 +1,12 @@
+remote_state {
+  backend = "s3"
+  generate = {
+    path      = "backend.tf"
+    if_exists = "overwrite"
+  }
+  config = {
+    bucket = "terragrunt-state"
+    key    = "${path_relative_to_include()}/terraform.tfstate"
+    region = get_env("AWS_REGION", "us-east-1")
+  }
+}
@@ -0,0 +1,5 @@
+variable "cidr" {}
+
+resource "aws_vpc" "vpc" {
+  cidr_block = var.cidr
+}
@@ -0,0 +1,7 @@
+remote_state {
+  backend = "gcs"
+  config = {
+    bucket = "standalone-state"
+    prefix = "standalone"
+  }
+}
@@ -0,0 +1,9 @@
+terraform {
+    backend "kubernetes" {
+        secret_suffix = "network"
+        namespace     = "terraform"
+        config_path   = "~/.kube/config"
+    }
+}
+
+provider "aws" {}
@@ -1,6 +1,8 @@
 package remote
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/resource"
 )
 
@@ -14,8 +16,8 @@ func NewSortableScanner(scanner resource.Supplier) *SortableScanner {
 	}
 }
 
-func (s *SortableScanner) Resources() ([]*resource.Resource, error) {
-	resources, err := s.Scanner.Resources()
+func (s *SortableScanner) Resources(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := s.Scanner.Resources(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package terraform
 
 import (
+	"context"
 	"crypto/sha1"
 	gojson "encoding/json"
 	"fmt"
@@ -39,12 +40,12 @@ func (p *FakeTerraformProvider) WithResponse(response string) *FakeTerraformProv
 	return p
 }
 
-func (p *FakeTerraformProvider) ReadResource(args terraform.ReadResourceArgs) (*cty.Value, error) {
+func (p *FakeTerraformProvider) ReadResource(ctx context.Context, args terraform.ReadResourceArgs) (*cty.Value, error) {
 	if p.response == "" {
 		return nil, errors.New("WithResponse should be called before ReadResource to specify a directory to fetch fake response")
 	}
 	if p.shouldUpdate {
-		readResource, err := p.realProvider.ReadResource(args)
+		readResource, err := p.realProvider.ReadResource(ctx, args)
 		p.writeResource(args, readResource, err)
 		return readResource, err
 	}
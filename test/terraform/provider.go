@@ -3,8 +3,10 @@ package terraform
 import (
 	"github.com/snyk/driftctl/enumeration/remote/aws"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
+	"github.com/snyk/driftctl/enumeration/remote/cloudflare"
 	"github.com/snyk/driftctl/enumeration/remote/github"
 	"github.com/snyk/driftctl/enumeration/remote/google"
+	"github.com/snyk/driftctl/enumeration/remote/kubernetes"
 	"github.com/snyk/driftctl/enumeration/terraform"
 	"os"
 
@@ -57,3 +59,27 @@ func InitTestAzureProvider(providerLibrary *terraform.ProviderLibrary, version s
 
 	return provider, nil
 }
+
+func InitTestKubernetesProvider(providerLibrary *terraform.ProviderLibrary, version string) (*kubernetes.KubernetesTerraformProvider, error) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Maybe().Return()
+	provider, err := kubernetes.NewKubernetesTerraformProvider(version, progress, os.TempDir())
+	if err != nil {
+		return nil, err
+	}
+	providerLibrary.AddProvider(terraform.KUBERNETES, provider)
+
+	return provider, nil
+}
+
+func InitTestCloudflareProvider(providerLibrary *terraform.ProviderLibrary, version string) (*cloudflare.CloudflareTerraformProvider, error) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Maybe().Return()
+	provider, err := cloudflare.NewCloudflareTerraformProvider(version, progress, os.TempDir())
+	if err != nil {
+		return nil, err
+	}
+	providerLibrary.AddProvider(terraform.CLOUDFLARE, provider)
+
+	return provider, nil
+}